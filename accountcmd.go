@@ -0,0 +1,166 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/mapprotocol/marker_tool01/handler"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var accountCommand = cli.Command{
+	Name:  "account",
+	Usage: "plain account operations",
+	Subcommands: []cli.Command{
+		{
+			Name:      "balance",
+			Usage:     "show the balance of an account",
+			ArgsUsage: "<account>",
+			Flags:     callFlags,
+			Action:    balanceOf,
+		},
+		{
+			Name:      "transfer",
+			Usage:     "transfer wei to an account",
+			ArgsUsage: "<to> <wei>",
+			Flags:     txFlags,
+			Action:    transfer,
+		},
+		{
+			Name:      "batch-transfer",
+			Usage:     "send every transfer of a to,wei CSV file, then wait for all of them",
+			ArgsUsage: "<csv>",
+			Flags:     txFlags,
+			Action:    batchTransfer,
+		},
+	},
+}
+
+func balanceOf(ctx *cli.Context) error {
+	account, err := argAddress(ctx, 0, "account")
+	if err != nil {
+		return err
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	b, err := client.BalanceOf(account)
+	if err != nil {
+		return err
+	}
+	log.Info("balanceOf", "to", account, "balance", b.String(), "coin", handler.ToCoin(b))
+	return nil
+}
+
+func transfer(ctx *cli.Context) error {
+	to, err := argAddress(ctx, 0, "to")
+	if err != nil {
+		return err
+	}
+	value, err := argBig(ctx, 1, "wei")
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := client.SendTransaction(signer, to, value)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info("transfer", "from", signer.Address(), "to", to, "value", value)
+	return nil
+}
+
+func batchTransfer(ctx *cli.Context) error {
+	if ctx.NArg() < 1 {
+		return errors.New("missing csv argument")
+	}
+	tos, values, err := loadTransfers(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// The client allocates nonces locally, so every transfer can be sent
+	// before waiting for the first receipt.
+	hashes := make([]common.Hash, 0, len(tos))
+	for i, to := range tos {
+		txHash, err := client.SendTransaction(signer, to, values[i])
+		if err != nil {
+			return fmt.Errorf("transfer %d to %s: %w", i, to.Hex(), err)
+		}
+		log.Info("transfer sent", "index", i, "to", to, "value", values[i], "txHash", txHash)
+		hashes = append(hashes, txHash)
+	}
+	for _, txHash := range hashes {
+		if err := waitTx(ctx, client, txHash); err != nil {
+			return err
+		}
+	}
+	log.Info("batch transfer", "count", len(hashes))
+	return nil
+}
+
+// loadTransfers reads to,wei rows from file; a header row is skipped.
+func loadTransfers(file string) ([]common.Address, []*big.Int, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		tos    []common.Address
+		values []*big.Int
+	)
+	for i, row := range rows {
+		if len(row) < 2 {
+			return nil, nil, fmt.Errorf("line %d: want to,wei", i+1)
+		}
+		to, err := parseAddress(strings.TrimSpace(row[0]))
+		if err != nil && i == 0 {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %v", i+1, err)
+		}
+		value, err := parseBig(strings.TrimSpace(row[1]))
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %v", i+1, err)
+		}
+		tos = append(tos, to)
+		values = append(values, value)
+	}
+	return tos, values, nil
+}
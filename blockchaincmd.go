@@ -0,0 +1,33 @@
+package main
+
+import (
+	"github.com/ethereum/go-ethereum/log"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var blockchainCommand = cli.Command{
+	Name:  "blockchain",
+	Usage: "BlockchainParameters contract operations",
+	Subcommands: []cli.Command{
+		{
+			Name:   "get-gas-limit",
+			Usage:  "show the block gas limit",
+			Flags:  callFlags,
+			Action: getBlockGasLimit,
+		},
+	},
+}
+
+func getBlockGasLimit(ctx *cli.Context) error {
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	res, err := client.GetBlockGasLimit()
+	if err != nil {
+		return err
+	}
+	log.Info("getBlockGasLimit", "gasLimit", res)
+	return nil
+}
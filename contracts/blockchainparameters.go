@@ -0,0 +1,323 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// BlockchainParametersMetaData contains all meta data concerning the BlockchainParameters contract.
+var BlockchainParametersMetaData = &bind.MetaData{
+	ABI: "[{\"constant\":true,\"inputs\":[],\"name\":\"getMinimumClientVersion\",\"outputs\":[{\"name\":\"major\",\"type\":\"uint256\"},{\"name\":\"minor\",\"type\":\"uint256\"},{\"name\":\"patch\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"blockGasLimit\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getUptimeLookbackWindow\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"lookbackWindow\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"intrinsicGasForAlternativeFeeCurrency\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// BlockchainParametersABI is the input ABI used to generate the binding from.
+// Deprecated: Use BlockchainParametersMetaData.ABI instead.
+var BlockchainParametersABI = BlockchainParametersMetaData.ABI
+
+// BlockchainParameters is an auto generated Go binding around an Ethereum contract.
+type BlockchainParameters struct {
+	BlockchainParametersCaller     // Read-only binding to the contract
+	BlockchainParametersTransactor // Write-only binding to the contract
+	BlockchainParametersFilterer   // Log filterer for contract events
+}
+
+// BlockchainParametersCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BlockchainParametersCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BlockchainParametersTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BlockchainParametersTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BlockchainParametersFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BlockchainParametersFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BlockchainParametersSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BlockchainParametersSession struct {
+	Contract     *BlockchainParameters // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts         // Call options to use throughout this session
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// BlockchainParametersCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BlockchainParametersCallerSession struct {
+	Contract *BlockchainParametersCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts               // Call options to use throughout this session
+}
+
+// BlockchainParametersTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BlockchainParametersTransactorSession struct {
+	Contract     *BlockchainParametersTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts               // Transaction auth options to use throughout this session
+}
+
+// BlockchainParametersRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BlockchainParametersRaw struct {
+	Contract *BlockchainParameters // Generic contract binding to access the raw methods on
+}
+
+// BlockchainParametersCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BlockchainParametersCallerRaw struct {
+	Contract *BlockchainParametersCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BlockchainParametersTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BlockchainParametersTransactorRaw struct {
+	Contract *BlockchainParametersTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBlockchainParameters creates a new instance of BlockchainParameters, bound to a specific deployed contract.
+func NewBlockchainParameters(address common.Address, backend bind.ContractBackend) (*BlockchainParameters, error) {
+	contract, err := bindBlockchainParameters(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockchainParameters{BlockchainParametersCaller: BlockchainParametersCaller{contract: contract}, BlockchainParametersTransactor: BlockchainParametersTransactor{contract: contract}, BlockchainParametersFilterer: BlockchainParametersFilterer{contract: contract}}, nil
+}
+
+// NewBlockchainParametersCaller creates a new read-only instance of BlockchainParameters, bound to a specific deployed contract.
+func NewBlockchainParametersCaller(address common.Address, caller bind.ContractCaller) (*BlockchainParametersCaller, error) {
+	contract, err := bindBlockchainParameters(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockchainParametersCaller{contract: contract}, nil
+}
+
+// NewBlockchainParametersTransactor creates a new write-only instance of BlockchainParameters, bound to a specific deployed contract.
+func NewBlockchainParametersTransactor(address common.Address, transactor bind.ContractTransactor) (*BlockchainParametersTransactor, error) {
+	contract, err := bindBlockchainParameters(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockchainParametersTransactor{contract: contract}, nil
+}
+
+// NewBlockchainParametersFilterer creates a new log filterer instance of BlockchainParameters, bound to a specific deployed contract.
+func NewBlockchainParametersFilterer(address common.Address, filterer bind.ContractFilterer) (*BlockchainParametersFilterer, error) {
+	contract, err := bindBlockchainParameters(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockchainParametersFilterer{contract: contract}, nil
+}
+
+// bindBlockchainParameters binds a generic wrapper to an already deployed contract.
+func bindBlockchainParameters(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(BlockchainParametersABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BlockchainParameters *BlockchainParametersRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BlockchainParameters.Contract.BlockchainParametersCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BlockchainParameters *BlockchainParametersRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BlockchainParameters.Contract.BlockchainParametersTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BlockchainParameters *BlockchainParametersRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BlockchainParameters.Contract.BlockchainParametersTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BlockchainParameters *BlockchainParametersCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BlockchainParameters.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BlockchainParameters *BlockchainParametersTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BlockchainParameters.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BlockchainParameters *BlockchainParametersTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BlockchainParameters.Contract.contract.Transact(opts, method, params...)
+}
+
+// BlockGasLimit is a free data retrieval call binding the contract method 0x7877a797.
+//
+// Solidity: function blockGasLimit() view returns(uint256)
+func (_BlockchainParameters *BlockchainParametersCaller) BlockGasLimit(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BlockchainParameters.contract.Call(opts, &out, "blockGasLimit")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BlockGasLimit is a free data retrieval call binding the contract method 0x7877a797.
+//
+// Solidity: function blockGasLimit() view returns(uint256)
+func (_BlockchainParameters *BlockchainParametersSession) BlockGasLimit() (*big.Int, error) {
+	return _BlockchainParameters.Contract.BlockGasLimit(&_BlockchainParameters.CallOpts)
+}
+
+// BlockGasLimit is a free data retrieval call binding the contract method 0x7877a797.
+//
+// Solidity: function blockGasLimit() view returns(uint256)
+func (_BlockchainParameters *BlockchainParametersCallerSession) BlockGasLimit() (*big.Int, error) {
+	return _BlockchainParameters.Contract.BlockGasLimit(&_BlockchainParameters.CallOpts)
+}
+
+// GetMinimumClientVersion is a free data retrieval call binding the contract method 0x25eb315d.
+//
+// Solidity: function getMinimumClientVersion() view returns(uint256 major, uint256 minor, uint256 patch)
+func (_BlockchainParameters *BlockchainParametersCaller) GetMinimumClientVersion(opts *bind.CallOpts) (struct {
+	Major *big.Int
+	Minor *big.Int
+	Patch *big.Int
+}, error) {
+	var out []interface{}
+	err := _BlockchainParameters.contract.Call(opts, &out, "getMinimumClientVersion")
+
+	outstruct := new(struct {
+		Major *big.Int
+		Minor *big.Int
+		Patch *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Major = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Minor = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Patch = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetMinimumClientVersion is a free data retrieval call binding the contract method 0x25eb315d.
+//
+// Solidity: function getMinimumClientVersion() view returns(uint256 major, uint256 minor, uint256 patch)
+func (_BlockchainParameters *BlockchainParametersSession) GetMinimumClientVersion() (struct {
+	Major *big.Int
+	Minor *big.Int
+	Patch *big.Int
+}, error) {
+	return _BlockchainParameters.Contract.GetMinimumClientVersion(&_BlockchainParameters.CallOpts)
+}
+
+// GetMinimumClientVersion is a free data retrieval call binding the contract method 0x25eb315d.
+//
+// Solidity: function getMinimumClientVersion() view returns(uint256 major, uint256 minor, uint256 patch)
+func (_BlockchainParameters *BlockchainParametersCallerSession) GetMinimumClientVersion() (struct {
+	Major *big.Int
+	Minor *big.Int
+	Patch *big.Int
+}, error) {
+	return _BlockchainParameters.Contract.GetMinimumClientVersion(&_BlockchainParameters.CallOpts)
+}
+
+// GetUptimeLookbackWindow is a free data retrieval call binding the contract method 0x52bed4d7.
+//
+// Solidity: function getUptimeLookbackWindow() view returns(uint256 lookbackWindow)
+func (_BlockchainParameters *BlockchainParametersCaller) GetUptimeLookbackWindow(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BlockchainParameters.contract.Call(opts, &out, "getUptimeLookbackWindow")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetUptimeLookbackWindow is a free data retrieval call binding the contract method 0x52bed4d7.
+//
+// Solidity: function getUptimeLookbackWindow() view returns(uint256 lookbackWindow)
+func (_BlockchainParameters *BlockchainParametersSession) GetUptimeLookbackWindow() (*big.Int, error) {
+	return _BlockchainParameters.Contract.GetUptimeLookbackWindow(&_BlockchainParameters.CallOpts)
+}
+
+// GetUptimeLookbackWindow is a free data retrieval call binding the contract method 0x52bed4d7.
+//
+// Solidity: function getUptimeLookbackWindow() view returns(uint256 lookbackWindow)
+func (_BlockchainParameters *BlockchainParametersCallerSession) GetUptimeLookbackWindow() (*big.Int, error) {
+	return _BlockchainParameters.Contract.GetUptimeLookbackWindow(&_BlockchainParameters.CallOpts)
+}
+
+// IntrinsicGasForAlternativeFeeCurrency is a free data retrieval call binding the contract method 0x808474f1.
+//
+// Solidity: function intrinsicGasForAlternativeFeeCurrency() view returns(uint256)
+func (_BlockchainParameters *BlockchainParametersCaller) IntrinsicGasForAlternativeFeeCurrency(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BlockchainParameters.contract.Call(opts, &out, "intrinsicGasForAlternativeFeeCurrency")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// IntrinsicGasForAlternativeFeeCurrency is a free data retrieval call binding the contract method 0x808474f1.
+//
+// Solidity: function intrinsicGasForAlternativeFeeCurrency() view returns(uint256)
+func (_BlockchainParameters *BlockchainParametersSession) IntrinsicGasForAlternativeFeeCurrency() (*big.Int, error) {
+	return _BlockchainParameters.Contract.IntrinsicGasForAlternativeFeeCurrency(&_BlockchainParameters.CallOpts)
+}
+
+// IntrinsicGasForAlternativeFeeCurrency is a free data retrieval call binding the contract method 0x808474f1.
+//
+// Solidity: function intrinsicGasForAlternativeFeeCurrency() view returns(uint256)
+func (_BlockchainParameters *BlockchainParametersCallerSession) IntrinsicGasForAlternativeFeeCurrency() (*big.Int, error) {
+	return _BlockchainParameters.Contract.IntrinsicGasForAlternativeFeeCurrency(&_BlockchainParameters.CallOpts)
+}
@@ -0,0 +1,5 @@
+// Package contracts holds the generated Go bindings of the MAP relay chain
+// system contracts whose ABIs are embedded in the handler package.
+package contracts
+
+//go:generate go run gen.go
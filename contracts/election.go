@@ -0,0 +1,3943 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ElectionMetaData contains all meta data concerning the Election contract.
+var ElectionMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"test\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"electabilityThreshold\",\"type\":\"uint256\"}],\"name\":\"ElectabilityThresholdSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"min\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"max\",\"type\":\"uint256\"}],\"name\":\"ElectableValidatorsSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"EpochRewardRemainsDistributedToValidators\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"voterAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"EpochRewardsDistributedToVoters\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"maxNumValidatorsVotedFor\",\"type\":\"uint256\"}],\"name\":\"MaxNumValidatorsVotedForSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"registryAddress\",\"type\":\"address\"}],\"name\":\"RegistrySet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"ValidatorActiveVoteRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"ValidatorMarkedEligible\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"ValidatorMarkedIneligible\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"ValidatorPendingVoteRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"ValidatorVoteActivated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"ValidatorVoteCast\",\"type\":\"event\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"blsKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"blsPop\",\"type\":\"bytes\"}],\"name\":\"checkProofOfPossession\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"electabilityThreshold\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"electableValidators\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"min\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"max\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"aNumerator\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"aDenominator\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bNumerator\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bDenominator\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"exponent\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_decimals\",\"type\":\"uint256\"}],\"name\":\"fractionMulExp\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"header\",\"type\":\"bytes\"}],\"name\":\"getBlockNumberFromHeader\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getEpochNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"getEpochNumberOfBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getEpochSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"getParentSealBitmap\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"header\",\"type\":\"bytes\"}],\"name\":\"getVerifiedSealBitmapFromHeader\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"header\",\"type\":\"bytes\"}],\"name\":\"hashHeader\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"initialized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"isOwner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"maxNumValidatorsVotedFor\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"minQuorumSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"minQuorumSizeInCurrentSet\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"numberValidatorsInCurrentSet\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"numberValidatorsInSet\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"registry\",\"outputs\":[{\"internalType\":\"contractIRegistry\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"registryAddress\",\"type\":\"address\"}],\"name\":\"setRegistry\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"validatorSignerAddressFromCurrentSet\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"validatorSignerAddressFromSet\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getVersionNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"registryAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"minElectableValidators\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxElectableValidators\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_maxNumValidatorsVotedFor\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_electabilityThreshold\",\"type\":\"uint256\"}],\"name\":\"initialize\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"min\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"max\",\"type\":\"uint256\"}],\"name\":\"setElectableValidators\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getElectableValidators\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_maxNumValidatorsVotedFor\",\"type\":\"uint256\"}],\"name\":\"setMaxNumValidatorsVotedFor\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"threshold\",\"type\":\"uint256\"}],\"name\":\"setElectabilityThreshold\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getElectabilityThreshold\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"lesser\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"greater\",\"type\":\"address\"}],\"name\":\"vote\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"activate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"activateForAccount\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"hasActivatablePendingVotes\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"pendingInfo\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"lesser\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"greater\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"revokePending\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"lesser\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"greater\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"revokeAllActive\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"lesser\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"greater\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"revokeActive\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getTotalVotesByAccount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getPendingVotesForValidatorByAccount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getTotalVotesForValidatorByAccount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getActiveVotesForValidator\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getTotalVotesForValidator\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getPendingVotersForValidator\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getPendingVotesForValidator\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getValidatorEligibility\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"topNum\",\"type\":\"uint256\"}],\"name\":\"getTopValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"lesser\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"greater\",\"type\":\"address\"}],\"name\":\"distributeEpochVotersRewards\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"markValidatorIneligible\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"lesser\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"greater\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"markValidatorEligible\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getValidatorsVotedForByAccount\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"canReceiveVotes\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getNumVotesReceivable\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getTotalVotes\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getActiveVotes\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getEligibleValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getTotalVotesForEligibleValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"electValidatorSigners\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"minElectableValidators\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxElectableValidators\",\"type\":\"uint256\"}],\"name\":\"electNValidatorSigners\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getCurrentValidatorSigners\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"address[]\",\"name\":\"lessers\",\"type\":\"address[]\"},{\"internalType\":\"address[]\",\"name\":\"greaters\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"indices\",\"type\":\"uint256[]\"}],\"name\":\"forceDecrementVotes\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getActiveVotesForValidatorByAccount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"}],\"name\":\"activeAllPending\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// ElectionABI is the input ABI used to generate the binding from.
+// Deprecated: Use ElectionMetaData.ABI instead.
+var ElectionABI = ElectionMetaData.ABI
+
+// Election is an auto generated Go binding around an Ethereum contract.
+type Election struct {
+	ElectionCaller     // Read-only binding to the contract
+	ElectionTransactor // Write-only binding to the contract
+	ElectionFilterer   // Log filterer for contract events
+}
+
+// ElectionCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ElectionCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ElectionTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ElectionTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ElectionFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ElectionFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ElectionSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ElectionSession struct {
+	Contract     *Election         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ElectionCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ElectionCallerSession struct {
+	Contract *ElectionCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// ElectionTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ElectionTransactorSession struct {
+	Contract     *ElectionTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// ElectionRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ElectionRaw struct {
+	Contract *Election // Generic contract binding to access the raw methods on
+}
+
+// ElectionCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ElectionCallerRaw struct {
+	Contract *ElectionCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ElectionTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ElectionTransactorRaw struct {
+	Contract *ElectionTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewElection creates a new instance of Election, bound to a specific deployed contract.
+func NewElection(address common.Address, backend bind.ContractBackend) (*Election, error) {
+	contract, err := bindElection(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Election{ElectionCaller: ElectionCaller{contract: contract}, ElectionTransactor: ElectionTransactor{contract: contract}, ElectionFilterer: ElectionFilterer{contract: contract}}, nil
+}
+
+// NewElectionCaller creates a new read-only instance of Election, bound to a specific deployed contract.
+func NewElectionCaller(address common.Address, caller bind.ContractCaller) (*ElectionCaller, error) {
+	contract, err := bindElection(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionCaller{contract: contract}, nil
+}
+
+// NewElectionTransactor creates a new write-only instance of Election, bound to a specific deployed contract.
+func NewElectionTransactor(address common.Address, transactor bind.ContractTransactor) (*ElectionTransactor, error) {
+	contract, err := bindElection(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionTransactor{contract: contract}, nil
+}
+
+// NewElectionFilterer creates a new log filterer instance of Election, bound to a specific deployed contract.
+func NewElectionFilterer(address common.Address, filterer bind.ContractFilterer) (*ElectionFilterer, error) {
+	contract, err := bindElection(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionFilterer{contract: contract}, nil
+}
+
+// bindElection binds a generic wrapper to an already deployed contract.
+func bindElection(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ElectionABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Election *ElectionRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Election.Contract.ElectionCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Election *ElectionRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Election.Contract.ElectionTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Election *ElectionRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Election.Contract.ElectionTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Election *ElectionCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Election.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Election *ElectionTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Election.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Election *ElectionTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Election.Contract.contract.Transact(opts, method, params...)
+}
+
+// CanReceiveVotes is a free data retrieval call binding the contract method 0xe59ea3e8.
+//
+// Solidity: function canReceiveVotes(address validator, uint256 value) view returns(bool)
+func (_Election *ElectionCaller) CanReceiveVotes(opts *bind.CallOpts, validator common.Address, value *big.Int) (bool, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "canReceiveVotes", validator, value)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// CanReceiveVotes is a free data retrieval call binding the contract method 0xe59ea3e8.
+//
+// Solidity: function canReceiveVotes(address validator, uint256 value) view returns(bool)
+func (_Election *ElectionSession) CanReceiveVotes(validator common.Address, value *big.Int) (bool, error) {
+	return _Election.Contract.CanReceiveVotes(&_Election.CallOpts, validator, value)
+}
+
+// CanReceiveVotes is a free data retrieval call binding the contract method 0xe59ea3e8.
+//
+// Solidity: function canReceiveVotes(address validator, uint256 value) view returns(bool)
+func (_Election *ElectionCallerSession) CanReceiveVotes(validator common.Address, value *big.Int) (bool, error) {
+	return _Election.Contract.CanReceiveVotes(&_Election.CallOpts, validator, value)
+}
+
+// CheckProofOfPossession is a free data retrieval call binding the contract method 0x23f0ab65.
+//
+// Solidity: function checkProofOfPossession(address sender, bytes blsKey, bytes blsPop) view returns(bool)
+func (_Election *ElectionCaller) CheckProofOfPossession(opts *bind.CallOpts, sender common.Address, blsKey []byte, blsPop []byte) (bool, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "checkProofOfPossession", sender, blsKey, blsPop)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// CheckProofOfPossession is a free data retrieval call binding the contract method 0x23f0ab65.
+//
+// Solidity: function checkProofOfPossession(address sender, bytes blsKey, bytes blsPop) view returns(bool)
+func (_Election *ElectionSession) CheckProofOfPossession(sender common.Address, blsKey []byte, blsPop []byte) (bool, error) {
+	return _Election.Contract.CheckProofOfPossession(&_Election.CallOpts, sender, blsKey, blsPop)
+}
+
+// CheckProofOfPossession is a free data retrieval call binding the contract method 0x23f0ab65.
+//
+// Solidity: function checkProofOfPossession(address sender, bytes blsKey, bytes blsPop) view returns(bool)
+func (_Election *ElectionCallerSession) CheckProofOfPossession(sender common.Address, blsKey []byte, blsPop []byte) (bool, error) {
+	return _Election.Contract.CheckProofOfPossession(&_Election.CallOpts, sender, blsKey, blsPop)
+}
+
+// ElectNValidatorSigners is a free data retrieval call binding the contract method 0x90a4dd5c.
+//
+// Solidity: function electNValidatorSigners(uint256 minElectableValidators, uint256 maxElectableValidators) view returns(address[])
+func (_Election *ElectionCaller) ElectNValidatorSigners(opts *bind.CallOpts, minElectableValidators *big.Int, maxElectableValidators *big.Int) ([]common.Address, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "electNValidatorSigners", minElectableValidators, maxElectableValidators)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// ElectNValidatorSigners is a free data retrieval call binding the contract method 0x90a4dd5c.
+//
+// Solidity: function electNValidatorSigners(uint256 minElectableValidators, uint256 maxElectableValidators) view returns(address[])
+func (_Election *ElectionSession) ElectNValidatorSigners(minElectableValidators *big.Int, maxElectableValidators *big.Int) ([]common.Address, error) {
+	return _Election.Contract.ElectNValidatorSigners(&_Election.CallOpts, minElectableValidators, maxElectableValidators)
+}
+
+// ElectNValidatorSigners is a free data retrieval call binding the contract method 0x90a4dd5c.
+//
+// Solidity: function electNValidatorSigners(uint256 minElectableValidators, uint256 maxElectableValidators) view returns(address[])
+func (_Election *ElectionCallerSession) ElectNValidatorSigners(minElectableValidators *big.Int, maxElectableValidators *big.Int) ([]common.Address, error) {
+	return _Election.Contract.ElectNValidatorSigners(&_Election.CallOpts, minElectableValidators, maxElectableValidators)
+}
+
+// ElectValidatorSigners is a free data retrieval call binding the contract method 0x2ba38e69.
+//
+// Solidity: function electValidatorSigners() view returns(address[])
+func (_Election *ElectionCaller) ElectValidatorSigners(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "electValidatorSigners")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// ElectValidatorSigners is a free data retrieval call binding the contract method 0x2ba38e69.
+//
+// Solidity: function electValidatorSigners() view returns(address[])
+func (_Election *ElectionSession) ElectValidatorSigners() ([]common.Address, error) {
+	return _Election.Contract.ElectValidatorSigners(&_Election.CallOpts)
+}
+
+// ElectValidatorSigners is a free data retrieval call binding the contract method 0x2ba38e69.
+//
+// Solidity: function electValidatorSigners() view returns(address[])
+func (_Election *ElectionCallerSession) ElectValidatorSigners() ([]common.Address, error) {
+	return _Election.Contract.ElectValidatorSigners(&_Election.CallOpts)
+}
+
+// ElectabilityThreshold is a free data retrieval call binding the contract method 0x4be8843b.
+//
+// Solidity: function electabilityThreshold() view returns(uint256 value)
+func (_Election *ElectionCaller) ElectabilityThreshold(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "electabilityThreshold")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ElectabilityThreshold is a free data retrieval call binding the contract method 0x4be8843b.
+//
+// Solidity: function electabilityThreshold() view returns(uint256 value)
+func (_Election *ElectionSession) ElectabilityThreshold() (*big.Int, error) {
+	return _Election.Contract.ElectabilityThreshold(&_Election.CallOpts)
+}
+
+// ElectabilityThreshold is a free data retrieval call binding the contract method 0x4be8843b.
+//
+// Solidity: function electabilityThreshold() view returns(uint256 value)
+func (_Election *ElectionCallerSession) ElectabilityThreshold() (*big.Int, error) {
+	return _Election.Contract.ElectabilityThreshold(&_Election.CallOpts)
+}
+
+// ElectableValidators is a free data retrieval call binding the contract method 0xf9d7daae.
+//
+// Solidity: function electableValidators() view returns(uint256 min, uint256 max)
+func (_Election *ElectionCaller) ElectableValidators(opts *bind.CallOpts) (struct {
+	Min *big.Int
+	Max *big.Int
+}, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "electableValidators")
+
+	outstruct := new(struct {
+		Min *big.Int
+		Max *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Min = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Max = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// ElectableValidators is a free data retrieval call binding the contract method 0xf9d7daae.
+//
+// Solidity: function electableValidators() view returns(uint256 min, uint256 max)
+func (_Election *ElectionSession) ElectableValidators() (struct {
+	Min *big.Int
+	Max *big.Int
+}, error) {
+	return _Election.Contract.ElectableValidators(&_Election.CallOpts)
+}
+
+// ElectableValidators is a free data retrieval call binding the contract method 0xf9d7daae.
+//
+// Solidity: function electableValidators() view returns(uint256 min, uint256 max)
+func (_Election *ElectionCallerSession) ElectableValidators() (struct {
+	Min *big.Int
+	Max *big.Int
+}, error) {
+	return _Election.Contract.ElectableValidators(&_Election.CallOpts)
+}
+
+// FractionMulExp is a free data retrieval call binding the contract method 0xec683072.
+//
+// Solidity: function fractionMulExp(uint256 aNumerator, uint256 aDenominator, uint256 bNumerator, uint256 bDenominator, uint256 exponent, uint256 _decimals) view returns(uint256, uint256)
+func (_Election *ElectionCaller) FractionMulExp(opts *bind.CallOpts, aNumerator *big.Int, aDenominator *big.Int, bNumerator *big.Int, bDenominator *big.Int, exponent *big.Int, _decimals *big.Int) (*big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "fractionMulExp", aNumerator, aDenominator, bNumerator, bDenominator, exponent, _decimals)
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// FractionMulExp is a free data retrieval call binding the contract method 0xec683072.
+//
+// Solidity: function fractionMulExp(uint256 aNumerator, uint256 aDenominator, uint256 bNumerator, uint256 bDenominator, uint256 exponent, uint256 _decimals) view returns(uint256, uint256)
+func (_Election *ElectionSession) FractionMulExp(aNumerator *big.Int, aDenominator *big.Int, bNumerator *big.Int, bDenominator *big.Int, exponent *big.Int, _decimals *big.Int) (*big.Int, *big.Int, error) {
+	return _Election.Contract.FractionMulExp(&_Election.CallOpts, aNumerator, aDenominator, bNumerator, bDenominator, exponent, _decimals)
+}
+
+// FractionMulExp is a free data retrieval call binding the contract method 0xec683072.
+//
+// Solidity: function fractionMulExp(uint256 aNumerator, uint256 aDenominator, uint256 bNumerator, uint256 bDenominator, uint256 exponent, uint256 _decimals) view returns(uint256, uint256)
+func (_Election *ElectionCallerSession) FractionMulExp(aNumerator *big.Int, aDenominator *big.Int, bNumerator *big.Int, bDenominator *big.Int, exponent *big.Int, _decimals *big.Int) (*big.Int, *big.Int, error) {
+	return _Election.Contract.FractionMulExp(&_Election.CallOpts, aNumerator, aDenominator, bNumerator, bDenominator, exponent, _decimals)
+}
+
+// GetActiveVotes is a free data retrieval call binding the contract method 0x1f604243.
+//
+// Solidity: function getActiveVotes() view returns(uint256)
+func (_Election *ElectionCaller) GetActiveVotes(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getActiveVotes")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetActiveVotes is a free data retrieval call binding the contract method 0x1f604243.
+//
+// Solidity: function getActiveVotes() view returns(uint256)
+func (_Election *ElectionSession) GetActiveVotes() (*big.Int, error) {
+	return _Election.Contract.GetActiveVotes(&_Election.CallOpts)
+}
+
+// GetActiveVotes is a free data retrieval call binding the contract method 0x1f604243.
+//
+// Solidity: function getActiveVotes() view returns(uint256)
+func (_Election *ElectionCallerSession) GetActiveVotes() (*big.Int, error) {
+	return _Election.Contract.GetActiveVotes(&_Election.CallOpts)
+}
+
+// GetActiveVotesForValidator is a free data retrieval call binding the contract method 0xfcb9c00d.
+//
+// Solidity: function getActiveVotesForValidator(address validator) view returns(uint256)
+func (_Election *ElectionCaller) GetActiveVotesForValidator(opts *bind.CallOpts, validator common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getActiveVotesForValidator", validator)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetActiveVotesForValidator is a free data retrieval call binding the contract method 0xfcb9c00d.
+//
+// Solidity: function getActiveVotesForValidator(address validator) view returns(uint256)
+func (_Election *ElectionSession) GetActiveVotesForValidator(validator common.Address) (*big.Int, error) {
+	return _Election.Contract.GetActiveVotesForValidator(&_Election.CallOpts, validator)
+}
+
+// GetActiveVotesForValidator is a free data retrieval call binding the contract method 0xfcb9c00d.
+//
+// Solidity: function getActiveVotesForValidator(address validator) view returns(uint256)
+func (_Election *ElectionCallerSession) GetActiveVotesForValidator(validator common.Address) (*big.Int, error) {
+	return _Election.Contract.GetActiveVotesForValidator(&_Election.CallOpts, validator)
+}
+
+// GetActiveVotesForValidatorByAccount is a free data retrieval call binding the contract method 0x7164ee88.
+//
+// Solidity: function getActiveVotesForValidatorByAccount(address validator, address account) view returns(uint256)
+func (_Election *ElectionCaller) GetActiveVotesForValidatorByAccount(opts *bind.CallOpts, validator common.Address, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getActiveVotesForValidatorByAccount", validator, account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetActiveVotesForValidatorByAccount is a free data retrieval call binding the contract method 0x7164ee88.
+//
+// Solidity: function getActiveVotesForValidatorByAccount(address validator, address account) view returns(uint256)
+func (_Election *ElectionSession) GetActiveVotesForValidatorByAccount(validator common.Address, account common.Address) (*big.Int, error) {
+	return _Election.Contract.GetActiveVotesForValidatorByAccount(&_Election.CallOpts, validator, account)
+}
+
+// GetActiveVotesForValidatorByAccount is a free data retrieval call binding the contract method 0x7164ee88.
+//
+// Solidity: function getActiveVotesForValidatorByAccount(address validator, address account) view returns(uint256)
+func (_Election *ElectionCallerSession) GetActiveVotesForValidatorByAccount(validator common.Address, account common.Address) (*big.Int, error) {
+	return _Election.Contract.GetActiveVotesForValidatorByAccount(&_Election.CallOpts, validator, account)
+}
+
+// GetBlockNumberFromHeader is a free data retrieval call binding the contract method 0x8a883626.
+//
+// Solidity: function getBlockNumberFromHeader(bytes header) view returns(uint256)
+func (_Election *ElectionCaller) GetBlockNumberFromHeader(opts *bind.CallOpts, header []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getBlockNumberFromHeader", header)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBlockNumberFromHeader is a free data retrieval call binding the contract method 0x8a883626.
+//
+// Solidity: function getBlockNumberFromHeader(bytes header) view returns(uint256)
+func (_Election *ElectionSession) GetBlockNumberFromHeader(header []byte) (*big.Int, error) {
+	return _Election.Contract.GetBlockNumberFromHeader(&_Election.CallOpts, header)
+}
+
+// GetBlockNumberFromHeader is a free data retrieval call binding the contract method 0x8a883626.
+//
+// Solidity: function getBlockNumberFromHeader(bytes header) view returns(uint256)
+func (_Election *ElectionCallerSession) GetBlockNumberFromHeader(header []byte) (*big.Int, error) {
+	return _Election.Contract.GetBlockNumberFromHeader(&_Election.CallOpts, header)
+}
+
+// GetCurrentValidatorSigners is a free data retrieval call binding the contract method 0x448144c8.
+//
+// Solidity: function getCurrentValidatorSigners() view returns(address[])
+func (_Election *ElectionCaller) GetCurrentValidatorSigners(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getCurrentValidatorSigners")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetCurrentValidatorSigners is a free data retrieval call binding the contract method 0x448144c8.
+//
+// Solidity: function getCurrentValidatorSigners() view returns(address[])
+func (_Election *ElectionSession) GetCurrentValidatorSigners() ([]common.Address, error) {
+	return _Election.Contract.GetCurrentValidatorSigners(&_Election.CallOpts)
+}
+
+// GetCurrentValidatorSigners is a free data retrieval call binding the contract method 0x448144c8.
+//
+// Solidity: function getCurrentValidatorSigners() view returns(address[])
+func (_Election *ElectionCallerSession) GetCurrentValidatorSigners() ([]common.Address, error) {
+	return _Election.Contract.GetCurrentValidatorSigners(&_Election.CallOpts)
+}
+
+// GetElectabilityThreshold is a free data retrieval call binding the contract method 0xbdd14318.
+//
+// Solidity: function getElectabilityThreshold() view returns(uint256)
+func (_Election *ElectionCaller) GetElectabilityThreshold(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getElectabilityThreshold")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetElectabilityThreshold is a free data retrieval call binding the contract method 0xbdd14318.
+//
+// Solidity: function getElectabilityThreshold() view returns(uint256)
+func (_Election *ElectionSession) GetElectabilityThreshold() (*big.Int, error) {
+	return _Election.Contract.GetElectabilityThreshold(&_Election.CallOpts)
+}
+
+// GetElectabilityThreshold is a free data retrieval call binding the contract method 0xbdd14318.
+//
+// Solidity: function getElectabilityThreshold() view returns(uint256)
+func (_Election *ElectionCallerSession) GetElectabilityThreshold() (*big.Int, error) {
+	return _Election.Contract.GetElectabilityThreshold(&_Election.CallOpts)
+}
+
+// GetElectableValidators is a free data retrieval call binding the contract method 0xf9f41a7a.
+//
+// Solidity: function getElectableValidators() view returns(uint256, uint256)
+func (_Election *ElectionCaller) GetElectableValidators(opts *bind.CallOpts) (*big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getElectableValidators")
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// GetElectableValidators is a free data retrieval call binding the contract method 0xf9f41a7a.
+//
+// Solidity: function getElectableValidators() view returns(uint256, uint256)
+func (_Election *ElectionSession) GetElectableValidators() (*big.Int, *big.Int, error) {
+	return _Election.Contract.GetElectableValidators(&_Election.CallOpts)
+}
+
+// GetElectableValidators is a free data retrieval call binding the contract method 0xf9f41a7a.
+//
+// Solidity: function getElectableValidators() view returns(uint256, uint256)
+func (_Election *ElectionCallerSession) GetElectableValidators() (*big.Int, *big.Int, error) {
+	return _Election.Contract.GetElectableValidators(&_Election.CallOpts)
+}
+
+// GetEligibleValidators is a free data retrieval call binding the contract method 0x72672ae4.
+//
+// Solidity: function getEligibleValidators() view returns(address[])
+func (_Election *ElectionCaller) GetEligibleValidators(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getEligibleValidators")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetEligibleValidators is a free data retrieval call binding the contract method 0x72672ae4.
+//
+// Solidity: function getEligibleValidators() view returns(address[])
+func (_Election *ElectionSession) GetEligibleValidators() ([]common.Address, error) {
+	return _Election.Contract.GetEligibleValidators(&_Election.CallOpts)
+}
+
+// GetEligibleValidators is a free data retrieval call binding the contract method 0x72672ae4.
+//
+// Solidity: function getEligibleValidators() view returns(address[])
+func (_Election *ElectionCallerSession) GetEligibleValidators() ([]common.Address, error) {
+	return _Election.Contract.GetEligibleValidators(&_Election.CallOpts)
+}
+
+// GetEpochNumber is a free data retrieval call binding the contract method 0x9a7b3be7.
+//
+// Solidity: function getEpochNumber() view returns(uint256)
+func (_Election *ElectionCaller) GetEpochNumber(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getEpochNumber")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetEpochNumber is a free data retrieval call binding the contract method 0x9a7b3be7.
+//
+// Solidity: function getEpochNumber() view returns(uint256)
+func (_Election *ElectionSession) GetEpochNumber() (*big.Int, error) {
+	return _Election.Contract.GetEpochNumber(&_Election.CallOpts)
+}
+
+// GetEpochNumber is a free data retrieval call binding the contract method 0x9a7b3be7.
+//
+// Solidity: function getEpochNumber() view returns(uint256)
+func (_Election *ElectionCallerSession) GetEpochNumber() (*big.Int, error) {
+	return _Election.Contract.GetEpochNumber(&_Election.CallOpts)
+}
+
+// GetEpochNumberOfBlock is a free data retrieval call binding the contract method 0x3b1eb4bf.
+//
+// Solidity: function getEpochNumberOfBlock(uint256 blockNumber) view returns(uint256)
+func (_Election *ElectionCaller) GetEpochNumberOfBlock(opts *bind.CallOpts, blockNumber *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getEpochNumberOfBlock", blockNumber)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetEpochNumberOfBlock is a free data retrieval call binding the contract method 0x3b1eb4bf.
+//
+// Solidity: function getEpochNumberOfBlock(uint256 blockNumber) view returns(uint256)
+func (_Election *ElectionSession) GetEpochNumberOfBlock(blockNumber *big.Int) (*big.Int, error) {
+	return _Election.Contract.GetEpochNumberOfBlock(&_Election.CallOpts, blockNumber)
+}
+
+// GetEpochNumberOfBlock is a free data retrieval call binding the contract method 0x3b1eb4bf.
+//
+// Solidity: function getEpochNumberOfBlock(uint256 blockNumber) view returns(uint256)
+func (_Election *ElectionCallerSession) GetEpochNumberOfBlock(blockNumber *big.Int) (*big.Int, error) {
+	return _Election.Contract.GetEpochNumberOfBlock(&_Election.CallOpts, blockNumber)
+}
+
+// GetEpochSize is a free data retrieval call binding the contract method 0xdf4da461.
+//
+// Solidity: function getEpochSize() view returns(uint256)
+func (_Election *ElectionCaller) GetEpochSize(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getEpochSize")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetEpochSize is a free data retrieval call binding the contract method 0xdf4da461.
+//
+// Solidity: function getEpochSize() view returns(uint256)
+func (_Election *ElectionSession) GetEpochSize() (*big.Int, error) {
+	return _Election.Contract.GetEpochSize(&_Election.CallOpts)
+}
+
+// GetEpochSize is a free data retrieval call binding the contract method 0xdf4da461.
+//
+// Solidity: function getEpochSize() view returns(uint256)
+func (_Election *ElectionCallerSession) GetEpochSize() (*big.Int, error) {
+	return _Election.Contract.GetEpochSize(&_Election.CallOpts)
+}
+
+// GetNumVotesReceivable is a free data retrieval call binding the contract method 0x2f047e06.
+//
+// Solidity: function getNumVotesReceivable() view returns(uint256)
+func (_Election *ElectionCaller) GetNumVotesReceivable(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getNumVotesReceivable")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetNumVotesReceivable is a free data retrieval call binding the contract method 0x2f047e06.
+//
+// Solidity: function getNumVotesReceivable() view returns(uint256)
+func (_Election *ElectionSession) GetNumVotesReceivable() (*big.Int, error) {
+	return _Election.Contract.GetNumVotesReceivable(&_Election.CallOpts)
+}
+
+// GetNumVotesReceivable is a free data retrieval call binding the contract method 0x2f047e06.
+//
+// Solidity: function getNumVotesReceivable() view returns(uint256)
+func (_Election *ElectionCallerSession) GetNumVotesReceivable() (*big.Int, error) {
+	return _Election.Contract.GetNumVotesReceivable(&_Election.CallOpts)
+}
+
+// GetParentSealBitmap is a free data retrieval call binding the contract method 0xfae8db0a.
+//
+// Solidity: function getParentSealBitmap(uint256 blockNumber) view returns(bytes32)
+func (_Election *ElectionCaller) GetParentSealBitmap(opts *bind.CallOpts, blockNumber *big.Int) ([32]byte, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getParentSealBitmap", blockNumber)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetParentSealBitmap is a free data retrieval call binding the contract method 0xfae8db0a.
+//
+// Solidity: function getParentSealBitmap(uint256 blockNumber) view returns(bytes32)
+func (_Election *ElectionSession) GetParentSealBitmap(blockNumber *big.Int) ([32]byte, error) {
+	return _Election.Contract.GetParentSealBitmap(&_Election.CallOpts, blockNumber)
+}
+
+// GetParentSealBitmap is a free data retrieval call binding the contract method 0xfae8db0a.
+//
+// Solidity: function getParentSealBitmap(uint256 blockNumber) view returns(bytes32)
+func (_Election *ElectionCallerSession) GetParentSealBitmap(blockNumber *big.Int) ([32]byte, error) {
+	return _Election.Contract.GetParentSealBitmap(&_Election.CallOpts, blockNumber)
+}
+
+// GetPendingVotersForValidator is a free data retrieval call binding the contract method 0x869ce422.
+//
+// Solidity: function getPendingVotersForValidator(address validator) view returns(address[])
+func (_Election *ElectionCaller) GetPendingVotersForValidator(opts *bind.CallOpts, validator common.Address) ([]common.Address, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getPendingVotersForValidator", validator)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetPendingVotersForValidator is a free data retrieval call binding the contract method 0x869ce422.
+//
+// Solidity: function getPendingVotersForValidator(address validator) view returns(address[])
+func (_Election *ElectionSession) GetPendingVotersForValidator(validator common.Address) ([]common.Address, error) {
+	return _Election.Contract.GetPendingVotersForValidator(&_Election.CallOpts, validator)
+}
+
+// GetPendingVotersForValidator is a free data retrieval call binding the contract method 0x869ce422.
+//
+// Solidity: function getPendingVotersForValidator(address validator) view returns(address[])
+func (_Election *ElectionCallerSession) GetPendingVotersForValidator(validator common.Address) ([]common.Address, error) {
+	return _Election.Contract.GetPendingVotersForValidator(&_Election.CallOpts, validator)
+}
+
+// GetPendingVotesForValidator is a free data retrieval call binding the contract method 0xa38b3ab1.
+//
+// Solidity: function getPendingVotesForValidator(address validator) view returns(uint256)
+func (_Election *ElectionCaller) GetPendingVotesForValidator(opts *bind.CallOpts, validator common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getPendingVotesForValidator", validator)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetPendingVotesForValidator is a free data retrieval call binding the contract method 0xa38b3ab1.
+//
+// Solidity: function getPendingVotesForValidator(address validator) view returns(uint256)
+func (_Election *ElectionSession) GetPendingVotesForValidator(validator common.Address) (*big.Int, error) {
+	return _Election.Contract.GetPendingVotesForValidator(&_Election.CallOpts, validator)
+}
+
+// GetPendingVotesForValidator is a free data retrieval call binding the contract method 0xa38b3ab1.
+//
+// Solidity: function getPendingVotesForValidator(address validator) view returns(uint256)
+func (_Election *ElectionCallerSession) GetPendingVotesForValidator(validator common.Address) (*big.Int, error) {
+	return _Election.Contract.GetPendingVotesForValidator(&_Election.CallOpts, validator)
+}
+
+// GetPendingVotesForValidatorByAccount is a free data retrieval call binding the contract method 0xe884ae85.
+//
+// Solidity: function getPendingVotesForValidatorByAccount(address validator, address account) view returns(uint256)
+func (_Election *ElectionCaller) GetPendingVotesForValidatorByAccount(opts *bind.CallOpts, validator common.Address, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getPendingVotesForValidatorByAccount", validator, account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetPendingVotesForValidatorByAccount is a free data retrieval call binding the contract method 0xe884ae85.
+//
+// Solidity: function getPendingVotesForValidatorByAccount(address validator, address account) view returns(uint256)
+func (_Election *ElectionSession) GetPendingVotesForValidatorByAccount(validator common.Address, account common.Address) (*big.Int, error) {
+	return _Election.Contract.GetPendingVotesForValidatorByAccount(&_Election.CallOpts, validator, account)
+}
+
+// GetPendingVotesForValidatorByAccount is a free data retrieval call binding the contract method 0xe884ae85.
+//
+// Solidity: function getPendingVotesForValidatorByAccount(address validator, address account) view returns(uint256)
+func (_Election *ElectionCallerSession) GetPendingVotesForValidatorByAccount(validator common.Address, account common.Address) (*big.Int, error) {
+	return _Election.Contract.GetPendingVotesForValidatorByAccount(&_Election.CallOpts, validator, account)
+}
+
+// GetTopValidators is a free data retrieval call binding the contract method 0x93a5b1b6.
+//
+// Solidity: function getTopValidators(uint256 topNum) view returns(address[])
+func (_Election *ElectionCaller) GetTopValidators(opts *bind.CallOpts, topNum *big.Int) ([]common.Address, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getTopValidators", topNum)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetTopValidators is a free data retrieval call binding the contract method 0x93a5b1b6.
+//
+// Solidity: function getTopValidators(uint256 topNum) view returns(address[])
+func (_Election *ElectionSession) GetTopValidators(topNum *big.Int) ([]common.Address, error) {
+	return _Election.Contract.GetTopValidators(&_Election.CallOpts, topNum)
+}
+
+// GetTopValidators is a free data retrieval call binding the contract method 0x93a5b1b6.
+//
+// Solidity: function getTopValidators(uint256 topNum) view returns(address[])
+func (_Election *ElectionCallerSession) GetTopValidators(topNum *big.Int) ([]common.Address, error) {
+	return _Election.Contract.GetTopValidators(&_Election.CallOpts, topNum)
+}
+
+// GetTotalVotes is a free data retrieval call binding the contract method 0x9a0e7d66.
+//
+// Solidity: function getTotalVotes() view returns(uint256)
+func (_Election *ElectionCaller) GetTotalVotes(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getTotalVotes")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTotalVotes is a free data retrieval call binding the contract method 0x9a0e7d66.
+//
+// Solidity: function getTotalVotes() view returns(uint256)
+func (_Election *ElectionSession) GetTotalVotes() (*big.Int, error) {
+	return _Election.Contract.GetTotalVotes(&_Election.CallOpts)
+}
+
+// GetTotalVotes is a free data retrieval call binding the contract method 0x9a0e7d66.
+//
+// Solidity: function getTotalVotes() view returns(uint256)
+func (_Election *ElectionCallerSession) GetTotalVotes() (*big.Int, error) {
+	return _Election.Contract.GetTotalVotes(&_Election.CallOpts)
+}
+
+// GetTotalVotesByAccount is a free data retrieval call binding the contract method 0x6c781a2c.
+//
+// Solidity: function getTotalVotesByAccount(address account) view returns(uint256)
+func (_Election *ElectionCaller) GetTotalVotesByAccount(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getTotalVotesByAccount", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTotalVotesByAccount is a free data retrieval call binding the contract method 0x6c781a2c.
+//
+// Solidity: function getTotalVotesByAccount(address account) view returns(uint256)
+func (_Election *ElectionSession) GetTotalVotesByAccount(account common.Address) (*big.Int, error) {
+	return _Election.Contract.GetTotalVotesByAccount(&_Election.CallOpts, account)
+}
+
+// GetTotalVotesByAccount is a free data retrieval call binding the contract method 0x6c781a2c.
+//
+// Solidity: function getTotalVotesByAccount(address account) view returns(uint256)
+func (_Election *ElectionCallerSession) GetTotalVotesByAccount(account common.Address) (*big.Int, error) {
+	return _Election.Contract.GetTotalVotesByAccount(&_Election.CallOpts, account)
+}
+
+// GetTotalVotesForEligibleValidators is a free data retrieval call binding the contract method 0x6ee242c0.
+//
+// Solidity: function getTotalVotesForEligibleValidators() view returns(address[] validators, uint256[] values)
+func (_Election *ElectionCaller) GetTotalVotesForEligibleValidators(opts *bind.CallOpts) (struct {
+	Validators []common.Address
+	Values     []*big.Int
+}, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getTotalVotesForEligibleValidators")
+
+	outstruct := new(struct {
+		Validators []common.Address
+		Values     []*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Validators = *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+	outstruct.Values = *abi.ConvertType(out[1], new([]*big.Int)).(*[]*big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetTotalVotesForEligibleValidators is a free data retrieval call binding the contract method 0x6ee242c0.
+//
+// Solidity: function getTotalVotesForEligibleValidators() view returns(address[] validators, uint256[] values)
+func (_Election *ElectionSession) GetTotalVotesForEligibleValidators() (struct {
+	Validators []common.Address
+	Values     []*big.Int
+}, error) {
+	return _Election.Contract.GetTotalVotesForEligibleValidators(&_Election.CallOpts)
+}
+
+// GetTotalVotesForEligibleValidators is a free data retrieval call binding the contract method 0x6ee242c0.
+//
+// Solidity: function getTotalVotesForEligibleValidators() view returns(address[] validators, uint256[] values)
+func (_Election *ElectionCallerSession) GetTotalVotesForEligibleValidators() (struct {
+	Validators []common.Address
+	Values     []*big.Int
+}, error) {
+	return _Election.Contract.GetTotalVotesForEligibleValidators(&_Election.CallOpts)
+}
+
+// GetTotalVotesForValidator is a free data retrieval call binding the contract method 0xfdfda3ae.
+//
+// Solidity: function getTotalVotesForValidator(address validator) view returns(uint256)
+func (_Election *ElectionCaller) GetTotalVotesForValidator(opts *bind.CallOpts, validator common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getTotalVotesForValidator", validator)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTotalVotesForValidator is a free data retrieval call binding the contract method 0xfdfda3ae.
+//
+// Solidity: function getTotalVotesForValidator(address validator) view returns(uint256)
+func (_Election *ElectionSession) GetTotalVotesForValidator(validator common.Address) (*big.Int, error) {
+	return _Election.Contract.GetTotalVotesForValidator(&_Election.CallOpts, validator)
+}
+
+// GetTotalVotesForValidator is a free data retrieval call binding the contract method 0xfdfda3ae.
+//
+// Solidity: function getTotalVotesForValidator(address validator) view returns(uint256)
+func (_Election *ElectionCallerSession) GetTotalVotesForValidator(validator common.Address) (*big.Int, error) {
+	return _Election.Contract.GetTotalVotesForValidator(&_Election.CallOpts, validator)
+}
+
+// GetTotalVotesForValidatorByAccount is a free data retrieval call binding the contract method 0x317b79cd.
+//
+// Solidity: function getTotalVotesForValidatorByAccount(address validator, address account) view returns(uint256)
+func (_Election *ElectionCaller) GetTotalVotesForValidatorByAccount(opts *bind.CallOpts, validator common.Address, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getTotalVotesForValidatorByAccount", validator, account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTotalVotesForValidatorByAccount is a free data retrieval call binding the contract method 0x317b79cd.
+//
+// Solidity: function getTotalVotesForValidatorByAccount(address validator, address account) view returns(uint256)
+func (_Election *ElectionSession) GetTotalVotesForValidatorByAccount(validator common.Address, account common.Address) (*big.Int, error) {
+	return _Election.Contract.GetTotalVotesForValidatorByAccount(&_Election.CallOpts, validator, account)
+}
+
+// GetTotalVotesForValidatorByAccount is a free data retrieval call binding the contract method 0x317b79cd.
+//
+// Solidity: function getTotalVotesForValidatorByAccount(address validator, address account) view returns(uint256)
+func (_Election *ElectionCallerSession) GetTotalVotesForValidatorByAccount(validator common.Address, account common.Address) (*big.Int, error) {
+	return _Election.Contract.GetTotalVotesForValidatorByAccount(&_Election.CallOpts, validator, account)
+}
+
+// GetValidatorEligibility is a free data retrieval call binding the contract method 0x28fb8855.
+//
+// Solidity: function getValidatorEligibility(address validator) view returns(bool)
+func (_Election *ElectionCaller) GetValidatorEligibility(opts *bind.CallOpts, validator common.Address) (bool, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getValidatorEligibility", validator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// GetValidatorEligibility is a free data retrieval call binding the contract method 0x28fb8855.
+//
+// Solidity: function getValidatorEligibility(address validator) view returns(bool)
+func (_Election *ElectionSession) GetValidatorEligibility(validator common.Address) (bool, error) {
+	return _Election.Contract.GetValidatorEligibility(&_Election.CallOpts, validator)
+}
+
+// GetValidatorEligibility is a free data retrieval call binding the contract method 0x28fb8855.
+//
+// Solidity: function getValidatorEligibility(address validator) view returns(bool)
+func (_Election *ElectionCallerSession) GetValidatorEligibility(validator common.Address) (bool, error) {
+	return _Election.Contract.GetValidatorEligibility(&_Election.CallOpts, validator)
+}
+
+// GetValidatorsVotedForByAccount is a free data retrieval call binding the contract method 0x466b642b.
+//
+// Solidity: function getValidatorsVotedForByAccount(address account) view returns(address[])
+func (_Election *ElectionCaller) GetValidatorsVotedForByAccount(opts *bind.CallOpts, account common.Address) ([]common.Address, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getValidatorsVotedForByAccount", account)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetValidatorsVotedForByAccount is a free data retrieval call binding the contract method 0x466b642b.
+//
+// Solidity: function getValidatorsVotedForByAccount(address account) view returns(address[])
+func (_Election *ElectionSession) GetValidatorsVotedForByAccount(account common.Address) ([]common.Address, error) {
+	return _Election.Contract.GetValidatorsVotedForByAccount(&_Election.CallOpts, account)
+}
+
+// GetValidatorsVotedForByAccount is a free data retrieval call binding the contract method 0x466b642b.
+//
+// Solidity: function getValidatorsVotedForByAccount(address account) view returns(address[])
+func (_Election *ElectionCallerSession) GetValidatorsVotedForByAccount(account common.Address) ([]common.Address, error) {
+	return _Election.Contract.GetValidatorsVotedForByAccount(&_Election.CallOpts, account)
+}
+
+// GetVerifiedSealBitmapFromHeader is a free data retrieval call binding the contract method 0x4b2c2f44.
+//
+// Solidity: function getVerifiedSealBitmapFromHeader(bytes header) view returns(bytes32)
+func (_Election *ElectionCaller) GetVerifiedSealBitmapFromHeader(opts *bind.CallOpts, header []byte) ([32]byte, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getVerifiedSealBitmapFromHeader", header)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetVerifiedSealBitmapFromHeader is a free data retrieval call binding the contract method 0x4b2c2f44.
+//
+// Solidity: function getVerifiedSealBitmapFromHeader(bytes header) view returns(bytes32)
+func (_Election *ElectionSession) GetVerifiedSealBitmapFromHeader(header []byte) ([32]byte, error) {
+	return _Election.Contract.GetVerifiedSealBitmapFromHeader(&_Election.CallOpts, header)
+}
+
+// GetVerifiedSealBitmapFromHeader is a free data retrieval call binding the contract method 0x4b2c2f44.
+//
+// Solidity: function getVerifiedSealBitmapFromHeader(bytes header) view returns(bytes32)
+func (_Election *ElectionCallerSession) GetVerifiedSealBitmapFromHeader(header []byte) ([32]byte, error) {
+	return _Election.Contract.GetVerifiedSealBitmapFromHeader(&_Election.CallOpts, header)
+}
+
+// GetVersionNumber is a free data retrieval call binding the contract method 0x54255be0.
+//
+// Solidity: function getVersionNumber() pure returns(uint256, uint256, uint256, uint256)
+func (_Election *ElectionCaller) GetVersionNumber(opts *bind.CallOpts) (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "getVersionNumber")
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	out2 := *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	out3 := *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return out0, out1, out2, out3, err
+
+}
+
+// GetVersionNumber is a free data retrieval call binding the contract method 0x54255be0.
+//
+// Solidity: function getVersionNumber() pure returns(uint256, uint256, uint256, uint256)
+func (_Election *ElectionSession) GetVersionNumber() (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	return _Election.Contract.GetVersionNumber(&_Election.CallOpts)
+}
+
+// GetVersionNumber is a free data retrieval call binding the contract method 0x54255be0.
+//
+// Solidity: function getVersionNumber() pure returns(uint256, uint256, uint256, uint256)
+func (_Election *ElectionCallerSession) GetVersionNumber() (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	return _Election.Contract.GetVersionNumber(&_Election.CallOpts)
+}
+
+// HasActivatablePendingVotes is a free data retrieval call binding the contract method 0x263ecf74.
+//
+// Solidity: function hasActivatablePendingVotes(address account, address validator) view returns(bool)
+func (_Election *ElectionCaller) HasActivatablePendingVotes(opts *bind.CallOpts, account common.Address, validator common.Address) (bool, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "hasActivatablePendingVotes", account, validator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasActivatablePendingVotes is a free data retrieval call binding the contract method 0x263ecf74.
+//
+// Solidity: function hasActivatablePendingVotes(address account, address validator) view returns(bool)
+func (_Election *ElectionSession) HasActivatablePendingVotes(account common.Address, validator common.Address) (bool, error) {
+	return _Election.Contract.HasActivatablePendingVotes(&_Election.CallOpts, account, validator)
+}
+
+// HasActivatablePendingVotes is a free data retrieval call binding the contract method 0x263ecf74.
+//
+// Solidity: function hasActivatablePendingVotes(address account, address validator) view returns(bool)
+func (_Election *ElectionCallerSession) HasActivatablePendingVotes(account common.Address, validator common.Address) (bool, error) {
+	return _Election.Contract.HasActivatablePendingVotes(&_Election.CallOpts, account, validator)
+}
+
+// HashHeader is a free data retrieval call binding the contract method 0x67960e91.
+//
+// Solidity: function hashHeader(bytes header) view returns(bytes32)
+func (_Election *ElectionCaller) HashHeader(opts *bind.CallOpts, header []byte) ([32]byte, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "hashHeader", header)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// HashHeader is a free data retrieval call binding the contract method 0x67960e91.
+//
+// Solidity: function hashHeader(bytes header) view returns(bytes32)
+func (_Election *ElectionSession) HashHeader(header []byte) ([32]byte, error) {
+	return _Election.Contract.HashHeader(&_Election.CallOpts, header)
+}
+
+// HashHeader is a free data retrieval call binding the contract method 0x67960e91.
+//
+// Solidity: function hashHeader(bytes header) view returns(bytes32)
+func (_Election *ElectionCallerSession) HashHeader(header []byte) ([32]byte, error) {
+	return _Election.Contract.HashHeader(&_Election.CallOpts, header)
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() view returns(bool)
+func (_Election *ElectionCaller) Initialized(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "initialized")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() view returns(bool)
+func (_Election *ElectionSession) Initialized() (bool, error) {
+	return _Election.Contract.Initialized(&_Election.CallOpts)
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() view returns(bool)
+func (_Election *ElectionCallerSession) Initialized() (bool, error) {
+	return _Election.Contract.Initialized(&_Election.CallOpts)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_Election *ElectionCaller) IsOwner(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "isOwner")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_Election *ElectionSession) IsOwner() (bool, error) {
+	return _Election.Contract.IsOwner(&_Election.CallOpts)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_Election *ElectionCallerSession) IsOwner() (bool, error) {
+	return _Election.Contract.IsOwner(&_Election.CallOpts)
+}
+
+// MaxNumValidatorsVotedFor is a free data retrieval call binding the contract method 0xcc33e74d.
+//
+// Solidity: function maxNumValidatorsVotedFor() view returns(uint256)
+func (_Election *ElectionCaller) MaxNumValidatorsVotedFor(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "maxNumValidatorsVotedFor")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxNumValidatorsVotedFor is a free data retrieval call binding the contract method 0xcc33e74d.
+//
+// Solidity: function maxNumValidatorsVotedFor() view returns(uint256)
+func (_Election *ElectionSession) MaxNumValidatorsVotedFor() (*big.Int, error) {
+	return _Election.Contract.MaxNumValidatorsVotedFor(&_Election.CallOpts)
+}
+
+// MaxNumValidatorsVotedFor is a free data retrieval call binding the contract method 0xcc33e74d.
+//
+// Solidity: function maxNumValidatorsVotedFor() view returns(uint256)
+func (_Election *ElectionCallerSession) MaxNumValidatorsVotedFor() (*big.Int, error) {
+	return _Election.Contract.MaxNumValidatorsVotedFor(&_Election.CallOpts)
+}
+
+// MinQuorumSize is a free data retrieval call binding the contract method 0xe50e652d.
+//
+// Solidity: function minQuorumSize(uint256 blockNumber) view returns(uint256)
+func (_Election *ElectionCaller) MinQuorumSize(opts *bind.CallOpts, blockNumber *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "minQuorumSize", blockNumber)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinQuorumSize is a free data retrieval call binding the contract method 0xe50e652d.
+//
+// Solidity: function minQuorumSize(uint256 blockNumber) view returns(uint256)
+func (_Election *ElectionSession) MinQuorumSize(blockNumber *big.Int) (*big.Int, error) {
+	return _Election.Contract.MinQuorumSize(&_Election.CallOpts, blockNumber)
+}
+
+// MinQuorumSize is a free data retrieval call binding the contract method 0xe50e652d.
+//
+// Solidity: function minQuorumSize(uint256 blockNumber) view returns(uint256)
+func (_Election *ElectionCallerSession) MinQuorumSize(blockNumber *big.Int) (*big.Int, error) {
+	return _Election.Contract.MinQuorumSize(&_Election.CallOpts, blockNumber)
+}
+
+// MinQuorumSizeInCurrentSet is a free data retrieval call binding the contract method 0x7385e5da.
+//
+// Solidity: function minQuorumSizeInCurrentSet() view returns(uint256)
+func (_Election *ElectionCaller) MinQuorumSizeInCurrentSet(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "minQuorumSizeInCurrentSet")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinQuorumSizeInCurrentSet is a free data retrieval call binding the contract method 0x7385e5da.
+//
+// Solidity: function minQuorumSizeInCurrentSet() view returns(uint256)
+func (_Election *ElectionSession) MinQuorumSizeInCurrentSet() (*big.Int, error) {
+	return _Election.Contract.MinQuorumSizeInCurrentSet(&_Election.CallOpts)
+}
+
+// MinQuorumSizeInCurrentSet is a free data retrieval call binding the contract method 0x7385e5da.
+//
+// Solidity: function minQuorumSizeInCurrentSet() view returns(uint256)
+func (_Election *ElectionCallerSession) MinQuorumSizeInCurrentSet() (*big.Int, error) {
+	return _Election.Contract.MinQuorumSizeInCurrentSet(&_Election.CallOpts)
+}
+
+// NumberValidatorsInCurrentSet is a free data retrieval call binding the contract method 0x87ee8a0f.
+//
+// Solidity: function numberValidatorsInCurrentSet() view returns(uint256)
+func (_Election *ElectionCaller) NumberValidatorsInCurrentSet(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "numberValidatorsInCurrentSet")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NumberValidatorsInCurrentSet is a free data retrieval call binding the contract method 0x87ee8a0f.
+//
+// Solidity: function numberValidatorsInCurrentSet() view returns(uint256)
+func (_Election *ElectionSession) NumberValidatorsInCurrentSet() (*big.Int, error) {
+	return _Election.Contract.NumberValidatorsInCurrentSet(&_Election.CallOpts)
+}
+
+// NumberValidatorsInCurrentSet is a free data retrieval call binding the contract method 0x87ee8a0f.
+//
+// Solidity: function numberValidatorsInCurrentSet() view returns(uint256)
+func (_Election *ElectionCallerSession) NumberValidatorsInCurrentSet() (*big.Int, error) {
+	return _Election.Contract.NumberValidatorsInCurrentSet(&_Election.CallOpts)
+}
+
+// NumberValidatorsInSet is a free data retrieval call binding the contract method 0x9b2b592f.
+//
+// Solidity: function numberValidatorsInSet(uint256 blockNumber) view returns(uint256)
+func (_Election *ElectionCaller) NumberValidatorsInSet(opts *bind.CallOpts, blockNumber *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "numberValidatorsInSet", blockNumber)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NumberValidatorsInSet is a free data retrieval call binding the contract method 0x9b2b592f.
+//
+// Solidity: function numberValidatorsInSet(uint256 blockNumber) view returns(uint256)
+func (_Election *ElectionSession) NumberValidatorsInSet(blockNumber *big.Int) (*big.Int, error) {
+	return _Election.Contract.NumberValidatorsInSet(&_Election.CallOpts, blockNumber)
+}
+
+// NumberValidatorsInSet is a free data retrieval call binding the contract method 0x9b2b592f.
+//
+// Solidity: function numberValidatorsInSet(uint256 blockNumber) view returns(uint256)
+func (_Election *ElectionCallerSession) NumberValidatorsInSet(blockNumber *big.Int) (*big.Int, error) {
+	return _Election.Contract.NumberValidatorsInSet(&_Election.CallOpts, blockNumber)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Election *ElectionCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Election *ElectionSession) Owner() (common.Address, error) {
+	return _Election.Contract.Owner(&_Election.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Election *ElectionCallerSession) Owner() (common.Address, error) {
+	return _Election.Contract.Owner(&_Election.CallOpts)
+}
+
+// PendingInfo is a free data retrieval call binding the contract method 0x2341855f.
+//
+// Solidity: function pendingInfo(address account, address validator) view returns(uint256, uint256)
+func (_Election *ElectionCaller) PendingInfo(opts *bind.CallOpts, account common.Address, validator common.Address) (*big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "pendingInfo", account, validator)
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// PendingInfo is a free data retrieval call binding the contract method 0x2341855f.
+//
+// Solidity: function pendingInfo(address account, address validator) view returns(uint256, uint256)
+func (_Election *ElectionSession) PendingInfo(account common.Address, validator common.Address) (*big.Int, *big.Int, error) {
+	return _Election.Contract.PendingInfo(&_Election.CallOpts, account, validator)
+}
+
+// PendingInfo is a free data retrieval call binding the contract method 0x2341855f.
+//
+// Solidity: function pendingInfo(address account, address validator) view returns(uint256, uint256)
+func (_Election *ElectionCallerSession) PendingInfo(account common.Address, validator common.Address) (*big.Int, *big.Int, error) {
+	return _Election.Contract.PendingInfo(&_Election.CallOpts, account, validator)
+}
+
+// Registry is a free data retrieval call binding the contract method 0x7b103999.
+//
+// Solidity: function registry() view returns(address)
+func (_Election *ElectionCaller) Registry(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "registry")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Registry is a free data retrieval call binding the contract method 0x7b103999.
+//
+// Solidity: function registry() view returns(address)
+func (_Election *ElectionSession) Registry() (common.Address, error) {
+	return _Election.Contract.Registry(&_Election.CallOpts)
+}
+
+// Registry is a free data retrieval call binding the contract method 0x7b103999.
+//
+// Solidity: function registry() view returns(address)
+func (_Election *ElectionCallerSession) Registry() (common.Address, error) {
+	return _Election.Contract.Registry(&_Election.CallOpts)
+}
+
+// ValidatorSignerAddressFromCurrentSet is a free data retrieval call binding the contract method 0x123633ea.
+//
+// Solidity: function validatorSignerAddressFromCurrentSet(uint256 index) view returns(address)
+func (_Election *ElectionCaller) ValidatorSignerAddressFromCurrentSet(opts *bind.CallOpts, index *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "validatorSignerAddressFromCurrentSet", index)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ValidatorSignerAddressFromCurrentSet is a free data retrieval call binding the contract method 0x123633ea.
+//
+// Solidity: function validatorSignerAddressFromCurrentSet(uint256 index) view returns(address)
+func (_Election *ElectionSession) ValidatorSignerAddressFromCurrentSet(index *big.Int) (common.Address, error) {
+	return _Election.Contract.ValidatorSignerAddressFromCurrentSet(&_Election.CallOpts, index)
+}
+
+// ValidatorSignerAddressFromCurrentSet is a free data retrieval call binding the contract method 0x123633ea.
+//
+// Solidity: function validatorSignerAddressFromCurrentSet(uint256 index) view returns(address)
+func (_Election *ElectionCallerSession) ValidatorSignerAddressFromCurrentSet(index *big.Int) (common.Address, error) {
+	return _Election.Contract.ValidatorSignerAddressFromCurrentSet(&_Election.CallOpts, index)
+}
+
+// ValidatorSignerAddressFromSet is a free data retrieval call binding the contract method 0x5d180adb.
+//
+// Solidity: function validatorSignerAddressFromSet(uint256 index, uint256 blockNumber) view returns(address)
+func (_Election *ElectionCaller) ValidatorSignerAddressFromSet(opts *bind.CallOpts, index *big.Int, blockNumber *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _Election.contract.Call(opts, &out, "validatorSignerAddressFromSet", index, blockNumber)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ValidatorSignerAddressFromSet is a free data retrieval call binding the contract method 0x5d180adb.
+//
+// Solidity: function validatorSignerAddressFromSet(uint256 index, uint256 blockNumber) view returns(address)
+func (_Election *ElectionSession) ValidatorSignerAddressFromSet(index *big.Int, blockNumber *big.Int) (common.Address, error) {
+	return _Election.Contract.ValidatorSignerAddressFromSet(&_Election.CallOpts, index, blockNumber)
+}
+
+// ValidatorSignerAddressFromSet is a free data retrieval call binding the contract method 0x5d180adb.
+//
+// Solidity: function validatorSignerAddressFromSet(uint256 index, uint256 blockNumber) view returns(address)
+func (_Election *ElectionCallerSession) ValidatorSignerAddressFromSet(index *big.Int, blockNumber *big.Int) (common.Address, error) {
+	return _Election.Contract.ValidatorSignerAddressFromSet(&_Election.CallOpts, index, blockNumber)
+}
+
+// Activate is a paid mutator transaction binding the contract method 0x1c5a9d9c.
+//
+// Solidity: function activate(address validator) returns(bool)
+func (_Election *ElectionTransactor) Activate(opts *bind.TransactOpts, validator common.Address) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "activate", validator)
+}
+
+// Activate is a paid mutator transaction binding the contract method 0x1c5a9d9c.
+//
+// Solidity: function activate(address validator) returns(bool)
+func (_Election *ElectionSession) Activate(validator common.Address) (*types.Transaction, error) {
+	return _Election.Contract.Activate(&_Election.TransactOpts, validator)
+}
+
+// Activate is a paid mutator transaction binding the contract method 0x1c5a9d9c.
+//
+// Solidity: function activate(address validator) returns(bool)
+func (_Election *ElectionTransactorSession) Activate(validator common.Address) (*types.Transaction, error) {
+	return _Election.Contract.Activate(&_Election.TransactOpts, validator)
+}
+
+// ActivateForAccount is a paid mutator transaction binding the contract method 0xc14470c4.
+//
+// Solidity: function activateForAccount(address validator, address account) returns(bool)
+func (_Election *ElectionTransactor) ActivateForAccount(opts *bind.TransactOpts, validator common.Address, account common.Address) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "activateForAccount", validator, account)
+}
+
+// ActivateForAccount is a paid mutator transaction binding the contract method 0xc14470c4.
+//
+// Solidity: function activateForAccount(address validator, address account) returns(bool)
+func (_Election *ElectionSession) ActivateForAccount(validator common.Address, account common.Address) (*types.Transaction, error) {
+	return _Election.Contract.ActivateForAccount(&_Election.TransactOpts, validator, account)
+}
+
+// ActivateForAccount is a paid mutator transaction binding the contract method 0xc14470c4.
+//
+// Solidity: function activateForAccount(address validator, address account) returns(bool)
+func (_Election *ElectionTransactorSession) ActivateForAccount(validator common.Address, account common.Address) (*types.Transaction, error) {
+	return _Election.Contract.ActivateForAccount(&_Election.TransactOpts, validator, account)
+}
+
+// ActiveAllPending is a paid mutator transaction binding the contract method 0x8258edd0.
+//
+// Solidity: function activeAllPending(address[] validators) returns(bool)
+func (_Election *ElectionTransactor) ActiveAllPending(opts *bind.TransactOpts, validators []common.Address) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "activeAllPending", validators)
+}
+
+// ActiveAllPending is a paid mutator transaction binding the contract method 0x8258edd0.
+//
+// Solidity: function activeAllPending(address[] validators) returns(bool)
+func (_Election *ElectionSession) ActiveAllPending(validators []common.Address) (*types.Transaction, error) {
+	return _Election.Contract.ActiveAllPending(&_Election.TransactOpts, validators)
+}
+
+// ActiveAllPending is a paid mutator transaction binding the contract method 0x8258edd0.
+//
+// Solidity: function activeAllPending(address[] validators) returns(bool)
+func (_Election *ElectionTransactorSession) ActiveAllPending(validators []common.Address) (*types.Transaction, error) {
+	return _Election.Contract.ActiveAllPending(&_Election.TransactOpts, validators)
+}
+
+// DistributeEpochVotersRewards is a paid mutator transaction binding the contract method 0x5545e936.
+//
+// Solidity: function distributeEpochVotersRewards(address validator, uint256 value, address lesser, address greater) returns()
+func (_Election *ElectionTransactor) DistributeEpochVotersRewards(opts *bind.TransactOpts, validator common.Address, value *big.Int, lesser common.Address, greater common.Address) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "distributeEpochVotersRewards", validator, value, lesser, greater)
+}
+
+// DistributeEpochVotersRewards is a paid mutator transaction binding the contract method 0x5545e936.
+//
+// Solidity: function distributeEpochVotersRewards(address validator, uint256 value, address lesser, address greater) returns()
+func (_Election *ElectionSession) DistributeEpochVotersRewards(validator common.Address, value *big.Int, lesser common.Address, greater common.Address) (*types.Transaction, error) {
+	return _Election.Contract.DistributeEpochVotersRewards(&_Election.TransactOpts, validator, value, lesser, greater)
+}
+
+// DistributeEpochVotersRewards is a paid mutator transaction binding the contract method 0x5545e936.
+//
+// Solidity: function distributeEpochVotersRewards(address validator, uint256 value, address lesser, address greater) returns()
+func (_Election *ElectionTransactorSession) DistributeEpochVotersRewards(validator common.Address, value *big.Int, lesser common.Address, greater common.Address) (*types.Transaction, error) {
+	return _Election.Contract.DistributeEpochVotersRewards(&_Election.TransactOpts, validator, value, lesser, greater)
+}
+
+// ForceDecrementVotes is a paid mutator transaction binding the contract method 0x8ef01def.
+//
+// Solidity: function forceDecrementVotes(address account, uint256 value, address[] lessers, address[] greaters, uint256[] indices) returns(uint256)
+func (_Election *ElectionTransactor) ForceDecrementVotes(opts *bind.TransactOpts, account common.Address, value *big.Int, lessers []common.Address, greaters []common.Address, indices []*big.Int) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "forceDecrementVotes", account, value, lessers, greaters, indices)
+}
+
+// ForceDecrementVotes is a paid mutator transaction binding the contract method 0x8ef01def.
+//
+// Solidity: function forceDecrementVotes(address account, uint256 value, address[] lessers, address[] greaters, uint256[] indices) returns(uint256)
+func (_Election *ElectionSession) ForceDecrementVotes(account common.Address, value *big.Int, lessers []common.Address, greaters []common.Address, indices []*big.Int) (*types.Transaction, error) {
+	return _Election.Contract.ForceDecrementVotes(&_Election.TransactOpts, account, value, lessers, greaters, indices)
+}
+
+// ForceDecrementVotes is a paid mutator transaction binding the contract method 0x8ef01def.
+//
+// Solidity: function forceDecrementVotes(address account, uint256 value, address[] lessers, address[] greaters, uint256[] indices) returns(uint256)
+func (_Election *ElectionTransactorSession) ForceDecrementVotes(account common.Address, value *big.Int, lessers []common.Address, greaters []common.Address, indices []*big.Int) (*types.Transaction, error) {
+	return _Election.Contract.ForceDecrementVotes(&_Election.TransactOpts, account, value, lessers, greaters, indices)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xf92ad219.
+//
+// Solidity: function initialize(address registryAddress, uint256 minElectableValidators, uint256 maxElectableValidators, uint256 _maxNumValidatorsVotedFor, uint256 _electabilityThreshold) returns()
+func (_Election *ElectionTransactor) Initialize(opts *bind.TransactOpts, registryAddress common.Address, minElectableValidators *big.Int, maxElectableValidators *big.Int, _maxNumValidatorsVotedFor *big.Int, _electabilityThreshold *big.Int) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "initialize", registryAddress, minElectableValidators, maxElectableValidators, _maxNumValidatorsVotedFor, _electabilityThreshold)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xf92ad219.
+//
+// Solidity: function initialize(address registryAddress, uint256 minElectableValidators, uint256 maxElectableValidators, uint256 _maxNumValidatorsVotedFor, uint256 _electabilityThreshold) returns()
+func (_Election *ElectionSession) Initialize(registryAddress common.Address, minElectableValidators *big.Int, maxElectableValidators *big.Int, _maxNumValidatorsVotedFor *big.Int, _electabilityThreshold *big.Int) (*types.Transaction, error) {
+	return _Election.Contract.Initialize(&_Election.TransactOpts, registryAddress, minElectableValidators, maxElectableValidators, _maxNumValidatorsVotedFor, _electabilityThreshold)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xf92ad219.
+//
+// Solidity: function initialize(address registryAddress, uint256 minElectableValidators, uint256 maxElectableValidators, uint256 _maxNumValidatorsVotedFor, uint256 _electabilityThreshold) returns()
+func (_Election *ElectionTransactorSession) Initialize(registryAddress common.Address, minElectableValidators *big.Int, maxElectableValidators *big.Int, _maxNumValidatorsVotedFor *big.Int, _electabilityThreshold *big.Int) (*types.Transaction, error) {
+	return _Election.Contract.Initialize(&_Election.TransactOpts, registryAddress, minElectableValidators, maxElectableValidators, _maxNumValidatorsVotedFor, _electabilityThreshold)
+}
+
+// MarkValidatorEligible is a paid mutator transaction binding the contract method 0x55720182.
+//
+// Solidity: function markValidatorEligible(address lesser, address greater, address validator) returns()
+func (_Election *ElectionTransactor) MarkValidatorEligible(opts *bind.TransactOpts, lesser common.Address, greater common.Address, validator common.Address) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "markValidatorEligible", lesser, greater, validator)
+}
+
+// MarkValidatorEligible is a paid mutator transaction binding the contract method 0x55720182.
+//
+// Solidity: function markValidatorEligible(address lesser, address greater, address validator) returns()
+func (_Election *ElectionSession) MarkValidatorEligible(lesser common.Address, greater common.Address, validator common.Address) (*types.Transaction, error) {
+	return _Election.Contract.MarkValidatorEligible(&_Election.TransactOpts, lesser, greater, validator)
+}
+
+// MarkValidatorEligible is a paid mutator transaction binding the contract method 0x55720182.
+//
+// Solidity: function markValidatorEligible(address lesser, address greater, address validator) returns()
+func (_Election *ElectionTransactorSession) MarkValidatorEligible(lesser common.Address, greater common.Address, validator common.Address) (*types.Transaction, error) {
+	return _Election.Contract.MarkValidatorEligible(&_Election.TransactOpts, lesser, greater, validator)
+}
+
+// MarkValidatorIneligible is a paid mutator transaction binding the contract method 0xffe9fce3.
+//
+// Solidity: function markValidatorIneligible(address validator) returns()
+func (_Election *ElectionTransactor) MarkValidatorIneligible(opts *bind.TransactOpts, validator common.Address) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "markValidatorIneligible", validator)
+}
+
+// MarkValidatorIneligible is a paid mutator transaction binding the contract method 0xffe9fce3.
+//
+// Solidity: function markValidatorIneligible(address validator) returns()
+func (_Election *ElectionSession) MarkValidatorIneligible(validator common.Address) (*types.Transaction, error) {
+	return _Election.Contract.MarkValidatorIneligible(&_Election.TransactOpts, validator)
+}
+
+// MarkValidatorIneligible is a paid mutator transaction binding the contract method 0xffe9fce3.
+//
+// Solidity: function markValidatorIneligible(address validator) returns()
+func (_Election *ElectionTransactorSession) MarkValidatorIneligible(validator common.Address) (*types.Transaction, error) {
+	return _Election.Contract.MarkValidatorIneligible(&_Election.TransactOpts, validator)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Election *ElectionTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Election *ElectionSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Election.Contract.RenounceOwnership(&_Election.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Election *ElectionTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Election.Contract.RenounceOwnership(&_Election.TransactOpts)
+}
+
+// RevokeActive is a paid mutator transaction binding the contract method 0x6e198475.
+//
+// Solidity: function revokeActive(address validator, uint256 value, address lesser, address greater, uint256 index) returns(bool)
+func (_Election *ElectionTransactor) RevokeActive(opts *bind.TransactOpts, validator common.Address, value *big.Int, lesser common.Address, greater common.Address, index *big.Int) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "revokeActive", validator, value, lesser, greater, index)
+}
+
+// RevokeActive is a paid mutator transaction binding the contract method 0x6e198475.
+//
+// Solidity: function revokeActive(address validator, uint256 value, address lesser, address greater, uint256 index) returns(bool)
+func (_Election *ElectionSession) RevokeActive(validator common.Address, value *big.Int, lesser common.Address, greater common.Address, index *big.Int) (*types.Transaction, error) {
+	return _Election.Contract.RevokeActive(&_Election.TransactOpts, validator, value, lesser, greater, index)
+}
+
+// RevokeActive is a paid mutator transaction binding the contract method 0x6e198475.
+//
+// Solidity: function revokeActive(address validator, uint256 value, address lesser, address greater, uint256 index) returns(bool)
+func (_Election *ElectionTransactorSession) RevokeActive(validator common.Address, value *big.Int, lesser common.Address, greater common.Address, index *big.Int) (*types.Transaction, error) {
+	return _Election.Contract.RevokeActive(&_Election.TransactOpts, validator, value, lesser, greater, index)
+}
+
+// RevokeAllActive is a paid mutator transaction binding the contract method 0xe0a2ab52.
+//
+// Solidity: function revokeAllActive(address validator, address lesser, address greater, uint256 index) returns(bool)
+func (_Election *ElectionTransactor) RevokeAllActive(opts *bind.TransactOpts, validator common.Address, lesser common.Address, greater common.Address, index *big.Int) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "revokeAllActive", validator, lesser, greater, index)
+}
+
+// RevokeAllActive is a paid mutator transaction binding the contract method 0xe0a2ab52.
+//
+// Solidity: function revokeAllActive(address validator, address lesser, address greater, uint256 index) returns(bool)
+func (_Election *ElectionSession) RevokeAllActive(validator common.Address, lesser common.Address, greater common.Address, index *big.Int) (*types.Transaction, error) {
+	return _Election.Contract.RevokeAllActive(&_Election.TransactOpts, validator, lesser, greater, index)
+}
+
+// RevokeAllActive is a paid mutator transaction binding the contract method 0xe0a2ab52.
+//
+// Solidity: function revokeAllActive(address validator, address lesser, address greater, uint256 index) returns(bool)
+func (_Election *ElectionTransactorSession) RevokeAllActive(validator common.Address, lesser common.Address, greater common.Address, index *big.Int) (*types.Transaction, error) {
+	return _Election.Contract.RevokeAllActive(&_Election.TransactOpts, validator, lesser, greater, index)
+}
+
+// RevokePending is a paid mutator transaction binding the contract method 0x9dfb6081.
+//
+// Solidity: function revokePending(address validator, uint256 value, address lesser, address greater, uint256 index) returns(bool)
+func (_Election *ElectionTransactor) RevokePending(opts *bind.TransactOpts, validator common.Address, value *big.Int, lesser common.Address, greater common.Address, index *big.Int) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "revokePending", validator, value, lesser, greater, index)
+}
+
+// RevokePending is a paid mutator transaction binding the contract method 0x9dfb6081.
+//
+// Solidity: function revokePending(address validator, uint256 value, address lesser, address greater, uint256 index) returns(bool)
+func (_Election *ElectionSession) RevokePending(validator common.Address, value *big.Int, lesser common.Address, greater common.Address, index *big.Int) (*types.Transaction, error) {
+	return _Election.Contract.RevokePending(&_Election.TransactOpts, validator, value, lesser, greater, index)
+}
+
+// RevokePending is a paid mutator transaction binding the contract method 0x9dfb6081.
+//
+// Solidity: function revokePending(address validator, uint256 value, address lesser, address greater, uint256 index) returns(bool)
+func (_Election *ElectionTransactorSession) RevokePending(validator common.Address, value *big.Int, lesser common.Address, greater common.Address, index *big.Int) (*types.Transaction, error) {
+	return _Election.Contract.RevokePending(&_Election.TransactOpts, validator, value, lesser, greater, index)
+}
+
+// SetElectabilityThreshold is a paid mutator transaction binding the contract method 0x631db7e7.
+//
+// Solidity: function setElectabilityThreshold(uint256 threshold) returns(bool)
+func (_Election *ElectionTransactor) SetElectabilityThreshold(opts *bind.TransactOpts, threshold *big.Int) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "setElectabilityThreshold", threshold)
+}
+
+// SetElectabilityThreshold is a paid mutator transaction binding the contract method 0x631db7e7.
+//
+// Solidity: function setElectabilityThreshold(uint256 threshold) returns(bool)
+func (_Election *ElectionSession) SetElectabilityThreshold(threshold *big.Int) (*types.Transaction, error) {
+	return _Election.Contract.SetElectabilityThreshold(&_Election.TransactOpts, threshold)
+}
+
+// SetElectabilityThreshold is a paid mutator transaction binding the contract method 0x631db7e7.
+//
+// Solidity: function setElectabilityThreshold(uint256 threshold) returns(bool)
+func (_Election *ElectionTransactorSession) SetElectabilityThreshold(threshold *big.Int) (*types.Transaction, error) {
+	return _Election.Contract.SetElectabilityThreshold(&_Election.TransactOpts, threshold)
+}
+
+// SetElectableValidators is a paid mutator transaction binding the contract method 0xf911f0b7.
+//
+// Solidity: function setElectableValidators(uint256 min, uint256 max) returns(bool)
+func (_Election *ElectionTransactor) SetElectableValidators(opts *bind.TransactOpts, min *big.Int, max *big.Int) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "setElectableValidators", min, max)
+}
+
+// SetElectableValidators is a paid mutator transaction binding the contract method 0xf911f0b7.
+//
+// Solidity: function setElectableValidators(uint256 min, uint256 max) returns(bool)
+func (_Election *ElectionSession) SetElectableValidators(min *big.Int, max *big.Int) (*types.Transaction, error) {
+	return _Election.Contract.SetElectableValidators(&_Election.TransactOpts, min, max)
+}
+
+// SetElectableValidators is a paid mutator transaction binding the contract method 0xf911f0b7.
+//
+// Solidity: function setElectableValidators(uint256 min, uint256 max) returns(bool)
+func (_Election *ElectionTransactorSession) SetElectableValidators(min *big.Int, max *big.Int) (*types.Transaction, error) {
+	return _Election.Contract.SetElectableValidators(&_Election.TransactOpts, min, max)
+}
+
+// SetMaxNumValidatorsVotedFor is a paid mutator transaction binding the contract method 0x3bb82cbc.
+//
+// Solidity: function setMaxNumValidatorsVotedFor(uint256 _maxNumValidatorsVotedFor) returns(bool)
+func (_Election *ElectionTransactor) SetMaxNumValidatorsVotedFor(opts *bind.TransactOpts, _maxNumValidatorsVotedFor *big.Int) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "setMaxNumValidatorsVotedFor", _maxNumValidatorsVotedFor)
+}
+
+// SetMaxNumValidatorsVotedFor is a paid mutator transaction binding the contract method 0x3bb82cbc.
+//
+// Solidity: function setMaxNumValidatorsVotedFor(uint256 _maxNumValidatorsVotedFor) returns(bool)
+func (_Election *ElectionSession) SetMaxNumValidatorsVotedFor(_maxNumValidatorsVotedFor *big.Int) (*types.Transaction, error) {
+	return _Election.Contract.SetMaxNumValidatorsVotedFor(&_Election.TransactOpts, _maxNumValidatorsVotedFor)
+}
+
+// SetMaxNumValidatorsVotedFor is a paid mutator transaction binding the contract method 0x3bb82cbc.
+//
+// Solidity: function setMaxNumValidatorsVotedFor(uint256 _maxNumValidatorsVotedFor) returns(bool)
+func (_Election *ElectionTransactorSession) SetMaxNumValidatorsVotedFor(_maxNumValidatorsVotedFor *big.Int) (*types.Transaction, error) {
+	return _Election.Contract.SetMaxNumValidatorsVotedFor(&_Election.TransactOpts, _maxNumValidatorsVotedFor)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address registryAddress) returns()
+func (_Election *ElectionTransactor) SetRegistry(opts *bind.TransactOpts, registryAddress common.Address) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "setRegistry", registryAddress)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address registryAddress) returns()
+func (_Election *ElectionSession) SetRegistry(registryAddress common.Address) (*types.Transaction, error) {
+	return _Election.Contract.SetRegistry(&_Election.TransactOpts, registryAddress)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address registryAddress) returns()
+func (_Election *ElectionTransactorSession) SetRegistry(registryAddress common.Address) (*types.Transaction, error) {
+	return _Election.Contract.SetRegistry(&_Election.TransactOpts, registryAddress)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Election *ElectionTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Election *ElectionSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Election.Contract.TransferOwnership(&_Election.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Election *ElectionTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Election.Contract.TransferOwnership(&_Election.TransactOpts, newOwner)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x580d747a.
+//
+// Solidity: function vote(address validator, uint256 value, address lesser, address greater) returns(bool)
+func (_Election *ElectionTransactor) Vote(opts *bind.TransactOpts, validator common.Address, value *big.Int, lesser common.Address, greater common.Address) (*types.Transaction, error) {
+	return _Election.contract.Transact(opts, "vote", validator, value, lesser, greater)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x580d747a.
+//
+// Solidity: function vote(address validator, uint256 value, address lesser, address greater) returns(bool)
+func (_Election *ElectionSession) Vote(validator common.Address, value *big.Int, lesser common.Address, greater common.Address) (*types.Transaction, error) {
+	return _Election.Contract.Vote(&_Election.TransactOpts, validator, value, lesser, greater)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x580d747a.
+//
+// Solidity: function vote(address validator, uint256 value, address lesser, address greater) returns(bool)
+func (_Election *ElectionTransactorSession) Vote(validator common.Address, value *big.Int, lesser common.Address, greater common.Address) (*types.Transaction, error) {
+	return _Election.Contract.Vote(&_Election.TransactOpts, validator, value, lesser, greater)
+}
+
+// ElectionElectabilityThresholdSetIterator is returned from FilterElectabilityThresholdSet and is used to iterate over the raw logs and unpacked data for ElectabilityThresholdSet events raised by the Election contract.
+type ElectionElectabilityThresholdSetIterator struct {
+	Event *ElectionElectabilityThresholdSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ElectionElectabilityThresholdSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ElectionElectabilityThresholdSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ElectionElectabilityThresholdSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ElectionElectabilityThresholdSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ElectionElectabilityThresholdSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ElectionElectabilityThresholdSet represents a ElectabilityThresholdSet event raised by the Election contract.
+type ElectionElectabilityThresholdSet struct {
+	ElectabilityThreshold *big.Int
+	Raw                   types.Log // Blockchain specific contextual infos
+}
+
+// FilterElectabilityThresholdSet is a free log retrieval operation binding the contract event 0x9854be03126e38f9c318d8aabe1b150d09cb3a57059b21855b1e11d44e082c1a.
+//
+// Solidity: event ElectabilityThresholdSet(uint256 electabilityThreshold)
+func (_Election *ElectionFilterer) FilterElectabilityThresholdSet(opts *bind.FilterOpts) (*ElectionElectabilityThresholdSetIterator, error) {
+
+	logs, sub, err := _Election.contract.FilterLogs(opts, "ElectabilityThresholdSet")
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionElectabilityThresholdSetIterator{contract: _Election.contract, event: "ElectabilityThresholdSet", logs: logs, sub: sub}, nil
+}
+
+// WatchElectabilityThresholdSet is a free log subscription operation binding the contract event 0x9854be03126e38f9c318d8aabe1b150d09cb3a57059b21855b1e11d44e082c1a.
+//
+// Solidity: event ElectabilityThresholdSet(uint256 electabilityThreshold)
+func (_Election *ElectionFilterer) WatchElectabilityThresholdSet(opts *bind.WatchOpts, sink chan<- *ElectionElectabilityThresholdSet) (event.Subscription, error) {
+
+	logs, sub, err := _Election.contract.WatchLogs(opts, "ElectabilityThresholdSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ElectionElectabilityThresholdSet)
+				if err := _Election.contract.UnpackLog(event, "ElectabilityThresholdSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseElectabilityThresholdSet is a log parse operation binding the contract event 0x9854be03126e38f9c318d8aabe1b150d09cb3a57059b21855b1e11d44e082c1a.
+//
+// Solidity: event ElectabilityThresholdSet(uint256 electabilityThreshold)
+func (_Election *ElectionFilterer) ParseElectabilityThresholdSet(log types.Log) (*ElectionElectabilityThresholdSet, error) {
+	event := new(ElectionElectabilityThresholdSet)
+	if err := _Election.contract.UnpackLog(event, "ElectabilityThresholdSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ElectionElectableValidatorsSetIterator is returned from FilterElectableValidatorsSet and is used to iterate over the raw logs and unpacked data for ElectableValidatorsSet events raised by the Election contract.
+type ElectionElectableValidatorsSetIterator struct {
+	Event *ElectionElectableValidatorsSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ElectionElectableValidatorsSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ElectionElectableValidatorsSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ElectionElectableValidatorsSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ElectionElectableValidatorsSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ElectionElectableValidatorsSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ElectionElectableValidatorsSet represents a ElectableValidatorsSet event raised by the Election contract.
+type ElectionElectableValidatorsSet struct {
+	Min *big.Int
+	Max *big.Int
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterElectableValidatorsSet is a free log retrieval operation binding the contract event 0xb3ae64819ff89f6136eb58b8563cb32c6550f17eaf97f9ecc32f23783229f6de.
+//
+// Solidity: event ElectableValidatorsSet(uint256 min, uint256 max)
+func (_Election *ElectionFilterer) FilterElectableValidatorsSet(opts *bind.FilterOpts) (*ElectionElectableValidatorsSetIterator, error) {
+
+	logs, sub, err := _Election.contract.FilterLogs(opts, "ElectableValidatorsSet")
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionElectableValidatorsSetIterator{contract: _Election.contract, event: "ElectableValidatorsSet", logs: logs, sub: sub}, nil
+}
+
+// WatchElectableValidatorsSet is a free log subscription operation binding the contract event 0xb3ae64819ff89f6136eb58b8563cb32c6550f17eaf97f9ecc32f23783229f6de.
+//
+// Solidity: event ElectableValidatorsSet(uint256 min, uint256 max)
+func (_Election *ElectionFilterer) WatchElectableValidatorsSet(opts *bind.WatchOpts, sink chan<- *ElectionElectableValidatorsSet) (event.Subscription, error) {
+
+	logs, sub, err := _Election.contract.WatchLogs(opts, "ElectableValidatorsSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ElectionElectableValidatorsSet)
+				if err := _Election.contract.UnpackLog(event, "ElectableValidatorsSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseElectableValidatorsSet is a log parse operation binding the contract event 0xb3ae64819ff89f6136eb58b8563cb32c6550f17eaf97f9ecc32f23783229f6de.
+//
+// Solidity: event ElectableValidatorsSet(uint256 min, uint256 max)
+func (_Election *ElectionFilterer) ParseElectableValidatorsSet(log types.Log) (*ElectionElectableValidatorsSet, error) {
+	event := new(ElectionElectableValidatorsSet)
+	if err := _Election.contract.UnpackLog(event, "ElectableValidatorsSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ElectionEpochRewardRemainsDistributedToValidatorsIterator is returned from FilterEpochRewardRemainsDistributedToValidators and is used to iterate over the raw logs and unpacked data for EpochRewardRemainsDistributedToValidators events raised by the Election contract.
+type ElectionEpochRewardRemainsDistributedToValidatorsIterator struct {
+	Event *ElectionEpochRewardRemainsDistributedToValidators // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ElectionEpochRewardRemainsDistributedToValidatorsIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ElectionEpochRewardRemainsDistributedToValidators)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ElectionEpochRewardRemainsDistributedToValidators)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ElectionEpochRewardRemainsDistributedToValidatorsIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ElectionEpochRewardRemainsDistributedToValidatorsIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ElectionEpochRewardRemainsDistributedToValidators represents a EpochRewardRemainsDistributedToValidators event raised by the Election contract.
+type ElectionEpochRewardRemainsDistributedToValidators struct {
+	Validator common.Address
+	Value     *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterEpochRewardRemainsDistributedToValidators is a free log retrieval operation binding the contract event 0x4992d4315d25c92f11b25bc1e623fd1e6c5c641c14684e1f1859046034b16520.
+//
+// Solidity: event EpochRewardRemainsDistributedToValidators(address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) FilterEpochRewardRemainsDistributedToValidators(opts *bind.FilterOpts, validator []common.Address) (*ElectionEpochRewardRemainsDistributedToValidatorsIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Election.contract.FilterLogs(opts, "EpochRewardRemainsDistributedToValidators", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionEpochRewardRemainsDistributedToValidatorsIterator{contract: _Election.contract, event: "EpochRewardRemainsDistributedToValidators", logs: logs, sub: sub}, nil
+}
+
+// WatchEpochRewardRemainsDistributedToValidators is a free log subscription operation binding the contract event 0x4992d4315d25c92f11b25bc1e623fd1e6c5c641c14684e1f1859046034b16520.
+//
+// Solidity: event EpochRewardRemainsDistributedToValidators(address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) WatchEpochRewardRemainsDistributedToValidators(opts *bind.WatchOpts, sink chan<- *ElectionEpochRewardRemainsDistributedToValidators, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Election.contract.WatchLogs(opts, "EpochRewardRemainsDistributedToValidators", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ElectionEpochRewardRemainsDistributedToValidators)
+				if err := _Election.contract.UnpackLog(event, "EpochRewardRemainsDistributedToValidators", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEpochRewardRemainsDistributedToValidators is a log parse operation binding the contract event 0x4992d4315d25c92f11b25bc1e623fd1e6c5c641c14684e1f1859046034b16520.
+//
+// Solidity: event EpochRewardRemainsDistributedToValidators(address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) ParseEpochRewardRemainsDistributedToValidators(log types.Log) (*ElectionEpochRewardRemainsDistributedToValidators, error) {
+	event := new(ElectionEpochRewardRemainsDistributedToValidators)
+	if err := _Election.contract.UnpackLog(event, "EpochRewardRemainsDistributedToValidators", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ElectionEpochRewardsDistributedToVotersIterator is returned from FilterEpochRewardsDistributedToVoters and is used to iterate over the raw logs and unpacked data for EpochRewardsDistributedToVoters events raised by the Election contract.
+type ElectionEpochRewardsDistributedToVotersIterator struct {
+	Event *ElectionEpochRewardsDistributedToVoters // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ElectionEpochRewardsDistributedToVotersIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ElectionEpochRewardsDistributedToVoters)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ElectionEpochRewardsDistributedToVoters)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ElectionEpochRewardsDistributedToVotersIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ElectionEpochRewardsDistributedToVotersIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ElectionEpochRewardsDistributedToVoters represents a EpochRewardsDistributedToVoters event raised by the Election contract.
+type ElectionEpochRewardsDistributedToVoters struct {
+	VoterAddress common.Address
+	Value        *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterEpochRewardsDistributedToVoters is a free log retrieval operation binding the contract event 0x91ba34d62474c14d6c623cd322f4256666c7a45b7fdaa3378e009d39dfcec2a7.
+//
+// Solidity: event EpochRewardsDistributedToVoters(address indexed voterAddress, uint256 value)
+func (_Election *ElectionFilterer) FilterEpochRewardsDistributedToVoters(opts *bind.FilterOpts, voterAddress []common.Address) (*ElectionEpochRewardsDistributedToVotersIterator, error) {
+
+	var voterAddressRule []interface{}
+	for _, voterAddressItem := range voterAddress {
+		voterAddressRule = append(voterAddressRule, voterAddressItem)
+	}
+
+	logs, sub, err := _Election.contract.FilterLogs(opts, "EpochRewardsDistributedToVoters", voterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionEpochRewardsDistributedToVotersIterator{contract: _Election.contract, event: "EpochRewardsDistributedToVoters", logs: logs, sub: sub}, nil
+}
+
+// WatchEpochRewardsDistributedToVoters is a free log subscription operation binding the contract event 0x91ba34d62474c14d6c623cd322f4256666c7a45b7fdaa3378e009d39dfcec2a7.
+//
+// Solidity: event EpochRewardsDistributedToVoters(address indexed voterAddress, uint256 value)
+func (_Election *ElectionFilterer) WatchEpochRewardsDistributedToVoters(opts *bind.WatchOpts, sink chan<- *ElectionEpochRewardsDistributedToVoters, voterAddress []common.Address) (event.Subscription, error) {
+
+	var voterAddressRule []interface{}
+	for _, voterAddressItem := range voterAddress {
+		voterAddressRule = append(voterAddressRule, voterAddressItem)
+	}
+
+	logs, sub, err := _Election.contract.WatchLogs(opts, "EpochRewardsDistributedToVoters", voterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ElectionEpochRewardsDistributedToVoters)
+				if err := _Election.contract.UnpackLog(event, "EpochRewardsDistributedToVoters", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEpochRewardsDistributedToVoters is a log parse operation binding the contract event 0x91ba34d62474c14d6c623cd322f4256666c7a45b7fdaa3378e009d39dfcec2a7.
+//
+// Solidity: event EpochRewardsDistributedToVoters(address indexed voterAddress, uint256 value)
+func (_Election *ElectionFilterer) ParseEpochRewardsDistributedToVoters(log types.Log) (*ElectionEpochRewardsDistributedToVoters, error) {
+	event := new(ElectionEpochRewardsDistributedToVoters)
+	if err := _Election.contract.UnpackLog(event, "EpochRewardsDistributedToVoters", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ElectionMaxNumValidatorsVotedForSetIterator is returned from FilterMaxNumValidatorsVotedForSet and is used to iterate over the raw logs and unpacked data for MaxNumValidatorsVotedForSet events raised by the Election contract.
+type ElectionMaxNumValidatorsVotedForSetIterator struct {
+	Event *ElectionMaxNumValidatorsVotedForSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ElectionMaxNumValidatorsVotedForSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ElectionMaxNumValidatorsVotedForSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ElectionMaxNumValidatorsVotedForSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ElectionMaxNumValidatorsVotedForSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ElectionMaxNumValidatorsVotedForSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ElectionMaxNumValidatorsVotedForSet represents a MaxNumValidatorsVotedForSet event raised by the Election contract.
+type ElectionMaxNumValidatorsVotedForSet struct {
+	MaxNumValidatorsVotedFor *big.Int
+	Raw                      types.Log // Blockchain specific contextual infos
+}
+
+// FilterMaxNumValidatorsVotedForSet is a free log retrieval operation binding the contract event 0x7e8cc874cb1e4d7d1de6c8a822abffb562ee863566b0906e6a6b25fc6b9ab9f1.
+//
+// Solidity: event MaxNumValidatorsVotedForSet(uint256 maxNumValidatorsVotedFor)
+func (_Election *ElectionFilterer) FilterMaxNumValidatorsVotedForSet(opts *bind.FilterOpts) (*ElectionMaxNumValidatorsVotedForSetIterator, error) {
+
+	logs, sub, err := _Election.contract.FilterLogs(opts, "MaxNumValidatorsVotedForSet")
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionMaxNumValidatorsVotedForSetIterator{contract: _Election.contract, event: "MaxNumValidatorsVotedForSet", logs: logs, sub: sub}, nil
+}
+
+// WatchMaxNumValidatorsVotedForSet is a free log subscription operation binding the contract event 0x7e8cc874cb1e4d7d1de6c8a822abffb562ee863566b0906e6a6b25fc6b9ab9f1.
+//
+// Solidity: event MaxNumValidatorsVotedForSet(uint256 maxNumValidatorsVotedFor)
+func (_Election *ElectionFilterer) WatchMaxNumValidatorsVotedForSet(opts *bind.WatchOpts, sink chan<- *ElectionMaxNumValidatorsVotedForSet) (event.Subscription, error) {
+
+	logs, sub, err := _Election.contract.WatchLogs(opts, "MaxNumValidatorsVotedForSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ElectionMaxNumValidatorsVotedForSet)
+				if err := _Election.contract.UnpackLog(event, "MaxNumValidatorsVotedForSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMaxNumValidatorsVotedForSet is a log parse operation binding the contract event 0x7e8cc874cb1e4d7d1de6c8a822abffb562ee863566b0906e6a6b25fc6b9ab9f1.
+//
+// Solidity: event MaxNumValidatorsVotedForSet(uint256 maxNumValidatorsVotedFor)
+func (_Election *ElectionFilterer) ParseMaxNumValidatorsVotedForSet(log types.Log) (*ElectionMaxNumValidatorsVotedForSet, error) {
+	event := new(ElectionMaxNumValidatorsVotedForSet)
+	if err := _Election.contract.UnpackLog(event, "MaxNumValidatorsVotedForSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ElectionOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Election contract.
+type ElectionOwnershipTransferredIterator struct {
+	Event *ElectionOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ElectionOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ElectionOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ElectionOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ElectionOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ElectionOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ElectionOwnershipTransferred represents a OwnershipTransferred event raised by the Election contract.
+type ElectionOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Election *ElectionFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ElectionOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Election.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionOwnershipTransferredIterator{contract: _Election.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Election *ElectionFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ElectionOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Election.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ElectionOwnershipTransferred)
+				if err := _Election.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Election *ElectionFilterer) ParseOwnershipTransferred(log types.Log) (*ElectionOwnershipTransferred, error) {
+	event := new(ElectionOwnershipTransferred)
+	if err := _Election.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ElectionRegistrySetIterator is returned from FilterRegistrySet and is used to iterate over the raw logs and unpacked data for RegistrySet events raised by the Election contract.
+type ElectionRegistrySetIterator struct {
+	Event *ElectionRegistrySet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ElectionRegistrySetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ElectionRegistrySet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ElectionRegistrySet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ElectionRegistrySetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ElectionRegistrySetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ElectionRegistrySet represents a RegistrySet event raised by the Election contract.
+type ElectionRegistrySet struct {
+	RegistryAddress common.Address
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterRegistrySet is a free log retrieval operation binding the contract event 0x27fe5f0c1c3b1ed427cc63d0f05759ffdecf9aec9e18d31ef366fc8a6cb5dc3b.
+//
+// Solidity: event RegistrySet(address indexed registryAddress)
+func (_Election *ElectionFilterer) FilterRegistrySet(opts *bind.FilterOpts, registryAddress []common.Address) (*ElectionRegistrySetIterator, error) {
+
+	var registryAddressRule []interface{}
+	for _, registryAddressItem := range registryAddress {
+		registryAddressRule = append(registryAddressRule, registryAddressItem)
+	}
+
+	logs, sub, err := _Election.contract.FilterLogs(opts, "RegistrySet", registryAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionRegistrySetIterator{contract: _Election.contract, event: "RegistrySet", logs: logs, sub: sub}, nil
+}
+
+// WatchRegistrySet is a free log subscription operation binding the contract event 0x27fe5f0c1c3b1ed427cc63d0f05759ffdecf9aec9e18d31ef366fc8a6cb5dc3b.
+//
+// Solidity: event RegistrySet(address indexed registryAddress)
+func (_Election *ElectionFilterer) WatchRegistrySet(opts *bind.WatchOpts, sink chan<- *ElectionRegistrySet, registryAddress []common.Address) (event.Subscription, error) {
+
+	var registryAddressRule []interface{}
+	for _, registryAddressItem := range registryAddress {
+		registryAddressRule = append(registryAddressRule, registryAddressItem)
+	}
+
+	logs, sub, err := _Election.contract.WatchLogs(opts, "RegistrySet", registryAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ElectionRegistrySet)
+				if err := _Election.contract.UnpackLog(event, "RegistrySet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRegistrySet is a log parse operation binding the contract event 0x27fe5f0c1c3b1ed427cc63d0f05759ffdecf9aec9e18d31ef366fc8a6cb5dc3b.
+//
+// Solidity: event RegistrySet(address indexed registryAddress)
+func (_Election *ElectionFilterer) ParseRegistrySet(log types.Log) (*ElectionRegistrySet, error) {
+	event := new(ElectionRegistrySet)
+	if err := _Election.contract.UnpackLog(event, "RegistrySet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ElectionValidatorActiveVoteRevokedIterator is returned from FilterValidatorActiveVoteRevoked and is used to iterate over the raw logs and unpacked data for ValidatorActiveVoteRevoked events raised by the Election contract.
+type ElectionValidatorActiveVoteRevokedIterator struct {
+	Event *ElectionValidatorActiveVoteRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ElectionValidatorActiveVoteRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ElectionValidatorActiveVoteRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ElectionValidatorActiveVoteRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ElectionValidatorActiveVoteRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ElectionValidatorActiveVoteRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ElectionValidatorActiveVoteRevoked represents a ValidatorActiveVoteRevoked event raised by the Election contract.
+type ElectionValidatorActiveVoteRevoked struct {
+	Account   common.Address
+	Validator common.Address
+	Value     *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorActiveVoteRevoked is a free log retrieval operation binding the contract event 0xf692f44fc726cd419cd10a72d26be76540f6f721cf66b8c1ef5e9ddb63388c8d.
+//
+// Solidity: event ValidatorActiveVoteRevoked(address indexed account, address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) FilterValidatorActiveVoteRevoked(opts *bind.FilterOpts, account []common.Address, validator []common.Address) (*ElectionValidatorActiveVoteRevokedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Election.contract.FilterLogs(opts, "ValidatorActiveVoteRevoked", accountRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionValidatorActiveVoteRevokedIterator{contract: _Election.contract, event: "ValidatorActiveVoteRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorActiveVoteRevoked is a free log subscription operation binding the contract event 0xf692f44fc726cd419cd10a72d26be76540f6f721cf66b8c1ef5e9ddb63388c8d.
+//
+// Solidity: event ValidatorActiveVoteRevoked(address indexed account, address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) WatchValidatorActiveVoteRevoked(opts *bind.WatchOpts, sink chan<- *ElectionValidatorActiveVoteRevoked, account []common.Address, validator []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Election.contract.WatchLogs(opts, "ValidatorActiveVoteRevoked", accountRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ElectionValidatorActiveVoteRevoked)
+				if err := _Election.contract.UnpackLog(event, "ValidatorActiveVoteRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorActiveVoteRevoked is a log parse operation binding the contract event 0xf692f44fc726cd419cd10a72d26be76540f6f721cf66b8c1ef5e9ddb63388c8d.
+//
+// Solidity: event ValidatorActiveVoteRevoked(address indexed account, address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) ParseValidatorActiveVoteRevoked(log types.Log) (*ElectionValidatorActiveVoteRevoked, error) {
+	event := new(ElectionValidatorActiveVoteRevoked)
+	if err := _Election.contract.UnpackLog(event, "ValidatorActiveVoteRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ElectionValidatorMarkedEligibleIterator is returned from FilterValidatorMarkedEligible and is used to iterate over the raw logs and unpacked data for ValidatorMarkedEligible events raised by the Election contract.
+type ElectionValidatorMarkedEligibleIterator struct {
+	Event *ElectionValidatorMarkedEligible // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ElectionValidatorMarkedEligibleIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ElectionValidatorMarkedEligible)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ElectionValidatorMarkedEligible)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ElectionValidatorMarkedEligibleIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ElectionValidatorMarkedEligibleIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ElectionValidatorMarkedEligible represents a ValidatorMarkedEligible event raised by the Election contract.
+type ElectionValidatorMarkedEligible struct {
+	Validator common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorMarkedEligible is a free log retrieval operation binding the contract event 0x38093c8a525118085d567a151b0100775cab64ea08ae87ec248ab3d7a9ab21ef.
+//
+// Solidity: event ValidatorMarkedEligible(address indexed validator)
+func (_Election *ElectionFilterer) FilterValidatorMarkedEligible(opts *bind.FilterOpts, validator []common.Address) (*ElectionValidatorMarkedEligibleIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Election.contract.FilterLogs(opts, "ValidatorMarkedEligible", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionValidatorMarkedEligibleIterator{contract: _Election.contract, event: "ValidatorMarkedEligible", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorMarkedEligible is a free log subscription operation binding the contract event 0x38093c8a525118085d567a151b0100775cab64ea08ae87ec248ab3d7a9ab21ef.
+//
+// Solidity: event ValidatorMarkedEligible(address indexed validator)
+func (_Election *ElectionFilterer) WatchValidatorMarkedEligible(opts *bind.WatchOpts, sink chan<- *ElectionValidatorMarkedEligible, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Election.contract.WatchLogs(opts, "ValidatorMarkedEligible", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ElectionValidatorMarkedEligible)
+				if err := _Election.contract.UnpackLog(event, "ValidatorMarkedEligible", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorMarkedEligible is a log parse operation binding the contract event 0x38093c8a525118085d567a151b0100775cab64ea08ae87ec248ab3d7a9ab21ef.
+//
+// Solidity: event ValidatorMarkedEligible(address indexed validator)
+func (_Election *ElectionFilterer) ParseValidatorMarkedEligible(log types.Log) (*ElectionValidatorMarkedEligible, error) {
+	event := new(ElectionValidatorMarkedEligible)
+	if err := _Election.contract.UnpackLog(event, "ValidatorMarkedEligible", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ElectionValidatorMarkedIneligibleIterator is returned from FilterValidatorMarkedIneligible and is used to iterate over the raw logs and unpacked data for ValidatorMarkedIneligible events raised by the Election contract.
+type ElectionValidatorMarkedIneligibleIterator struct {
+	Event *ElectionValidatorMarkedIneligible // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ElectionValidatorMarkedIneligibleIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ElectionValidatorMarkedIneligible)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ElectionValidatorMarkedIneligible)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ElectionValidatorMarkedIneligibleIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ElectionValidatorMarkedIneligibleIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ElectionValidatorMarkedIneligible represents a ValidatorMarkedIneligible event raised by the Election contract.
+type ElectionValidatorMarkedIneligible struct {
+	Validator common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorMarkedIneligible is a free log retrieval operation binding the contract event 0xf326c8d0a93e53d2b260b648ed514433e44d6e7a9eb6c2702ed55ac8e534ee24.
+//
+// Solidity: event ValidatorMarkedIneligible(address indexed validator)
+func (_Election *ElectionFilterer) FilterValidatorMarkedIneligible(opts *bind.FilterOpts, validator []common.Address) (*ElectionValidatorMarkedIneligibleIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Election.contract.FilterLogs(opts, "ValidatorMarkedIneligible", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionValidatorMarkedIneligibleIterator{contract: _Election.contract, event: "ValidatorMarkedIneligible", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorMarkedIneligible is a free log subscription operation binding the contract event 0xf326c8d0a93e53d2b260b648ed514433e44d6e7a9eb6c2702ed55ac8e534ee24.
+//
+// Solidity: event ValidatorMarkedIneligible(address indexed validator)
+func (_Election *ElectionFilterer) WatchValidatorMarkedIneligible(opts *bind.WatchOpts, sink chan<- *ElectionValidatorMarkedIneligible, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Election.contract.WatchLogs(opts, "ValidatorMarkedIneligible", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ElectionValidatorMarkedIneligible)
+				if err := _Election.contract.UnpackLog(event, "ValidatorMarkedIneligible", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorMarkedIneligible is a log parse operation binding the contract event 0xf326c8d0a93e53d2b260b648ed514433e44d6e7a9eb6c2702ed55ac8e534ee24.
+//
+// Solidity: event ValidatorMarkedIneligible(address indexed validator)
+func (_Election *ElectionFilterer) ParseValidatorMarkedIneligible(log types.Log) (*ElectionValidatorMarkedIneligible, error) {
+	event := new(ElectionValidatorMarkedIneligible)
+	if err := _Election.contract.UnpackLog(event, "ValidatorMarkedIneligible", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ElectionValidatorPendingVoteRevokedIterator is returned from FilterValidatorPendingVoteRevoked and is used to iterate over the raw logs and unpacked data for ValidatorPendingVoteRevoked events raised by the Election contract.
+type ElectionValidatorPendingVoteRevokedIterator struct {
+	Event *ElectionValidatorPendingVoteRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ElectionValidatorPendingVoteRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ElectionValidatorPendingVoteRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ElectionValidatorPendingVoteRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ElectionValidatorPendingVoteRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ElectionValidatorPendingVoteRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ElectionValidatorPendingVoteRevoked represents a ValidatorPendingVoteRevoked event raised by the Election contract.
+type ElectionValidatorPendingVoteRevoked struct {
+	Account   common.Address
+	Validator common.Address
+	Value     *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorPendingVoteRevoked is a free log retrieval operation binding the contract event 0x2063d19882f853e1a6ceb94b5a49933223dee72351696352abf3116b92e9b94e.
+//
+// Solidity: event ValidatorPendingVoteRevoked(address indexed account, address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) FilterValidatorPendingVoteRevoked(opts *bind.FilterOpts, account []common.Address, validator []common.Address) (*ElectionValidatorPendingVoteRevokedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Election.contract.FilterLogs(opts, "ValidatorPendingVoteRevoked", accountRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionValidatorPendingVoteRevokedIterator{contract: _Election.contract, event: "ValidatorPendingVoteRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorPendingVoteRevoked is a free log subscription operation binding the contract event 0x2063d19882f853e1a6ceb94b5a49933223dee72351696352abf3116b92e9b94e.
+//
+// Solidity: event ValidatorPendingVoteRevoked(address indexed account, address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) WatchValidatorPendingVoteRevoked(opts *bind.WatchOpts, sink chan<- *ElectionValidatorPendingVoteRevoked, account []common.Address, validator []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Election.contract.WatchLogs(opts, "ValidatorPendingVoteRevoked", accountRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ElectionValidatorPendingVoteRevoked)
+				if err := _Election.contract.UnpackLog(event, "ValidatorPendingVoteRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorPendingVoteRevoked is a log parse operation binding the contract event 0x2063d19882f853e1a6ceb94b5a49933223dee72351696352abf3116b92e9b94e.
+//
+// Solidity: event ValidatorPendingVoteRevoked(address indexed account, address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) ParseValidatorPendingVoteRevoked(log types.Log) (*ElectionValidatorPendingVoteRevoked, error) {
+	event := new(ElectionValidatorPendingVoteRevoked)
+	if err := _Election.contract.UnpackLog(event, "ValidatorPendingVoteRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ElectionValidatorVoteActivatedIterator is returned from FilterValidatorVoteActivated and is used to iterate over the raw logs and unpacked data for ValidatorVoteActivated events raised by the Election contract.
+type ElectionValidatorVoteActivatedIterator struct {
+	Event *ElectionValidatorVoteActivated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ElectionValidatorVoteActivatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ElectionValidatorVoteActivated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ElectionValidatorVoteActivated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ElectionValidatorVoteActivatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ElectionValidatorVoteActivatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ElectionValidatorVoteActivated represents a ValidatorVoteActivated event raised by the Election contract.
+type ElectionValidatorVoteActivated struct {
+	Account   common.Address
+	Validator common.Address
+	Value     *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorVoteActivated is a free log retrieval operation binding the contract event 0x28b69685129020127f11839589cc4ec429c510d90b3d340dcc5a1d734ca03a9c.
+//
+// Solidity: event ValidatorVoteActivated(address indexed account, address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) FilterValidatorVoteActivated(opts *bind.FilterOpts, account []common.Address, validator []common.Address) (*ElectionValidatorVoteActivatedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Election.contract.FilterLogs(opts, "ValidatorVoteActivated", accountRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionValidatorVoteActivatedIterator{contract: _Election.contract, event: "ValidatorVoteActivated", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorVoteActivated is a free log subscription operation binding the contract event 0x28b69685129020127f11839589cc4ec429c510d90b3d340dcc5a1d734ca03a9c.
+//
+// Solidity: event ValidatorVoteActivated(address indexed account, address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) WatchValidatorVoteActivated(opts *bind.WatchOpts, sink chan<- *ElectionValidatorVoteActivated, account []common.Address, validator []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Election.contract.WatchLogs(opts, "ValidatorVoteActivated", accountRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ElectionValidatorVoteActivated)
+				if err := _Election.contract.UnpackLog(event, "ValidatorVoteActivated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorVoteActivated is a log parse operation binding the contract event 0x28b69685129020127f11839589cc4ec429c510d90b3d340dcc5a1d734ca03a9c.
+//
+// Solidity: event ValidatorVoteActivated(address indexed account, address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) ParseValidatorVoteActivated(log types.Log) (*ElectionValidatorVoteActivated, error) {
+	event := new(ElectionValidatorVoteActivated)
+	if err := _Election.contract.UnpackLog(event, "ValidatorVoteActivated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ElectionValidatorVoteCastIterator is returned from FilterValidatorVoteCast and is used to iterate over the raw logs and unpacked data for ValidatorVoteCast events raised by the Election contract.
+type ElectionValidatorVoteCastIterator struct {
+	Event *ElectionValidatorVoteCast // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ElectionValidatorVoteCastIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ElectionValidatorVoteCast)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ElectionValidatorVoteCast)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ElectionValidatorVoteCastIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ElectionValidatorVoteCastIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ElectionValidatorVoteCast represents a ValidatorVoteCast event raised by the Election contract.
+type ElectionValidatorVoteCast struct {
+	Account   common.Address
+	Validator common.Address
+	Value     *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorVoteCast is a free log retrieval operation binding the contract event 0xc042b2b90064506b5645f918161286a366d16838e9f9234a27d03a4f447257d8.
+//
+// Solidity: event ValidatorVoteCast(address indexed account, address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) FilterValidatorVoteCast(opts *bind.FilterOpts, account []common.Address, validator []common.Address) (*ElectionValidatorVoteCastIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Election.contract.FilterLogs(opts, "ValidatorVoteCast", accountRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ElectionValidatorVoteCastIterator{contract: _Election.contract, event: "ValidatorVoteCast", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorVoteCast is a free log subscription operation binding the contract event 0xc042b2b90064506b5645f918161286a366d16838e9f9234a27d03a4f447257d8.
+//
+// Solidity: event ValidatorVoteCast(address indexed account, address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) WatchValidatorVoteCast(opts *bind.WatchOpts, sink chan<- *ElectionValidatorVoteCast, account []common.Address, validator []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Election.contract.WatchLogs(opts, "ValidatorVoteCast", accountRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ElectionValidatorVoteCast)
+				if err := _Election.contract.UnpackLog(event, "ValidatorVoteCast", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorVoteCast is a log parse operation binding the contract event 0xc042b2b90064506b5645f918161286a366d16838e9f9234a27d03a4f447257d8.
+//
+// Solidity: event ValidatorVoteCast(address indexed account, address indexed validator, uint256 value)
+func (_Election *ElectionFilterer) ParseValidatorVoteCast(log types.Log) (*ElectionValidatorVoteCast, error) {
+	event := new(ElectionValidatorVoteCast)
+	if err := _Election.contract.UnpackLog(event, "ValidatorVoteCast", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
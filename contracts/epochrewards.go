@@ -0,0 +1,2770 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// EpochRewardsMetaData contains all meta data concerning the EpochRewards contract.
+var EpochRewardsMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"test\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"partner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"fraction\",\"type\":\"uint256\"}],\"name\":\"CommunityRewardFundSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"fraction\",\"type\":\"uint256\"}],\"name\":\"EpochRelayerPaymentFractionFundSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"registryAddress\",\"type\":\"address\"}],\"name\":\"RegistrySet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"max\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"underspendAdjustmentFactor\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"overspendAdjustmentFactor\",\"type\":\"uint256\"}],\"name\":\"RewardsMultiplierParametersSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"payment\",\"type\":\"uint256\"}],\"name\":\"TargetRelayerEpochPaymentSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"payment\",\"type\":\"uint256\"}],\"name\":\"TargetValidatorEpochPaymentSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"fraction\",\"type\":\"uint256\"}],\"name\":\"TargetVotingGoldFractionSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"max\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"adjustmentFactor\",\"type\":\"uint256\"}],\"name\":\"TargetVotingYieldParametersSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"target\",\"type\":\"uint256\"}],\"name\":\"TargetVotingYieldSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"fraction\",\"type\":\"uint256\"}],\"name\":\"TargetVotingYieldUpdated\",\"type\":\"event\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"blsKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"blsG1Key\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"blsPop\",\"type\":\"bytes\"}],\"name\":\"checkProofOfPossession\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"communityPartner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"epochPayment\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"aNumerator\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"aDenominator\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bNumerator\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bDenominator\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"exponent\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_decimals\",\"type\":\"uint256\"}],\"name\":\"fractionMulExp\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"header\",\"type\":\"bytes\"}],\"name\":\"getBlockNumberFromHeader\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getEpochNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"getEpochNumberOfBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getEpochSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"getParentSealBitmap\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"header\",\"type\":\"bytes\"}],\"name\":\"getVerifiedSealBitmapFromHeader\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"header\",\"type\":\"bytes\"}],\"name\":\"hashHeader\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"initialized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"isOwner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"mgrMaintainer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"minQuorumSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"minQuorumSizeInCurrentSet\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"numberValidatorsInCurrentSet\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"numberValidatorsInSet\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"registry\",\"outputs\":[{\"internalType\":\"contractIRegistry\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"registryAddress\",\"type\":\"address\"}],\"name\":\"setRegistry\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"startTime\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"validatorSignerAddressFromCurrentSet\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"validatorSignerAddressFromSet\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getVersionNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"registryAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_targetEpochPayment\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_communityRewardFraction\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_epochRelayerPaymentFraction\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_communityPartner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"mgrAddr\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"partner\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"setCommunityRewardFraction\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"setEpochMaintainerPaymentFraction\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"setMgrMaintainerAddress\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getCommunityRewardFraction\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"setTargetEpochPayment\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getTargetTotalEpochPaymentsInGold\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getCommunityPartner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getMgrMaintainerAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"calculateTargetEpochRewards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// EpochRewardsABI is the input ABI used to generate the binding from.
+// Deprecated: Use EpochRewardsMetaData.ABI instead.
+var EpochRewardsABI = EpochRewardsMetaData.ABI
+
+// EpochRewards is an auto generated Go binding around an Ethereum contract.
+type EpochRewards struct {
+	EpochRewardsCaller     // Read-only binding to the contract
+	EpochRewardsTransactor // Write-only binding to the contract
+	EpochRewardsFilterer   // Log filterer for contract events
+}
+
+// EpochRewardsCaller is an auto generated read-only Go binding around an Ethereum contract.
+type EpochRewardsCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EpochRewardsTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type EpochRewardsTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EpochRewardsFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type EpochRewardsFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EpochRewardsSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type EpochRewardsSession struct {
+	Contract     *EpochRewards     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// EpochRewardsCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type EpochRewardsCallerSession struct {
+	Contract *EpochRewardsCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// EpochRewardsTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type EpochRewardsTransactorSession struct {
+	Contract     *EpochRewardsTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// EpochRewardsRaw is an auto generated low-level Go binding around an Ethereum contract.
+type EpochRewardsRaw struct {
+	Contract *EpochRewards // Generic contract binding to access the raw methods on
+}
+
+// EpochRewardsCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type EpochRewardsCallerRaw struct {
+	Contract *EpochRewardsCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// EpochRewardsTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type EpochRewardsTransactorRaw struct {
+	Contract *EpochRewardsTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewEpochRewards creates a new instance of EpochRewards, bound to a specific deployed contract.
+func NewEpochRewards(address common.Address, backend bind.ContractBackend) (*EpochRewards, error) {
+	contract, err := bindEpochRewards(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewards{EpochRewardsCaller: EpochRewardsCaller{contract: contract}, EpochRewardsTransactor: EpochRewardsTransactor{contract: contract}, EpochRewardsFilterer: EpochRewardsFilterer{contract: contract}}, nil
+}
+
+// NewEpochRewardsCaller creates a new read-only instance of EpochRewards, bound to a specific deployed contract.
+func NewEpochRewardsCaller(address common.Address, caller bind.ContractCaller) (*EpochRewardsCaller, error) {
+	contract, err := bindEpochRewards(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewardsCaller{contract: contract}, nil
+}
+
+// NewEpochRewardsTransactor creates a new write-only instance of EpochRewards, bound to a specific deployed contract.
+func NewEpochRewardsTransactor(address common.Address, transactor bind.ContractTransactor) (*EpochRewardsTransactor, error) {
+	contract, err := bindEpochRewards(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewardsTransactor{contract: contract}, nil
+}
+
+// NewEpochRewardsFilterer creates a new log filterer instance of EpochRewards, bound to a specific deployed contract.
+func NewEpochRewardsFilterer(address common.Address, filterer bind.ContractFilterer) (*EpochRewardsFilterer, error) {
+	contract, err := bindEpochRewards(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewardsFilterer{contract: contract}, nil
+}
+
+// bindEpochRewards binds a generic wrapper to an already deployed contract.
+func bindEpochRewards(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(EpochRewardsABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EpochRewards *EpochRewardsRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EpochRewards.Contract.EpochRewardsCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EpochRewards *EpochRewardsRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EpochRewards.Contract.EpochRewardsTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EpochRewards *EpochRewardsRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EpochRewards.Contract.EpochRewardsTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EpochRewards *EpochRewardsCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EpochRewards.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EpochRewards *EpochRewardsTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EpochRewards.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EpochRewards *EpochRewardsTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EpochRewards.Contract.contract.Transact(opts, method, params...)
+}
+
+// CalculateTargetEpochRewards is a free data retrieval call binding the contract method 0x64347043.
+//
+// Solidity: function calculateTargetEpochRewards() view returns(uint256, uint256, uint256)
+func (_EpochRewards *EpochRewardsCaller) CalculateTargetEpochRewards(opts *bind.CallOpts) (*big.Int, *big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "calculateTargetEpochRewards")
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	out2 := *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return out0, out1, out2, err
+
+}
+
+// CalculateTargetEpochRewards is a free data retrieval call binding the contract method 0x64347043.
+//
+// Solidity: function calculateTargetEpochRewards() view returns(uint256, uint256, uint256)
+func (_EpochRewards *EpochRewardsSession) CalculateTargetEpochRewards() (*big.Int, *big.Int, *big.Int, error) {
+	return _EpochRewards.Contract.CalculateTargetEpochRewards(&_EpochRewards.CallOpts)
+}
+
+// CalculateTargetEpochRewards is a free data retrieval call binding the contract method 0x64347043.
+//
+// Solidity: function calculateTargetEpochRewards() view returns(uint256, uint256, uint256)
+func (_EpochRewards *EpochRewardsCallerSession) CalculateTargetEpochRewards() (*big.Int, *big.Int, *big.Int, error) {
+	return _EpochRewards.Contract.CalculateTargetEpochRewards(&_EpochRewards.CallOpts)
+}
+
+// CheckProofOfPossession is a free data retrieval call binding the contract method 0xcab81d07.
+//
+// Solidity: function checkProofOfPossession(address sender, bytes blsKey, bytes blsG1Key, bytes blsPop) view returns(bool)
+func (_EpochRewards *EpochRewardsCaller) CheckProofOfPossession(opts *bind.CallOpts, sender common.Address, blsKey []byte, blsG1Key []byte, blsPop []byte) (bool, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "checkProofOfPossession", sender, blsKey, blsG1Key, blsPop)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// CheckProofOfPossession is a free data retrieval call binding the contract method 0xcab81d07.
+//
+// Solidity: function checkProofOfPossession(address sender, bytes blsKey, bytes blsG1Key, bytes blsPop) view returns(bool)
+func (_EpochRewards *EpochRewardsSession) CheckProofOfPossession(sender common.Address, blsKey []byte, blsG1Key []byte, blsPop []byte) (bool, error) {
+	return _EpochRewards.Contract.CheckProofOfPossession(&_EpochRewards.CallOpts, sender, blsKey, blsG1Key, blsPop)
+}
+
+// CheckProofOfPossession is a free data retrieval call binding the contract method 0xcab81d07.
+//
+// Solidity: function checkProofOfPossession(address sender, bytes blsKey, bytes blsG1Key, bytes blsPop) view returns(bool)
+func (_EpochRewards *EpochRewardsCallerSession) CheckProofOfPossession(sender common.Address, blsKey []byte, blsG1Key []byte, blsPop []byte) (bool, error) {
+	return _EpochRewards.Contract.CheckProofOfPossession(&_EpochRewards.CallOpts, sender, blsKey, blsG1Key, blsPop)
+}
+
+// CommunityPartner is a free data retrieval call binding the contract method 0x93555303.
+//
+// Solidity: function communityPartner() view returns(address)
+func (_EpochRewards *EpochRewardsCaller) CommunityPartner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "communityPartner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// CommunityPartner is a free data retrieval call binding the contract method 0x93555303.
+//
+// Solidity: function communityPartner() view returns(address)
+func (_EpochRewards *EpochRewardsSession) CommunityPartner() (common.Address, error) {
+	return _EpochRewards.Contract.CommunityPartner(&_EpochRewards.CallOpts)
+}
+
+// CommunityPartner is a free data retrieval call binding the contract method 0x93555303.
+//
+// Solidity: function communityPartner() view returns(address)
+func (_EpochRewards *EpochRewardsCallerSession) CommunityPartner() (common.Address, error) {
+	return _EpochRewards.Contract.CommunityPartner(&_EpochRewards.CallOpts)
+}
+
+// EpochPayment is a free data retrieval call binding the contract method 0x5264e4e5.
+//
+// Solidity: function epochPayment() view returns(uint256)
+func (_EpochRewards *EpochRewardsCaller) EpochPayment(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "epochPayment")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// EpochPayment is a free data retrieval call binding the contract method 0x5264e4e5.
+//
+// Solidity: function epochPayment() view returns(uint256)
+func (_EpochRewards *EpochRewardsSession) EpochPayment() (*big.Int, error) {
+	return _EpochRewards.Contract.EpochPayment(&_EpochRewards.CallOpts)
+}
+
+// EpochPayment is a free data retrieval call binding the contract method 0x5264e4e5.
+//
+// Solidity: function epochPayment() view returns(uint256)
+func (_EpochRewards *EpochRewardsCallerSession) EpochPayment() (*big.Int, error) {
+	return _EpochRewards.Contract.EpochPayment(&_EpochRewards.CallOpts)
+}
+
+// FractionMulExp is a free data retrieval call binding the contract method 0xec683072.
+//
+// Solidity: function fractionMulExp(uint256 aNumerator, uint256 aDenominator, uint256 bNumerator, uint256 bDenominator, uint256 exponent, uint256 _decimals) view returns(uint256, uint256)
+func (_EpochRewards *EpochRewardsCaller) FractionMulExp(opts *bind.CallOpts, aNumerator *big.Int, aDenominator *big.Int, bNumerator *big.Int, bDenominator *big.Int, exponent *big.Int, _decimals *big.Int) (*big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "fractionMulExp", aNumerator, aDenominator, bNumerator, bDenominator, exponent, _decimals)
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// FractionMulExp is a free data retrieval call binding the contract method 0xec683072.
+//
+// Solidity: function fractionMulExp(uint256 aNumerator, uint256 aDenominator, uint256 bNumerator, uint256 bDenominator, uint256 exponent, uint256 _decimals) view returns(uint256, uint256)
+func (_EpochRewards *EpochRewardsSession) FractionMulExp(aNumerator *big.Int, aDenominator *big.Int, bNumerator *big.Int, bDenominator *big.Int, exponent *big.Int, _decimals *big.Int) (*big.Int, *big.Int, error) {
+	return _EpochRewards.Contract.FractionMulExp(&_EpochRewards.CallOpts, aNumerator, aDenominator, bNumerator, bDenominator, exponent, _decimals)
+}
+
+// FractionMulExp is a free data retrieval call binding the contract method 0xec683072.
+//
+// Solidity: function fractionMulExp(uint256 aNumerator, uint256 aDenominator, uint256 bNumerator, uint256 bDenominator, uint256 exponent, uint256 _decimals) view returns(uint256, uint256)
+func (_EpochRewards *EpochRewardsCallerSession) FractionMulExp(aNumerator *big.Int, aDenominator *big.Int, bNumerator *big.Int, bDenominator *big.Int, exponent *big.Int, _decimals *big.Int) (*big.Int, *big.Int, error) {
+	return _EpochRewards.Contract.FractionMulExp(&_EpochRewards.CallOpts, aNumerator, aDenominator, bNumerator, bDenominator, exponent, _decimals)
+}
+
+// GetBlockNumberFromHeader is a free data retrieval call binding the contract method 0x8a883626.
+//
+// Solidity: function getBlockNumberFromHeader(bytes header) view returns(uint256)
+func (_EpochRewards *EpochRewardsCaller) GetBlockNumberFromHeader(opts *bind.CallOpts, header []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "getBlockNumberFromHeader", header)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBlockNumberFromHeader is a free data retrieval call binding the contract method 0x8a883626.
+//
+// Solidity: function getBlockNumberFromHeader(bytes header) view returns(uint256)
+func (_EpochRewards *EpochRewardsSession) GetBlockNumberFromHeader(header []byte) (*big.Int, error) {
+	return _EpochRewards.Contract.GetBlockNumberFromHeader(&_EpochRewards.CallOpts, header)
+}
+
+// GetBlockNumberFromHeader is a free data retrieval call binding the contract method 0x8a883626.
+//
+// Solidity: function getBlockNumberFromHeader(bytes header) view returns(uint256)
+func (_EpochRewards *EpochRewardsCallerSession) GetBlockNumberFromHeader(header []byte) (*big.Int, error) {
+	return _EpochRewards.Contract.GetBlockNumberFromHeader(&_EpochRewards.CallOpts, header)
+}
+
+// GetCommunityPartner is a free data retrieval call binding the contract method 0xdd782f7d.
+//
+// Solidity: function getCommunityPartner() view returns(address)
+func (_EpochRewards *EpochRewardsCaller) GetCommunityPartner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "getCommunityPartner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetCommunityPartner is a free data retrieval call binding the contract method 0xdd782f7d.
+//
+// Solidity: function getCommunityPartner() view returns(address)
+func (_EpochRewards *EpochRewardsSession) GetCommunityPartner() (common.Address, error) {
+	return _EpochRewards.Contract.GetCommunityPartner(&_EpochRewards.CallOpts)
+}
+
+// GetCommunityPartner is a free data retrieval call binding the contract method 0xdd782f7d.
+//
+// Solidity: function getCommunityPartner() view returns(address)
+func (_EpochRewards *EpochRewardsCallerSession) GetCommunityPartner() (common.Address, error) {
+	return _EpochRewards.Contract.GetCommunityPartner(&_EpochRewards.CallOpts)
+}
+
+// GetCommunityRewardFraction is a free data retrieval call binding the contract method 0x9917907f.
+//
+// Solidity: function getCommunityRewardFraction() view returns(uint256)
+func (_EpochRewards *EpochRewardsCaller) GetCommunityRewardFraction(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "getCommunityRewardFraction")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCommunityRewardFraction is a free data retrieval call binding the contract method 0x9917907f.
+//
+// Solidity: function getCommunityRewardFraction() view returns(uint256)
+func (_EpochRewards *EpochRewardsSession) GetCommunityRewardFraction() (*big.Int, error) {
+	return _EpochRewards.Contract.GetCommunityRewardFraction(&_EpochRewards.CallOpts)
+}
+
+// GetCommunityRewardFraction is a free data retrieval call binding the contract method 0x9917907f.
+//
+// Solidity: function getCommunityRewardFraction() view returns(uint256)
+func (_EpochRewards *EpochRewardsCallerSession) GetCommunityRewardFraction() (*big.Int, error) {
+	return _EpochRewards.Contract.GetCommunityRewardFraction(&_EpochRewards.CallOpts)
+}
+
+// GetEpochNumber is a free data retrieval call binding the contract method 0x9a7b3be7.
+//
+// Solidity: function getEpochNumber() view returns(uint256)
+func (_EpochRewards *EpochRewardsCaller) GetEpochNumber(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "getEpochNumber")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetEpochNumber is a free data retrieval call binding the contract method 0x9a7b3be7.
+//
+// Solidity: function getEpochNumber() view returns(uint256)
+func (_EpochRewards *EpochRewardsSession) GetEpochNumber() (*big.Int, error) {
+	return _EpochRewards.Contract.GetEpochNumber(&_EpochRewards.CallOpts)
+}
+
+// GetEpochNumber is a free data retrieval call binding the contract method 0x9a7b3be7.
+//
+// Solidity: function getEpochNumber() view returns(uint256)
+func (_EpochRewards *EpochRewardsCallerSession) GetEpochNumber() (*big.Int, error) {
+	return _EpochRewards.Contract.GetEpochNumber(&_EpochRewards.CallOpts)
+}
+
+// GetEpochNumberOfBlock is a free data retrieval call binding the contract method 0x3b1eb4bf.
+//
+// Solidity: function getEpochNumberOfBlock(uint256 blockNumber) view returns(uint256)
+func (_EpochRewards *EpochRewardsCaller) GetEpochNumberOfBlock(opts *bind.CallOpts, blockNumber *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "getEpochNumberOfBlock", blockNumber)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetEpochNumberOfBlock is a free data retrieval call binding the contract method 0x3b1eb4bf.
+//
+// Solidity: function getEpochNumberOfBlock(uint256 blockNumber) view returns(uint256)
+func (_EpochRewards *EpochRewardsSession) GetEpochNumberOfBlock(blockNumber *big.Int) (*big.Int, error) {
+	return _EpochRewards.Contract.GetEpochNumberOfBlock(&_EpochRewards.CallOpts, blockNumber)
+}
+
+// GetEpochNumberOfBlock is a free data retrieval call binding the contract method 0x3b1eb4bf.
+//
+// Solidity: function getEpochNumberOfBlock(uint256 blockNumber) view returns(uint256)
+func (_EpochRewards *EpochRewardsCallerSession) GetEpochNumberOfBlock(blockNumber *big.Int) (*big.Int, error) {
+	return _EpochRewards.Contract.GetEpochNumberOfBlock(&_EpochRewards.CallOpts, blockNumber)
+}
+
+// GetEpochSize is a free data retrieval call binding the contract method 0xdf4da461.
+//
+// Solidity: function getEpochSize() view returns(uint256)
+func (_EpochRewards *EpochRewardsCaller) GetEpochSize(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "getEpochSize")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetEpochSize is a free data retrieval call binding the contract method 0xdf4da461.
+//
+// Solidity: function getEpochSize() view returns(uint256)
+func (_EpochRewards *EpochRewardsSession) GetEpochSize() (*big.Int, error) {
+	return _EpochRewards.Contract.GetEpochSize(&_EpochRewards.CallOpts)
+}
+
+// GetEpochSize is a free data retrieval call binding the contract method 0xdf4da461.
+//
+// Solidity: function getEpochSize() view returns(uint256)
+func (_EpochRewards *EpochRewardsCallerSession) GetEpochSize() (*big.Int, error) {
+	return _EpochRewards.Contract.GetEpochSize(&_EpochRewards.CallOpts)
+}
+
+// GetMgrMaintainerAddress is a free data retrieval call binding the contract method 0xe3910028.
+//
+// Solidity: function getMgrMaintainerAddress() view returns(address)
+func (_EpochRewards *EpochRewardsCaller) GetMgrMaintainerAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "getMgrMaintainerAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetMgrMaintainerAddress is a free data retrieval call binding the contract method 0xe3910028.
+//
+// Solidity: function getMgrMaintainerAddress() view returns(address)
+func (_EpochRewards *EpochRewardsSession) GetMgrMaintainerAddress() (common.Address, error) {
+	return _EpochRewards.Contract.GetMgrMaintainerAddress(&_EpochRewards.CallOpts)
+}
+
+// GetMgrMaintainerAddress is a free data retrieval call binding the contract method 0xe3910028.
+//
+// Solidity: function getMgrMaintainerAddress() view returns(address)
+func (_EpochRewards *EpochRewardsCallerSession) GetMgrMaintainerAddress() (common.Address, error) {
+	return _EpochRewards.Contract.GetMgrMaintainerAddress(&_EpochRewards.CallOpts)
+}
+
+// GetParentSealBitmap is a free data retrieval call binding the contract method 0xfae8db0a.
+//
+// Solidity: function getParentSealBitmap(uint256 blockNumber) view returns(bytes32)
+func (_EpochRewards *EpochRewardsCaller) GetParentSealBitmap(opts *bind.CallOpts, blockNumber *big.Int) ([32]byte, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "getParentSealBitmap", blockNumber)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetParentSealBitmap is a free data retrieval call binding the contract method 0xfae8db0a.
+//
+// Solidity: function getParentSealBitmap(uint256 blockNumber) view returns(bytes32)
+func (_EpochRewards *EpochRewardsSession) GetParentSealBitmap(blockNumber *big.Int) ([32]byte, error) {
+	return _EpochRewards.Contract.GetParentSealBitmap(&_EpochRewards.CallOpts, blockNumber)
+}
+
+// GetParentSealBitmap is a free data retrieval call binding the contract method 0xfae8db0a.
+//
+// Solidity: function getParentSealBitmap(uint256 blockNumber) view returns(bytes32)
+func (_EpochRewards *EpochRewardsCallerSession) GetParentSealBitmap(blockNumber *big.Int) ([32]byte, error) {
+	return _EpochRewards.Contract.GetParentSealBitmap(&_EpochRewards.CallOpts, blockNumber)
+}
+
+// GetTargetTotalEpochPaymentsInGold is a free data retrieval call binding the contract method 0x4901c725.
+//
+// Solidity: function getTargetTotalEpochPaymentsInGold() view returns(uint256)
+func (_EpochRewards *EpochRewardsCaller) GetTargetTotalEpochPaymentsInGold(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "getTargetTotalEpochPaymentsInGold")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTargetTotalEpochPaymentsInGold is a free data retrieval call binding the contract method 0x4901c725.
+//
+// Solidity: function getTargetTotalEpochPaymentsInGold() view returns(uint256)
+func (_EpochRewards *EpochRewardsSession) GetTargetTotalEpochPaymentsInGold() (*big.Int, error) {
+	return _EpochRewards.Contract.GetTargetTotalEpochPaymentsInGold(&_EpochRewards.CallOpts)
+}
+
+// GetTargetTotalEpochPaymentsInGold is a free data retrieval call binding the contract method 0x4901c725.
+//
+// Solidity: function getTargetTotalEpochPaymentsInGold() view returns(uint256)
+func (_EpochRewards *EpochRewardsCallerSession) GetTargetTotalEpochPaymentsInGold() (*big.Int, error) {
+	return _EpochRewards.Contract.GetTargetTotalEpochPaymentsInGold(&_EpochRewards.CallOpts)
+}
+
+// GetVerifiedSealBitmapFromHeader is a free data retrieval call binding the contract method 0x4b2c2f44.
+//
+// Solidity: function getVerifiedSealBitmapFromHeader(bytes header) view returns(bytes32)
+func (_EpochRewards *EpochRewardsCaller) GetVerifiedSealBitmapFromHeader(opts *bind.CallOpts, header []byte) ([32]byte, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "getVerifiedSealBitmapFromHeader", header)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetVerifiedSealBitmapFromHeader is a free data retrieval call binding the contract method 0x4b2c2f44.
+//
+// Solidity: function getVerifiedSealBitmapFromHeader(bytes header) view returns(bytes32)
+func (_EpochRewards *EpochRewardsSession) GetVerifiedSealBitmapFromHeader(header []byte) ([32]byte, error) {
+	return _EpochRewards.Contract.GetVerifiedSealBitmapFromHeader(&_EpochRewards.CallOpts, header)
+}
+
+// GetVerifiedSealBitmapFromHeader is a free data retrieval call binding the contract method 0x4b2c2f44.
+//
+// Solidity: function getVerifiedSealBitmapFromHeader(bytes header) view returns(bytes32)
+func (_EpochRewards *EpochRewardsCallerSession) GetVerifiedSealBitmapFromHeader(header []byte) ([32]byte, error) {
+	return _EpochRewards.Contract.GetVerifiedSealBitmapFromHeader(&_EpochRewards.CallOpts, header)
+}
+
+// GetVersionNumber is a free data retrieval call binding the contract method 0x54255be0.
+//
+// Solidity: function getVersionNumber() pure returns(uint256, uint256, uint256, uint256)
+func (_EpochRewards *EpochRewardsCaller) GetVersionNumber(opts *bind.CallOpts) (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "getVersionNumber")
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	out2 := *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	out3 := *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return out0, out1, out2, out3, err
+
+}
+
+// GetVersionNumber is a free data retrieval call binding the contract method 0x54255be0.
+//
+// Solidity: function getVersionNumber() pure returns(uint256, uint256, uint256, uint256)
+func (_EpochRewards *EpochRewardsSession) GetVersionNumber() (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	return _EpochRewards.Contract.GetVersionNumber(&_EpochRewards.CallOpts)
+}
+
+// GetVersionNumber is a free data retrieval call binding the contract method 0x54255be0.
+//
+// Solidity: function getVersionNumber() pure returns(uint256, uint256, uint256, uint256)
+func (_EpochRewards *EpochRewardsCallerSession) GetVersionNumber() (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	return _EpochRewards.Contract.GetVersionNumber(&_EpochRewards.CallOpts)
+}
+
+// HashHeader is a free data retrieval call binding the contract method 0x67960e91.
+//
+// Solidity: function hashHeader(bytes header) view returns(bytes32)
+func (_EpochRewards *EpochRewardsCaller) HashHeader(opts *bind.CallOpts, header []byte) ([32]byte, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "hashHeader", header)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// HashHeader is a free data retrieval call binding the contract method 0x67960e91.
+//
+// Solidity: function hashHeader(bytes header) view returns(bytes32)
+func (_EpochRewards *EpochRewardsSession) HashHeader(header []byte) ([32]byte, error) {
+	return _EpochRewards.Contract.HashHeader(&_EpochRewards.CallOpts, header)
+}
+
+// HashHeader is a free data retrieval call binding the contract method 0x67960e91.
+//
+// Solidity: function hashHeader(bytes header) view returns(bytes32)
+func (_EpochRewards *EpochRewardsCallerSession) HashHeader(header []byte) ([32]byte, error) {
+	return _EpochRewards.Contract.HashHeader(&_EpochRewards.CallOpts, header)
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() view returns(bool)
+func (_EpochRewards *EpochRewardsCaller) Initialized(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "initialized")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() view returns(bool)
+func (_EpochRewards *EpochRewardsSession) Initialized() (bool, error) {
+	return _EpochRewards.Contract.Initialized(&_EpochRewards.CallOpts)
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() view returns(bool)
+func (_EpochRewards *EpochRewardsCallerSession) Initialized() (bool, error) {
+	return _EpochRewards.Contract.Initialized(&_EpochRewards.CallOpts)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_EpochRewards *EpochRewardsCaller) IsOwner(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "isOwner")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_EpochRewards *EpochRewardsSession) IsOwner() (bool, error) {
+	return _EpochRewards.Contract.IsOwner(&_EpochRewards.CallOpts)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_EpochRewards *EpochRewardsCallerSession) IsOwner() (bool, error) {
+	return _EpochRewards.Contract.IsOwner(&_EpochRewards.CallOpts)
+}
+
+// MgrMaintainer is a free data retrieval call binding the contract method 0x7eb9f653.
+//
+// Solidity: function mgrMaintainer() view returns(address)
+func (_EpochRewards *EpochRewardsCaller) MgrMaintainer(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "mgrMaintainer")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MgrMaintainer is a free data retrieval call binding the contract method 0x7eb9f653.
+//
+// Solidity: function mgrMaintainer() view returns(address)
+func (_EpochRewards *EpochRewardsSession) MgrMaintainer() (common.Address, error) {
+	return _EpochRewards.Contract.MgrMaintainer(&_EpochRewards.CallOpts)
+}
+
+// MgrMaintainer is a free data retrieval call binding the contract method 0x7eb9f653.
+//
+// Solidity: function mgrMaintainer() view returns(address)
+func (_EpochRewards *EpochRewardsCallerSession) MgrMaintainer() (common.Address, error) {
+	return _EpochRewards.Contract.MgrMaintainer(&_EpochRewards.CallOpts)
+}
+
+// MinQuorumSize is a free data retrieval call binding the contract method 0xe50e652d.
+//
+// Solidity: function minQuorumSize(uint256 blockNumber) view returns(uint256)
+func (_EpochRewards *EpochRewardsCaller) MinQuorumSize(opts *bind.CallOpts, blockNumber *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "minQuorumSize", blockNumber)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinQuorumSize is a free data retrieval call binding the contract method 0xe50e652d.
+//
+// Solidity: function minQuorumSize(uint256 blockNumber) view returns(uint256)
+func (_EpochRewards *EpochRewardsSession) MinQuorumSize(blockNumber *big.Int) (*big.Int, error) {
+	return _EpochRewards.Contract.MinQuorumSize(&_EpochRewards.CallOpts, blockNumber)
+}
+
+// MinQuorumSize is a free data retrieval call binding the contract method 0xe50e652d.
+//
+// Solidity: function minQuorumSize(uint256 blockNumber) view returns(uint256)
+func (_EpochRewards *EpochRewardsCallerSession) MinQuorumSize(blockNumber *big.Int) (*big.Int, error) {
+	return _EpochRewards.Contract.MinQuorumSize(&_EpochRewards.CallOpts, blockNumber)
+}
+
+// MinQuorumSizeInCurrentSet is a free data retrieval call binding the contract method 0x7385e5da.
+//
+// Solidity: function minQuorumSizeInCurrentSet() view returns(uint256)
+func (_EpochRewards *EpochRewardsCaller) MinQuorumSizeInCurrentSet(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "minQuorumSizeInCurrentSet")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinQuorumSizeInCurrentSet is a free data retrieval call binding the contract method 0x7385e5da.
+//
+// Solidity: function minQuorumSizeInCurrentSet() view returns(uint256)
+func (_EpochRewards *EpochRewardsSession) MinQuorumSizeInCurrentSet() (*big.Int, error) {
+	return _EpochRewards.Contract.MinQuorumSizeInCurrentSet(&_EpochRewards.CallOpts)
+}
+
+// MinQuorumSizeInCurrentSet is a free data retrieval call binding the contract method 0x7385e5da.
+//
+// Solidity: function minQuorumSizeInCurrentSet() view returns(uint256)
+func (_EpochRewards *EpochRewardsCallerSession) MinQuorumSizeInCurrentSet() (*big.Int, error) {
+	return _EpochRewards.Contract.MinQuorumSizeInCurrentSet(&_EpochRewards.CallOpts)
+}
+
+// NumberValidatorsInCurrentSet is a free data retrieval call binding the contract method 0x87ee8a0f.
+//
+// Solidity: function numberValidatorsInCurrentSet() view returns(uint256)
+func (_EpochRewards *EpochRewardsCaller) NumberValidatorsInCurrentSet(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "numberValidatorsInCurrentSet")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NumberValidatorsInCurrentSet is a free data retrieval call binding the contract method 0x87ee8a0f.
+//
+// Solidity: function numberValidatorsInCurrentSet() view returns(uint256)
+func (_EpochRewards *EpochRewardsSession) NumberValidatorsInCurrentSet() (*big.Int, error) {
+	return _EpochRewards.Contract.NumberValidatorsInCurrentSet(&_EpochRewards.CallOpts)
+}
+
+// NumberValidatorsInCurrentSet is a free data retrieval call binding the contract method 0x87ee8a0f.
+//
+// Solidity: function numberValidatorsInCurrentSet() view returns(uint256)
+func (_EpochRewards *EpochRewardsCallerSession) NumberValidatorsInCurrentSet() (*big.Int, error) {
+	return _EpochRewards.Contract.NumberValidatorsInCurrentSet(&_EpochRewards.CallOpts)
+}
+
+// NumberValidatorsInSet is a free data retrieval call binding the contract method 0x9b2b592f.
+//
+// Solidity: function numberValidatorsInSet(uint256 blockNumber) view returns(uint256)
+func (_EpochRewards *EpochRewardsCaller) NumberValidatorsInSet(opts *bind.CallOpts, blockNumber *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "numberValidatorsInSet", blockNumber)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NumberValidatorsInSet is a free data retrieval call binding the contract method 0x9b2b592f.
+//
+// Solidity: function numberValidatorsInSet(uint256 blockNumber) view returns(uint256)
+func (_EpochRewards *EpochRewardsSession) NumberValidatorsInSet(blockNumber *big.Int) (*big.Int, error) {
+	return _EpochRewards.Contract.NumberValidatorsInSet(&_EpochRewards.CallOpts, blockNumber)
+}
+
+// NumberValidatorsInSet is a free data retrieval call binding the contract method 0x9b2b592f.
+//
+// Solidity: function numberValidatorsInSet(uint256 blockNumber) view returns(uint256)
+func (_EpochRewards *EpochRewardsCallerSession) NumberValidatorsInSet(blockNumber *big.Int) (*big.Int, error) {
+	return _EpochRewards.Contract.NumberValidatorsInSet(&_EpochRewards.CallOpts, blockNumber)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_EpochRewards *EpochRewardsCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_EpochRewards *EpochRewardsSession) Owner() (common.Address, error) {
+	return _EpochRewards.Contract.Owner(&_EpochRewards.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_EpochRewards *EpochRewardsCallerSession) Owner() (common.Address, error) {
+	return _EpochRewards.Contract.Owner(&_EpochRewards.CallOpts)
+}
+
+// Registry is a free data retrieval call binding the contract method 0x7b103999.
+//
+// Solidity: function registry() view returns(address)
+func (_EpochRewards *EpochRewardsCaller) Registry(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "registry")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Registry is a free data retrieval call binding the contract method 0x7b103999.
+//
+// Solidity: function registry() view returns(address)
+func (_EpochRewards *EpochRewardsSession) Registry() (common.Address, error) {
+	return _EpochRewards.Contract.Registry(&_EpochRewards.CallOpts)
+}
+
+// Registry is a free data retrieval call binding the contract method 0x7b103999.
+//
+// Solidity: function registry() view returns(address)
+func (_EpochRewards *EpochRewardsCallerSession) Registry() (common.Address, error) {
+	return _EpochRewards.Contract.Registry(&_EpochRewards.CallOpts)
+}
+
+// StartTime is a free data retrieval call binding the contract method 0x78e97925.
+//
+// Solidity: function startTime() view returns(uint256)
+func (_EpochRewards *EpochRewardsCaller) StartTime(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "startTime")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StartTime is a free data retrieval call binding the contract method 0x78e97925.
+//
+// Solidity: function startTime() view returns(uint256)
+func (_EpochRewards *EpochRewardsSession) StartTime() (*big.Int, error) {
+	return _EpochRewards.Contract.StartTime(&_EpochRewards.CallOpts)
+}
+
+// StartTime is a free data retrieval call binding the contract method 0x78e97925.
+//
+// Solidity: function startTime() view returns(uint256)
+func (_EpochRewards *EpochRewardsCallerSession) StartTime() (*big.Int, error) {
+	return _EpochRewards.Contract.StartTime(&_EpochRewards.CallOpts)
+}
+
+// ValidatorSignerAddressFromCurrentSet is a free data retrieval call binding the contract method 0x123633ea.
+//
+// Solidity: function validatorSignerAddressFromCurrentSet(uint256 index) view returns(address)
+func (_EpochRewards *EpochRewardsCaller) ValidatorSignerAddressFromCurrentSet(opts *bind.CallOpts, index *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "validatorSignerAddressFromCurrentSet", index)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ValidatorSignerAddressFromCurrentSet is a free data retrieval call binding the contract method 0x123633ea.
+//
+// Solidity: function validatorSignerAddressFromCurrentSet(uint256 index) view returns(address)
+func (_EpochRewards *EpochRewardsSession) ValidatorSignerAddressFromCurrentSet(index *big.Int) (common.Address, error) {
+	return _EpochRewards.Contract.ValidatorSignerAddressFromCurrentSet(&_EpochRewards.CallOpts, index)
+}
+
+// ValidatorSignerAddressFromCurrentSet is a free data retrieval call binding the contract method 0x123633ea.
+//
+// Solidity: function validatorSignerAddressFromCurrentSet(uint256 index) view returns(address)
+func (_EpochRewards *EpochRewardsCallerSession) ValidatorSignerAddressFromCurrentSet(index *big.Int) (common.Address, error) {
+	return _EpochRewards.Contract.ValidatorSignerAddressFromCurrentSet(&_EpochRewards.CallOpts, index)
+}
+
+// ValidatorSignerAddressFromSet is a free data retrieval call binding the contract method 0x5d180adb.
+//
+// Solidity: function validatorSignerAddressFromSet(uint256 index, uint256 blockNumber) view returns(address)
+func (_EpochRewards *EpochRewardsCaller) ValidatorSignerAddressFromSet(opts *bind.CallOpts, index *big.Int, blockNumber *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _EpochRewards.contract.Call(opts, &out, "validatorSignerAddressFromSet", index, blockNumber)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ValidatorSignerAddressFromSet is a free data retrieval call binding the contract method 0x5d180adb.
+//
+// Solidity: function validatorSignerAddressFromSet(uint256 index, uint256 blockNumber) view returns(address)
+func (_EpochRewards *EpochRewardsSession) ValidatorSignerAddressFromSet(index *big.Int, blockNumber *big.Int) (common.Address, error) {
+	return _EpochRewards.Contract.ValidatorSignerAddressFromSet(&_EpochRewards.CallOpts, index, blockNumber)
+}
+
+// ValidatorSignerAddressFromSet is a free data retrieval call binding the contract method 0x5d180adb.
+//
+// Solidity: function validatorSignerAddressFromSet(uint256 index, uint256 blockNumber) view returns(address)
+func (_EpochRewards *EpochRewardsCallerSession) ValidatorSignerAddressFromSet(index *big.Int, blockNumber *big.Int) (common.Address, error) {
+	return _EpochRewards.Contract.ValidatorSignerAddressFromSet(&_EpochRewards.CallOpts, index, blockNumber)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xbf9fe138.
+//
+// Solidity: function initialize(address registryAddress, uint256 _targetEpochPayment, uint256 _communityRewardFraction, uint256 _epochRelayerPaymentFraction, address _communityPartner, address mgrAddr) returns()
+func (_EpochRewards *EpochRewardsTransactor) Initialize(opts *bind.TransactOpts, registryAddress common.Address, _targetEpochPayment *big.Int, _communityRewardFraction *big.Int, _epochRelayerPaymentFraction *big.Int, _communityPartner common.Address, mgrAddr common.Address) (*types.Transaction, error) {
+	return _EpochRewards.contract.Transact(opts, "initialize", registryAddress, _targetEpochPayment, _communityRewardFraction, _epochRelayerPaymentFraction, _communityPartner, mgrAddr)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xbf9fe138.
+//
+// Solidity: function initialize(address registryAddress, uint256 _targetEpochPayment, uint256 _communityRewardFraction, uint256 _epochRelayerPaymentFraction, address _communityPartner, address mgrAddr) returns()
+func (_EpochRewards *EpochRewardsSession) Initialize(registryAddress common.Address, _targetEpochPayment *big.Int, _communityRewardFraction *big.Int, _epochRelayerPaymentFraction *big.Int, _communityPartner common.Address, mgrAddr common.Address) (*types.Transaction, error) {
+	return _EpochRewards.Contract.Initialize(&_EpochRewards.TransactOpts, registryAddress, _targetEpochPayment, _communityRewardFraction, _epochRelayerPaymentFraction, _communityPartner, mgrAddr)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xbf9fe138.
+//
+// Solidity: function initialize(address registryAddress, uint256 _targetEpochPayment, uint256 _communityRewardFraction, uint256 _epochRelayerPaymentFraction, address _communityPartner, address mgrAddr) returns()
+func (_EpochRewards *EpochRewardsTransactorSession) Initialize(registryAddress common.Address, _targetEpochPayment *big.Int, _communityRewardFraction *big.Int, _epochRelayerPaymentFraction *big.Int, _communityPartner common.Address, mgrAddr common.Address) (*types.Transaction, error) {
+	return _EpochRewards.Contract.Initialize(&_EpochRewards.TransactOpts, registryAddress, _targetEpochPayment, _communityRewardFraction, _epochRelayerPaymentFraction, _communityPartner, mgrAddr)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_EpochRewards *EpochRewardsTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EpochRewards.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_EpochRewards *EpochRewardsSession) RenounceOwnership() (*types.Transaction, error) {
+	return _EpochRewards.Contract.RenounceOwnership(&_EpochRewards.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_EpochRewards *EpochRewardsTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _EpochRewards.Contract.RenounceOwnership(&_EpochRewards.TransactOpts)
+}
+
+// SetCommunityRewardFraction is a paid mutator transaction binding the contract method 0xf66264a6.
+//
+// Solidity: function setCommunityRewardFraction(address partner, uint256 value) returns(bool)
+func (_EpochRewards *EpochRewardsTransactor) SetCommunityRewardFraction(opts *bind.TransactOpts, partner common.Address, value *big.Int) (*types.Transaction, error) {
+	return _EpochRewards.contract.Transact(opts, "setCommunityRewardFraction", partner, value)
+}
+
+// SetCommunityRewardFraction is a paid mutator transaction binding the contract method 0xf66264a6.
+//
+// Solidity: function setCommunityRewardFraction(address partner, uint256 value) returns(bool)
+func (_EpochRewards *EpochRewardsSession) SetCommunityRewardFraction(partner common.Address, value *big.Int) (*types.Transaction, error) {
+	return _EpochRewards.Contract.SetCommunityRewardFraction(&_EpochRewards.TransactOpts, partner, value)
+}
+
+// SetCommunityRewardFraction is a paid mutator transaction binding the contract method 0xf66264a6.
+//
+// Solidity: function setCommunityRewardFraction(address partner, uint256 value) returns(bool)
+func (_EpochRewards *EpochRewardsTransactorSession) SetCommunityRewardFraction(partner common.Address, value *big.Int) (*types.Transaction, error) {
+	return _EpochRewards.Contract.SetCommunityRewardFraction(&_EpochRewards.TransactOpts, partner, value)
+}
+
+// SetEpochMaintainerPaymentFraction is a paid mutator transaction binding the contract method 0x4e5248e3.
+//
+// Solidity: function setEpochMaintainerPaymentFraction(uint256 value) returns(bool)
+func (_EpochRewards *EpochRewardsTransactor) SetEpochMaintainerPaymentFraction(opts *bind.TransactOpts, value *big.Int) (*types.Transaction, error) {
+	return _EpochRewards.contract.Transact(opts, "setEpochMaintainerPaymentFraction", value)
+}
+
+// SetEpochMaintainerPaymentFraction is a paid mutator transaction binding the contract method 0x4e5248e3.
+//
+// Solidity: function setEpochMaintainerPaymentFraction(uint256 value) returns(bool)
+func (_EpochRewards *EpochRewardsSession) SetEpochMaintainerPaymentFraction(value *big.Int) (*types.Transaction, error) {
+	return _EpochRewards.Contract.SetEpochMaintainerPaymentFraction(&_EpochRewards.TransactOpts, value)
+}
+
+// SetEpochMaintainerPaymentFraction is a paid mutator transaction binding the contract method 0x4e5248e3.
+//
+// Solidity: function setEpochMaintainerPaymentFraction(uint256 value) returns(bool)
+func (_EpochRewards *EpochRewardsTransactorSession) SetEpochMaintainerPaymentFraction(value *big.Int) (*types.Transaction, error) {
+	return _EpochRewards.Contract.SetEpochMaintainerPaymentFraction(&_EpochRewards.TransactOpts, value)
+}
+
+// SetMgrMaintainerAddress is a paid mutator transaction binding the contract method 0x4449f240.
+//
+// Solidity: function setMgrMaintainerAddress(address addr) returns(bool)
+func (_EpochRewards *EpochRewardsTransactor) SetMgrMaintainerAddress(opts *bind.TransactOpts, addr common.Address) (*types.Transaction, error) {
+	return _EpochRewards.contract.Transact(opts, "setMgrMaintainerAddress", addr)
+}
+
+// SetMgrMaintainerAddress is a paid mutator transaction binding the contract method 0x4449f240.
+//
+// Solidity: function setMgrMaintainerAddress(address addr) returns(bool)
+func (_EpochRewards *EpochRewardsSession) SetMgrMaintainerAddress(addr common.Address) (*types.Transaction, error) {
+	return _EpochRewards.Contract.SetMgrMaintainerAddress(&_EpochRewards.TransactOpts, addr)
+}
+
+// SetMgrMaintainerAddress is a paid mutator transaction binding the contract method 0x4449f240.
+//
+// Solidity: function setMgrMaintainerAddress(address addr) returns(bool)
+func (_EpochRewards *EpochRewardsTransactorSession) SetMgrMaintainerAddress(addr common.Address) (*types.Transaction, error) {
+	return _EpochRewards.Contract.SetMgrMaintainerAddress(&_EpochRewards.TransactOpts, addr)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address registryAddress) returns()
+func (_EpochRewards *EpochRewardsTransactor) SetRegistry(opts *bind.TransactOpts, registryAddress common.Address) (*types.Transaction, error) {
+	return _EpochRewards.contract.Transact(opts, "setRegistry", registryAddress)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address registryAddress) returns()
+func (_EpochRewards *EpochRewardsSession) SetRegistry(registryAddress common.Address) (*types.Transaction, error) {
+	return _EpochRewards.Contract.SetRegistry(&_EpochRewards.TransactOpts, registryAddress)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address registryAddress) returns()
+func (_EpochRewards *EpochRewardsTransactorSession) SetRegistry(registryAddress common.Address) (*types.Transaction, error) {
+	return _EpochRewards.Contract.SetRegistry(&_EpochRewards.TransactOpts, registryAddress)
+}
+
+// SetTargetEpochPayment is a paid mutator transaction binding the contract method 0xb98ca2a0.
+//
+// Solidity: function setTargetEpochPayment(uint256 value) returns(bool)
+func (_EpochRewards *EpochRewardsTransactor) SetTargetEpochPayment(opts *bind.TransactOpts, value *big.Int) (*types.Transaction, error) {
+	return _EpochRewards.contract.Transact(opts, "setTargetEpochPayment", value)
+}
+
+// SetTargetEpochPayment is a paid mutator transaction binding the contract method 0xb98ca2a0.
+//
+// Solidity: function setTargetEpochPayment(uint256 value) returns(bool)
+func (_EpochRewards *EpochRewardsSession) SetTargetEpochPayment(value *big.Int) (*types.Transaction, error) {
+	return _EpochRewards.Contract.SetTargetEpochPayment(&_EpochRewards.TransactOpts, value)
+}
+
+// SetTargetEpochPayment is a paid mutator transaction binding the contract method 0xb98ca2a0.
+//
+// Solidity: function setTargetEpochPayment(uint256 value) returns(bool)
+func (_EpochRewards *EpochRewardsTransactorSession) SetTargetEpochPayment(value *big.Int) (*types.Transaction, error) {
+	return _EpochRewards.Contract.SetTargetEpochPayment(&_EpochRewards.TransactOpts, value)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_EpochRewards *EpochRewardsTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _EpochRewards.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_EpochRewards *EpochRewardsSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _EpochRewards.Contract.TransferOwnership(&_EpochRewards.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_EpochRewards *EpochRewardsTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _EpochRewards.Contract.TransferOwnership(&_EpochRewards.TransactOpts, newOwner)
+}
+
+// EpochRewardsCommunityRewardFundSetIterator is returned from FilterCommunityRewardFundSet and is used to iterate over the raw logs and unpacked data for CommunityRewardFundSet events raised by the EpochRewards contract.
+type EpochRewardsCommunityRewardFundSetIterator struct {
+	Event *EpochRewardsCommunityRewardFundSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EpochRewardsCommunityRewardFundSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EpochRewardsCommunityRewardFundSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EpochRewardsCommunityRewardFundSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EpochRewardsCommunityRewardFundSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EpochRewardsCommunityRewardFundSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EpochRewardsCommunityRewardFundSet represents a CommunityRewardFundSet event raised by the EpochRewards contract.
+type EpochRewardsCommunityRewardFundSet struct {
+	Partner  common.Address
+	Fraction *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterCommunityRewardFundSet is a free log retrieval operation binding the contract event 0x3b722543ff39106f8453064db46dd5e9988ffa1a80b3a032fd8500dc33c05970.
+//
+// Solidity: event CommunityRewardFundSet(address indexed partner, uint256 fraction)
+func (_EpochRewards *EpochRewardsFilterer) FilterCommunityRewardFundSet(opts *bind.FilterOpts, partner []common.Address) (*EpochRewardsCommunityRewardFundSetIterator, error) {
+
+	var partnerRule []interface{}
+	for _, partnerItem := range partner {
+		partnerRule = append(partnerRule, partnerItem)
+	}
+
+	logs, sub, err := _EpochRewards.contract.FilterLogs(opts, "CommunityRewardFundSet", partnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewardsCommunityRewardFundSetIterator{contract: _EpochRewards.contract, event: "CommunityRewardFundSet", logs: logs, sub: sub}, nil
+}
+
+// WatchCommunityRewardFundSet is a free log subscription operation binding the contract event 0x3b722543ff39106f8453064db46dd5e9988ffa1a80b3a032fd8500dc33c05970.
+//
+// Solidity: event CommunityRewardFundSet(address indexed partner, uint256 fraction)
+func (_EpochRewards *EpochRewardsFilterer) WatchCommunityRewardFundSet(opts *bind.WatchOpts, sink chan<- *EpochRewardsCommunityRewardFundSet, partner []common.Address) (event.Subscription, error) {
+
+	var partnerRule []interface{}
+	for _, partnerItem := range partner {
+		partnerRule = append(partnerRule, partnerItem)
+	}
+
+	logs, sub, err := _EpochRewards.contract.WatchLogs(opts, "CommunityRewardFundSet", partnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EpochRewardsCommunityRewardFundSet)
+				if err := _EpochRewards.contract.UnpackLog(event, "CommunityRewardFundSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCommunityRewardFundSet is a log parse operation binding the contract event 0x3b722543ff39106f8453064db46dd5e9988ffa1a80b3a032fd8500dc33c05970.
+//
+// Solidity: event CommunityRewardFundSet(address indexed partner, uint256 fraction)
+func (_EpochRewards *EpochRewardsFilterer) ParseCommunityRewardFundSet(log types.Log) (*EpochRewardsCommunityRewardFundSet, error) {
+	event := new(EpochRewardsCommunityRewardFundSet)
+	if err := _EpochRewards.contract.UnpackLog(event, "CommunityRewardFundSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EpochRewardsEpochRelayerPaymentFractionFundSetIterator is returned from FilterEpochRelayerPaymentFractionFundSet and is used to iterate over the raw logs and unpacked data for EpochRelayerPaymentFractionFundSet events raised by the EpochRewards contract.
+type EpochRewardsEpochRelayerPaymentFractionFundSetIterator struct {
+	Event *EpochRewardsEpochRelayerPaymentFractionFundSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EpochRewardsEpochRelayerPaymentFractionFundSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EpochRewardsEpochRelayerPaymentFractionFundSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EpochRewardsEpochRelayerPaymentFractionFundSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EpochRewardsEpochRelayerPaymentFractionFundSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EpochRewardsEpochRelayerPaymentFractionFundSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EpochRewardsEpochRelayerPaymentFractionFundSet represents a EpochRelayerPaymentFractionFundSet event raised by the EpochRewards contract.
+type EpochRewardsEpochRelayerPaymentFractionFundSet struct {
+	Fraction *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterEpochRelayerPaymentFractionFundSet is a free log retrieval operation binding the contract event 0x6ac957ebea8938ce435a10089a7b410dccb27d8ffa26db0042fde6af235b6b48.
+//
+// Solidity: event EpochRelayerPaymentFractionFundSet(uint256 fraction)
+func (_EpochRewards *EpochRewardsFilterer) FilterEpochRelayerPaymentFractionFundSet(opts *bind.FilterOpts) (*EpochRewardsEpochRelayerPaymentFractionFundSetIterator, error) {
+
+	logs, sub, err := _EpochRewards.contract.FilterLogs(opts, "EpochRelayerPaymentFractionFundSet")
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewardsEpochRelayerPaymentFractionFundSetIterator{contract: _EpochRewards.contract, event: "EpochRelayerPaymentFractionFundSet", logs: logs, sub: sub}, nil
+}
+
+// WatchEpochRelayerPaymentFractionFundSet is a free log subscription operation binding the contract event 0x6ac957ebea8938ce435a10089a7b410dccb27d8ffa26db0042fde6af235b6b48.
+//
+// Solidity: event EpochRelayerPaymentFractionFundSet(uint256 fraction)
+func (_EpochRewards *EpochRewardsFilterer) WatchEpochRelayerPaymentFractionFundSet(opts *bind.WatchOpts, sink chan<- *EpochRewardsEpochRelayerPaymentFractionFundSet) (event.Subscription, error) {
+
+	logs, sub, err := _EpochRewards.contract.WatchLogs(opts, "EpochRelayerPaymentFractionFundSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EpochRewardsEpochRelayerPaymentFractionFundSet)
+				if err := _EpochRewards.contract.UnpackLog(event, "EpochRelayerPaymentFractionFundSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEpochRelayerPaymentFractionFundSet is a log parse operation binding the contract event 0x6ac957ebea8938ce435a10089a7b410dccb27d8ffa26db0042fde6af235b6b48.
+//
+// Solidity: event EpochRelayerPaymentFractionFundSet(uint256 fraction)
+func (_EpochRewards *EpochRewardsFilterer) ParseEpochRelayerPaymentFractionFundSet(log types.Log) (*EpochRewardsEpochRelayerPaymentFractionFundSet, error) {
+	event := new(EpochRewardsEpochRelayerPaymentFractionFundSet)
+	if err := _EpochRewards.contract.UnpackLog(event, "EpochRelayerPaymentFractionFundSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EpochRewardsOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the EpochRewards contract.
+type EpochRewardsOwnershipTransferredIterator struct {
+	Event *EpochRewardsOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EpochRewardsOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EpochRewardsOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EpochRewardsOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EpochRewardsOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EpochRewardsOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EpochRewardsOwnershipTransferred represents a OwnershipTransferred event raised by the EpochRewards contract.
+type EpochRewardsOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_EpochRewards *EpochRewardsFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*EpochRewardsOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _EpochRewards.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewardsOwnershipTransferredIterator{contract: _EpochRewards.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_EpochRewards *EpochRewardsFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *EpochRewardsOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _EpochRewards.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EpochRewardsOwnershipTransferred)
+				if err := _EpochRewards.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_EpochRewards *EpochRewardsFilterer) ParseOwnershipTransferred(log types.Log) (*EpochRewardsOwnershipTransferred, error) {
+	event := new(EpochRewardsOwnershipTransferred)
+	if err := _EpochRewards.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EpochRewardsRegistrySetIterator is returned from FilterRegistrySet and is used to iterate over the raw logs and unpacked data for RegistrySet events raised by the EpochRewards contract.
+type EpochRewardsRegistrySetIterator struct {
+	Event *EpochRewardsRegistrySet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EpochRewardsRegistrySetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EpochRewardsRegistrySet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EpochRewardsRegistrySet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EpochRewardsRegistrySetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EpochRewardsRegistrySetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EpochRewardsRegistrySet represents a RegistrySet event raised by the EpochRewards contract.
+type EpochRewardsRegistrySet struct {
+	RegistryAddress common.Address
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterRegistrySet is a free log retrieval operation binding the contract event 0x27fe5f0c1c3b1ed427cc63d0f05759ffdecf9aec9e18d31ef366fc8a6cb5dc3b.
+//
+// Solidity: event RegistrySet(address indexed registryAddress)
+func (_EpochRewards *EpochRewardsFilterer) FilterRegistrySet(opts *bind.FilterOpts, registryAddress []common.Address) (*EpochRewardsRegistrySetIterator, error) {
+
+	var registryAddressRule []interface{}
+	for _, registryAddressItem := range registryAddress {
+		registryAddressRule = append(registryAddressRule, registryAddressItem)
+	}
+
+	logs, sub, err := _EpochRewards.contract.FilterLogs(opts, "RegistrySet", registryAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewardsRegistrySetIterator{contract: _EpochRewards.contract, event: "RegistrySet", logs: logs, sub: sub}, nil
+}
+
+// WatchRegistrySet is a free log subscription operation binding the contract event 0x27fe5f0c1c3b1ed427cc63d0f05759ffdecf9aec9e18d31ef366fc8a6cb5dc3b.
+//
+// Solidity: event RegistrySet(address indexed registryAddress)
+func (_EpochRewards *EpochRewardsFilterer) WatchRegistrySet(opts *bind.WatchOpts, sink chan<- *EpochRewardsRegistrySet, registryAddress []common.Address) (event.Subscription, error) {
+
+	var registryAddressRule []interface{}
+	for _, registryAddressItem := range registryAddress {
+		registryAddressRule = append(registryAddressRule, registryAddressItem)
+	}
+
+	logs, sub, err := _EpochRewards.contract.WatchLogs(opts, "RegistrySet", registryAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EpochRewardsRegistrySet)
+				if err := _EpochRewards.contract.UnpackLog(event, "RegistrySet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRegistrySet is a log parse operation binding the contract event 0x27fe5f0c1c3b1ed427cc63d0f05759ffdecf9aec9e18d31ef366fc8a6cb5dc3b.
+//
+// Solidity: event RegistrySet(address indexed registryAddress)
+func (_EpochRewards *EpochRewardsFilterer) ParseRegistrySet(log types.Log) (*EpochRewardsRegistrySet, error) {
+	event := new(EpochRewardsRegistrySet)
+	if err := _EpochRewards.contract.UnpackLog(event, "RegistrySet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EpochRewardsRewardsMultiplierParametersSetIterator is returned from FilterRewardsMultiplierParametersSet and is used to iterate over the raw logs and unpacked data for RewardsMultiplierParametersSet events raised by the EpochRewards contract.
+type EpochRewardsRewardsMultiplierParametersSetIterator struct {
+	Event *EpochRewardsRewardsMultiplierParametersSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EpochRewardsRewardsMultiplierParametersSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EpochRewardsRewardsMultiplierParametersSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EpochRewardsRewardsMultiplierParametersSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EpochRewardsRewardsMultiplierParametersSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EpochRewardsRewardsMultiplierParametersSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EpochRewardsRewardsMultiplierParametersSet represents a RewardsMultiplierParametersSet event raised by the EpochRewards contract.
+type EpochRewardsRewardsMultiplierParametersSet struct {
+	Max                        *big.Int
+	UnderspendAdjustmentFactor *big.Int
+	OverspendAdjustmentFactor  *big.Int
+	Raw                        types.Log // Blockchain specific contextual infos
+}
+
+// FilterRewardsMultiplierParametersSet is a free log retrieval operation binding the contract event 0x191445ee0115396c9725b9c642b985d63820ca57d54e42e5eb38faec4022f05d.
+//
+// Solidity: event RewardsMultiplierParametersSet(uint256 max, uint256 underspendAdjustmentFactor, uint256 overspendAdjustmentFactor)
+func (_EpochRewards *EpochRewardsFilterer) FilterRewardsMultiplierParametersSet(opts *bind.FilterOpts) (*EpochRewardsRewardsMultiplierParametersSetIterator, error) {
+
+	logs, sub, err := _EpochRewards.contract.FilterLogs(opts, "RewardsMultiplierParametersSet")
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewardsRewardsMultiplierParametersSetIterator{contract: _EpochRewards.contract, event: "RewardsMultiplierParametersSet", logs: logs, sub: sub}, nil
+}
+
+// WatchRewardsMultiplierParametersSet is a free log subscription operation binding the contract event 0x191445ee0115396c9725b9c642b985d63820ca57d54e42e5eb38faec4022f05d.
+//
+// Solidity: event RewardsMultiplierParametersSet(uint256 max, uint256 underspendAdjustmentFactor, uint256 overspendAdjustmentFactor)
+func (_EpochRewards *EpochRewardsFilterer) WatchRewardsMultiplierParametersSet(opts *bind.WatchOpts, sink chan<- *EpochRewardsRewardsMultiplierParametersSet) (event.Subscription, error) {
+
+	logs, sub, err := _EpochRewards.contract.WatchLogs(opts, "RewardsMultiplierParametersSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EpochRewardsRewardsMultiplierParametersSet)
+				if err := _EpochRewards.contract.UnpackLog(event, "RewardsMultiplierParametersSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRewardsMultiplierParametersSet is a log parse operation binding the contract event 0x191445ee0115396c9725b9c642b985d63820ca57d54e42e5eb38faec4022f05d.
+//
+// Solidity: event RewardsMultiplierParametersSet(uint256 max, uint256 underspendAdjustmentFactor, uint256 overspendAdjustmentFactor)
+func (_EpochRewards *EpochRewardsFilterer) ParseRewardsMultiplierParametersSet(log types.Log) (*EpochRewardsRewardsMultiplierParametersSet, error) {
+	event := new(EpochRewardsRewardsMultiplierParametersSet)
+	if err := _EpochRewards.contract.UnpackLog(event, "RewardsMultiplierParametersSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EpochRewardsTargetRelayerEpochPaymentSetIterator is returned from FilterTargetRelayerEpochPaymentSet and is used to iterate over the raw logs and unpacked data for TargetRelayerEpochPaymentSet events raised by the EpochRewards contract.
+type EpochRewardsTargetRelayerEpochPaymentSetIterator struct {
+	Event *EpochRewardsTargetRelayerEpochPaymentSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EpochRewardsTargetRelayerEpochPaymentSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EpochRewardsTargetRelayerEpochPaymentSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EpochRewardsTargetRelayerEpochPaymentSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EpochRewardsTargetRelayerEpochPaymentSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EpochRewardsTargetRelayerEpochPaymentSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EpochRewardsTargetRelayerEpochPaymentSet represents a TargetRelayerEpochPaymentSet event raised by the EpochRewards contract.
+type EpochRewardsTargetRelayerEpochPaymentSet struct {
+	Payment *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTargetRelayerEpochPaymentSet is a free log retrieval operation binding the contract event 0x9c85df856632a0a4d850b420fe3a52f1ad47bd00e655fa75284061b59a1636b8.
+//
+// Solidity: event TargetRelayerEpochPaymentSet(uint256 payment)
+func (_EpochRewards *EpochRewardsFilterer) FilterTargetRelayerEpochPaymentSet(opts *bind.FilterOpts) (*EpochRewardsTargetRelayerEpochPaymentSetIterator, error) {
+
+	logs, sub, err := _EpochRewards.contract.FilterLogs(opts, "TargetRelayerEpochPaymentSet")
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewardsTargetRelayerEpochPaymentSetIterator{contract: _EpochRewards.contract, event: "TargetRelayerEpochPaymentSet", logs: logs, sub: sub}, nil
+}
+
+// WatchTargetRelayerEpochPaymentSet is a free log subscription operation binding the contract event 0x9c85df856632a0a4d850b420fe3a52f1ad47bd00e655fa75284061b59a1636b8.
+//
+// Solidity: event TargetRelayerEpochPaymentSet(uint256 payment)
+func (_EpochRewards *EpochRewardsFilterer) WatchTargetRelayerEpochPaymentSet(opts *bind.WatchOpts, sink chan<- *EpochRewardsTargetRelayerEpochPaymentSet) (event.Subscription, error) {
+
+	logs, sub, err := _EpochRewards.contract.WatchLogs(opts, "TargetRelayerEpochPaymentSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EpochRewardsTargetRelayerEpochPaymentSet)
+				if err := _EpochRewards.contract.UnpackLog(event, "TargetRelayerEpochPaymentSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTargetRelayerEpochPaymentSet is a log parse operation binding the contract event 0x9c85df856632a0a4d850b420fe3a52f1ad47bd00e655fa75284061b59a1636b8.
+//
+// Solidity: event TargetRelayerEpochPaymentSet(uint256 payment)
+func (_EpochRewards *EpochRewardsFilterer) ParseTargetRelayerEpochPaymentSet(log types.Log) (*EpochRewardsTargetRelayerEpochPaymentSet, error) {
+	event := new(EpochRewardsTargetRelayerEpochPaymentSet)
+	if err := _EpochRewards.contract.UnpackLog(event, "TargetRelayerEpochPaymentSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EpochRewardsTargetValidatorEpochPaymentSetIterator is returned from FilterTargetValidatorEpochPaymentSet and is used to iterate over the raw logs and unpacked data for TargetValidatorEpochPaymentSet events raised by the EpochRewards contract.
+type EpochRewardsTargetValidatorEpochPaymentSetIterator struct {
+	Event *EpochRewardsTargetValidatorEpochPaymentSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EpochRewardsTargetValidatorEpochPaymentSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EpochRewardsTargetValidatorEpochPaymentSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EpochRewardsTargetValidatorEpochPaymentSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EpochRewardsTargetValidatorEpochPaymentSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EpochRewardsTargetValidatorEpochPaymentSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EpochRewardsTargetValidatorEpochPaymentSet represents a TargetValidatorEpochPaymentSet event raised by the EpochRewards contract.
+type EpochRewardsTargetValidatorEpochPaymentSet struct {
+	Payment *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTargetValidatorEpochPaymentSet is a free log retrieval operation binding the contract event 0xa21d141963bb2c1064b5376f762d22d3e9c4c51617edcf105bcec0f14e36800c.
+//
+// Solidity: event TargetValidatorEpochPaymentSet(uint256 payment)
+func (_EpochRewards *EpochRewardsFilterer) FilterTargetValidatorEpochPaymentSet(opts *bind.FilterOpts) (*EpochRewardsTargetValidatorEpochPaymentSetIterator, error) {
+
+	logs, sub, err := _EpochRewards.contract.FilterLogs(opts, "TargetValidatorEpochPaymentSet")
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewardsTargetValidatorEpochPaymentSetIterator{contract: _EpochRewards.contract, event: "TargetValidatorEpochPaymentSet", logs: logs, sub: sub}, nil
+}
+
+// WatchTargetValidatorEpochPaymentSet is a free log subscription operation binding the contract event 0xa21d141963bb2c1064b5376f762d22d3e9c4c51617edcf105bcec0f14e36800c.
+//
+// Solidity: event TargetValidatorEpochPaymentSet(uint256 payment)
+func (_EpochRewards *EpochRewardsFilterer) WatchTargetValidatorEpochPaymentSet(opts *bind.WatchOpts, sink chan<- *EpochRewardsTargetValidatorEpochPaymentSet) (event.Subscription, error) {
+
+	logs, sub, err := _EpochRewards.contract.WatchLogs(opts, "TargetValidatorEpochPaymentSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EpochRewardsTargetValidatorEpochPaymentSet)
+				if err := _EpochRewards.contract.UnpackLog(event, "TargetValidatorEpochPaymentSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTargetValidatorEpochPaymentSet is a log parse operation binding the contract event 0xa21d141963bb2c1064b5376f762d22d3e9c4c51617edcf105bcec0f14e36800c.
+//
+// Solidity: event TargetValidatorEpochPaymentSet(uint256 payment)
+func (_EpochRewards *EpochRewardsFilterer) ParseTargetValidatorEpochPaymentSet(log types.Log) (*EpochRewardsTargetValidatorEpochPaymentSet, error) {
+	event := new(EpochRewardsTargetValidatorEpochPaymentSet)
+	if err := _EpochRewards.contract.UnpackLog(event, "TargetValidatorEpochPaymentSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EpochRewardsTargetVotingGoldFractionSetIterator is returned from FilterTargetVotingGoldFractionSet and is used to iterate over the raw logs and unpacked data for TargetVotingGoldFractionSet events raised by the EpochRewards contract.
+type EpochRewardsTargetVotingGoldFractionSetIterator struct {
+	Event *EpochRewardsTargetVotingGoldFractionSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EpochRewardsTargetVotingGoldFractionSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EpochRewardsTargetVotingGoldFractionSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EpochRewardsTargetVotingGoldFractionSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EpochRewardsTargetVotingGoldFractionSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EpochRewardsTargetVotingGoldFractionSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EpochRewardsTargetVotingGoldFractionSet represents a TargetVotingGoldFractionSet event raised by the EpochRewards contract.
+type EpochRewardsTargetVotingGoldFractionSet struct {
+	Fraction *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterTargetVotingGoldFractionSet is a free log retrieval operation binding the contract event 0xbae2f33c70949fbc7325c98655f3039e5e1c7f774874c99fd4f31ec5f432b159.
+//
+// Solidity: event TargetVotingGoldFractionSet(uint256 fraction)
+func (_EpochRewards *EpochRewardsFilterer) FilterTargetVotingGoldFractionSet(opts *bind.FilterOpts) (*EpochRewardsTargetVotingGoldFractionSetIterator, error) {
+
+	logs, sub, err := _EpochRewards.contract.FilterLogs(opts, "TargetVotingGoldFractionSet")
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewardsTargetVotingGoldFractionSetIterator{contract: _EpochRewards.contract, event: "TargetVotingGoldFractionSet", logs: logs, sub: sub}, nil
+}
+
+// WatchTargetVotingGoldFractionSet is a free log subscription operation binding the contract event 0xbae2f33c70949fbc7325c98655f3039e5e1c7f774874c99fd4f31ec5f432b159.
+//
+// Solidity: event TargetVotingGoldFractionSet(uint256 fraction)
+func (_EpochRewards *EpochRewardsFilterer) WatchTargetVotingGoldFractionSet(opts *bind.WatchOpts, sink chan<- *EpochRewardsTargetVotingGoldFractionSet) (event.Subscription, error) {
+
+	logs, sub, err := _EpochRewards.contract.WatchLogs(opts, "TargetVotingGoldFractionSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EpochRewardsTargetVotingGoldFractionSet)
+				if err := _EpochRewards.contract.UnpackLog(event, "TargetVotingGoldFractionSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTargetVotingGoldFractionSet is a log parse operation binding the contract event 0xbae2f33c70949fbc7325c98655f3039e5e1c7f774874c99fd4f31ec5f432b159.
+//
+// Solidity: event TargetVotingGoldFractionSet(uint256 fraction)
+func (_EpochRewards *EpochRewardsFilterer) ParseTargetVotingGoldFractionSet(log types.Log) (*EpochRewardsTargetVotingGoldFractionSet, error) {
+	event := new(EpochRewardsTargetVotingGoldFractionSet)
+	if err := _EpochRewards.contract.UnpackLog(event, "TargetVotingGoldFractionSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EpochRewardsTargetVotingYieldParametersSetIterator is returned from FilterTargetVotingYieldParametersSet and is used to iterate over the raw logs and unpacked data for TargetVotingYieldParametersSet events raised by the EpochRewards contract.
+type EpochRewardsTargetVotingYieldParametersSetIterator struct {
+	Event *EpochRewardsTargetVotingYieldParametersSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EpochRewardsTargetVotingYieldParametersSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EpochRewardsTargetVotingYieldParametersSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EpochRewardsTargetVotingYieldParametersSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EpochRewardsTargetVotingYieldParametersSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EpochRewardsTargetVotingYieldParametersSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EpochRewardsTargetVotingYieldParametersSet represents a TargetVotingYieldParametersSet event raised by the EpochRewards contract.
+type EpochRewardsTargetVotingYieldParametersSet struct {
+	Max              *big.Int
+	AdjustmentFactor *big.Int
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterTargetVotingYieldParametersSet is a free log retrieval operation binding the contract event 0x1b76e38f3fdd1f284ed4d47c9d50ff407748c516ff9761616ff638c233107625.
+//
+// Solidity: event TargetVotingYieldParametersSet(uint256 max, uint256 adjustmentFactor)
+func (_EpochRewards *EpochRewardsFilterer) FilterTargetVotingYieldParametersSet(opts *bind.FilterOpts) (*EpochRewardsTargetVotingYieldParametersSetIterator, error) {
+
+	logs, sub, err := _EpochRewards.contract.FilterLogs(opts, "TargetVotingYieldParametersSet")
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewardsTargetVotingYieldParametersSetIterator{contract: _EpochRewards.contract, event: "TargetVotingYieldParametersSet", logs: logs, sub: sub}, nil
+}
+
+// WatchTargetVotingYieldParametersSet is a free log subscription operation binding the contract event 0x1b76e38f3fdd1f284ed4d47c9d50ff407748c516ff9761616ff638c233107625.
+//
+// Solidity: event TargetVotingYieldParametersSet(uint256 max, uint256 adjustmentFactor)
+func (_EpochRewards *EpochRewardsFilterer) WatchTargetVotingYieldParametersSet(opts *bind.WatchOpts, sink chan<- *EpochRewardsTargetVotingYieldParametersSet) (event.Subscription, error) {
+
+	logs, sub, err := _EpochRewards.contract.WatchLogs(opts, "TargetVotingYieldParametersSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EpochRewardsTargetVotingYieldParametersSet)
+				if err := _EpochRewards.contract.UnpackLog(event, "TargetVotingYieldParametersSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTargetVotingYieldParametersSet is a log parse operation binding the contract event 0x1b76e38f3fdd1f284ed4d47c9d50ff407748c516ff9761616ff638c233107625.
+//
+// Solidity: event TargetVotingYieldParametersSet(uint256 max, uint256 adjustmentFactor)
+func (_EpochRewards *EpochRewardsFilterer) ParseTargetVotingYieldParametersSet(log types.Log) (*EpochRewardsTargetVotingYieldParametersSet, error) {
+	event := new(EpochRewardsTargetVotingYieldParametersSet)
+	if err := _EpochRewards.contract.UnpackLog(event, "TargetVotingYieldParametersSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EpochRewardsTargetVotingYieldSetIterator is returned from FilterTargetVotingYieldSet and is used to iterate over the raw logs and unpacked data for TargetVotingYieldSet events raised by the EpochRewards contract.
+type EpochRewardsTargetVotingYieldSetIterator struct {
+	Event *EpochRewardsTargetVotingYieldSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EpochRewardsTargetVotingYieldSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EpochRewardsTargetVotingYieldSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EpochRewardsTargetVotingYieldSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EpochRewardsTargetVotingYieldSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EpochRewardsTargetVotingYieldSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EpochRewardsTargetVotingYieldSet represents a TargetVotingYieldSet event raised by the EpochRewards contract.
+type EpochRewardsTargetVotingYieldSet struct {
+	Target *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterTargetVotingYieldSet is a free log retrieval operation binding the contract event 0x152c3fc1e1cd415804bc9ae15876b37e62d8909358b940e6f4847ca927f46637.
+//
+// Solidity: event TargetVotingYieldSet(uint256 target)
+func (_EpochRewards *EpochRewardsFilterer) FilterTargetVotingYieldSet(opts *bind.FilterOpts) (*EpochRewardsTargetVotingYieldSetIterator, error) {
+
+	logs, sub, err := _EpochRewards.contract.FilterLogs(opts, "TargetVotingYieldSet")
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewardsTargetVotingYieldSetIterator{contract: _EpochRewards.contract, event: "TargetVotingYieldSet", logs: logs, sub: sub}, nil
+}
+
+// WatchTargetVotingYieldSet is a free log subscription operation binding the contract event 0x152c3fc1e1cd415804bc9ae15876b37e62d8909358b940e6f4847ca927f46637.
+//
+// Solidity: event TargetVotingYieldSet(uint256 target)
+func (_EpochRewards *EpochRewardsFilterer) WatchTargetVotingYieldSet(opts *bind.WatchOpts, sink chan<- *EpochRewardsTargetVotingYieldSet) (event.Subscription, error) {
+
+	logs, sub, err := _EpochRewards.contract.WatchLogs(opts, "TargetVotingYieldSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EpochRewardsTargetVotingYieldSet)
+				if err := _EpochRewards.contract.UnpackLog(event, "TargetVotingYieldSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTargetVotingYieldSet is a log parse operation binding the contract event 0x152c3fc1e1cd415804bc9ae15876b37e62d8909358b940e6f4847ca927f46637.
+//
+// Solidity: event TargetVotingYieldSet(uint256 target)
+func (_EpochRewards *EpochRewardsFilterer) ParseTargetVotingYieldSet(log types.Log) (*EpochRewardsTargetVotingYieldSet, error) {
+	event := new(EpochRewardsTargetVotingYieldSet)
+	if err := _EpochRewards.contract.UnpackLog(event, "TargetVotingYieldSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// EpochRewardsTargetVotingYieldUpdatedIterator is returned from FilterTargetVotingYieldUpdated and is used to iterate over the raw logs and unpacked data for TargetVotingYieldUpdated events raised by the EpochRewards contract.
+type EpochRewardsTargetVotingYieldUpdatedIterator struct {
+	Event *EpochRewardsTargetVotingYieldUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *EpochRewardsTargetVotingYieldUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(EpochRewardsTargetVotingYieldUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(EpochRewardsTargetVotingYieldUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *EpochRewardsTargetVotingYieldUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *EpochRewardsTargetVotingYieldUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// EpochRewardsTargetVotingYieldUpdated represents a TargetVotingYieldUpdated event raised by the EpochRewards contract.
+type EpochRewardsTargetVotingYieldUpdated struct {
+	Fraction *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterTargetVotingYieldUpdated is a free log retrieval operation binding the contract event 0x49d8cdfe05bae61517c234f65f4088454013bafe561115126a8fe0074dc7700e.
+//
+// Solidity: event TargetVotingYieldUpdated(uint256 fraction)
+func (_EpochRewards *EpochRewardsFilterer) FilterTargetVotingYieldUpdated(opts *bind.FilterOpts) (*EpochRewardsTargetVotingYieldUpdatedIterator, error) {
+
+	logs, sub, err := _EpochRewards.contract.FilterLogs(opts, "TargetVotingYieldUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &EpochRewardsTargetVotingYieldUpdatedIterator{contract: _EpochRewards.contract, event: "TargetVotingYieldUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchTargetVotingYieldUpdated is a free log subscription operation binding the contract event 0x49d8cdfe05bae61517c234f65f4088454013bafe561115126a8fe0074dc7700e.
+//
+// Solidity: event TargetVotingYieldUpdated(uint256 fraction)
+func (_EpochRewards *EpochRewardsFilterer) WatchTargetVotingYieldUpdated(opts *bind.WatchOpts, sink chan<- *EpochRewardsTargetVotingYieldUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _EpochRewards.contract.WatchLogs(opts, "TargetVotingYieldUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(EpochRewardsTargetVotingYieldUpdated)
+				if err := _EpochRewards.contract.UnpackLog(event, "TargetVotingYieldUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTargetVotingYieldUpdated is a log parse operation binding the contract event 0x49d8cdfe05bae61517c234f65f4088454013bafe561115126a8fe0074dc7700e.
+//
+// Solidity: event TargetVotingYieldUpdated(uint256 fraction)
+func (_EpochRewards *EpochRewardsFilterer) ParseTargetVotingYieldUpdated(log types.Log) (*EpochRewardsTargetVotingYieldUpdated, error) {
+	event := new(EpochRewardsTargetVotingYieldUpdated)
+	if err := _EpochRewards.contract.UnpackLog(event, "TargetVotingYieldUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
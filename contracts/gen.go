@@ -0,0 +1,88 @@
+//go:build ignore
+// +build ignore
+
+// gen regenerates the contract bindings from the ABIs embedded in
+// handler/abi.go. Run it through go generate from this directory.
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// bindings maps the ABI variable in handler/abi.go to the generated type and file.
+var bindings = []struct {
+	abiVar, typ, file string
+}{
+	{"EpochRewardsABI", "EpochRewards", "epochrewards.go"},
+	{"ElectionABI", "Election", "election.go"},
+	{"ValidatorsABI", "Validators", "validators.go"},
+	{"LockedGoldABI", "LockedGold", "lockedgold.go"},
+	{"ProxyABI", "Proxy", "proxy.go"},
+	{"GovernanceABI", "Governance", "governance.go"},
+	{"BlockchainParametersABI", "BlockchainParameters", "blockchainparameters.go"},
+	{"RegistryABI", "Registry", "registry.go"},
+}
+
+func main() {
+	abis, err := loadABIs("../handler/abi.go")
+	if err != nil {
+		fatal(err)
+	}
+	for _, b := range bindings {
+		abiJSON, ok := abis[b.abiVar]
+		if !ok {
+			fatal(fmt.Errorf("%s not found", b.abiVar))
+		}
+		code, err := bind.Bind([]string{b.typ}, []string{abiJSON}, []string{""}, nil, "contracts", bind.LangGo, nil, nil)
+		if err != nil {
+			fatal(fmt.Errorf("bind %s: %v", b.typ, err))
+		}
+		if err := ioutil.WriteFile(b.file, []byte(code), 0644); err != nil {
+			fatal(err)
+		}
+	}
+}
+
+// loadABIs returns the string value of every package level variable in file.
+func loadABIs(file string) (map[string]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	abis := make(map[string]string)
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, name := range vs.Names {
+				lit, ok := vs.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+				s, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					return nil, err
+				}
+				abis[name.Name] = strings.TrimSpace(s)
+			}
+		}
+	}
+	return abis, nil
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,850 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// RegistryMetaData contains all meta data concerning the Registry contract.
+var RegistryMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"test\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"identifier\",\"type\":\"string\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"identifierHash\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"RegistryUpdated\",\"type\":\"event\"},{\"constant\":true,\"inputs\":[],\"name\":\"initialized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"isOwner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"registry\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"initialize\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"string\",\"name\":\"identifier\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"setAddressFor\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"identifierHash\",\"type\":\"bytes32\"}],\"name\":\"getAddressForOrDie\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"identifierHash\",\"type\":\"bytes32\"}],\"name\":\"getAddressFor\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"identifier\",\"type\":\"string\"}],\"name\":\"getAddressForStringOrDie\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"identifier\",\"type\":\"string\"}],\"name\":\"getAddressForString\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes32[]\",\"name\":\"identifierHashes\",\"type\":\"bytes32[]\"},{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"isOneOf\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// RegistryABI is the input ABI used to generate the binding from.
+// Deprecated: Use RegistryMetaData.ABI instead.
+var RegistryABI = RegistryMetaData.ABI
+
+// Registry is an auto generated Go binding around an Ethereum contract.
+type Registry struct {
+	RegistryCaller     // Read-only binding to the contract
+	RegistryTransactor // Write-only binding to the contract
+	RegistryFilterer   // Log filterer for contract events
+}
+
+// RegistryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RegistryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RegistryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RegistryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RegistryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RegistryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RegistrySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RegistrySession struct {
+	Contract     *Registry         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// RegistryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RegistryCallerSession struct {
+	Contract *RegistryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// RegistryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RegistryTransactorSession struct {
+	Contract     *RegistryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// RegistryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RegistryRaw struct {
+	Contract *Registry // Generic contract binding to access the raw methods on
+}
+
+// RegistryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RegistryCallerRaw struct {
+	Contract *RegistryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RegistryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RegistryTransactorRaw struct {
+	Contract *RegistryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRegistry creates a new instance of Registry, bound to a specific deployed contract.
+func NewRegistry(address common.Address, backend bind.ContractBackend) (*Registry, error) {
+	contract, err := bindRegistry(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Registry{RegistryCaller: RegistryCaller{contract: contract}, RegistryTransactor: RegistryTransactor{contract: contract}, RegistryFilterer: RegistryFilterer{contract: contract}}, nil
+}
+
+// NewRegistryCaller creates a new read-only instance of Registry, bound to a specific deployed contract.
+func NewRegistryCaller(address common.Address, caller bind.ContractCaller) (*RegistryCaller, error) {
+	contract, err := bindRegistry(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryCaller{contract: contract}, nil
+}
+
+// NewRegistryTransactor creates a new write-only instance of Registry, bound to a specific deployed contract.
+func NewRegistryTransactor(address common.Address, transactor bind.ContractTransactor) (*RegistryTransactor, error) {
+	contract, err := bindRegistry(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryTransactor{contract: contract}, nil
+}
+
+// NewRegistryFilterer creates a new log filterer instance of Registry, bound to a specific deployed contract.
+func NewRegistryFilterer(address common.Address, filterer bind.ContractFilterer) (*RegistryFilterer, error) {
+	contract, err := bindRegistry(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryFilterer{contract: contract}, nil
+}
+
+// bindRegistry binds a generic wrapper to an already deployed contract.
+func bindRegistry(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(RegistryABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Registry *RegistryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Registry.Contract.RegistryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Registry *RegistryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Registry.Contract.RegistryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Registry *RegistryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Registry.Contract.RegistryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Registry *RegistryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Registry.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Registry *RegistryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Registry.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Registry *RegistryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Registry.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetAddressFor is a free data retrieval call binding the contract method 0xdd927233.
+//
+// Solidity: function getAddressFor(bytes32 identifierHash) view returns(address)
+func (_Registry *RegistryCaller) GetAddressFor(opts *bind.CallOpts, identifierHash [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "getAddressFor", identifierHash)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetAddressFor is a free data retrieval call binding the contract method 0xdd927233.
+//
+// Solidity: function getAddressFor(bytes32 identifierHash) view returns(address)
+func (_Registry *RegistrySession) GetAddressFor(identifierHash [32]byte) (common.Address, error) {
+	return _Registry.Contract.GetAddressFor(&_Registry.CallOpts, identifierHash)
+}
+
+// GetAddressFor is a free data retrieval call binding the contract method 0xdd927233.
+//
+// Solidity: function getAddressFor(bytes32 identifierHash) view returns(address)
+func (_Registry *RegistryCallerSession) GetAddressFor(identifierHash [32]byte) (common.Address, error) {
+	return _Registry.Contract.GetAddressFor(&_Registry.CallOpts, identifierHash)
+}
+
+// GetAddressForOrDie is a free data retrieval call binding the contract method 0xdcf0aaed.
+//
+// Solidity: function getAddressForOrDie(bytes32 identifierHash) view returns(address)
+func (_Registry *RegistryCaller) GetAddressForOrDie(opts *bind.CallOpts, identifierHash [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "getAddressForOrDie", identifierHash)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetAddressForOrDie is a free data retrieval call binding the contract method 0xdcf0aaed.
+//
+// Solidity: function getAddressForOrDie(bytes32 identifierHash) view returns(address)
+func (_Registry *RegistrySession) GetAddressForOrDie(identifierHash [32]byte) (common.Address, error) {
+	return _Registry.Contract.GetAddressForOrDie(&_Registry.CallOpts, identifierHash)
+}
+
+// GetAddressForOrDie is a free data retrieval call binding the contract method 0xdcf0aaed.
+//
+// Solidity: function getAddressForOrDie(bytes32 identifierHash) view returns(address)
+func (_Registry *RegistryCallerSession) GetAddressForOrDie(identifierHash [32]byte) (common.Address, error) {
+	return _Registry.Contract.GetAddressForOrDie(&_Registry.CallOpts, identifierHash)
+}
+
+// GetAddressForString is a free data retrieval call binding the contract method 0x853db323.
+//
+// Solidity: function getAddressForString(string identifier) view returns(address)
+func (_Registry *RegistryCaller) GetAddressForString(opts *bind.CallOpts, identifier string) (common.Address, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "getAddressForString", identifier)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetAddressForString is a free data retrieval call binding the contract method 0x853db323.
+//
+// Solidity: function getAddressForString(string identifier) view returns(address)
+func (_Registry *RegistrySession) GetAddressForString(identifier string) (common.Address, error) {
+	return _Registry.Contract.GetAddressForString(&_Registry.CallOpts, identifier)
+}
+
+// GetAddressForString is a free data retrieval call binding the contract method 0x853db323.
+//
+// Solidity: function getAddressForString(string identifier) view returns(address)
+func (_Registry *RegistryCallerSession) GetAddressForString(identifier string) (common.Address, error) {
+	return _Registry.Contract.GetAddressForString(&_Registry.CallOpts, identifier)
+}
+
+// GetAddressForStringOrDie is a free data retrieval call binding the contract method 0x8932cbf4.
+//
+// Solidity: function getAddressForStringOrDie(string identifier) view returns(address)
+func (_Registry *RegistryCaller) GetAddressForStringOrDie(opts *bind.CallOpts, identifier string) (common.Address, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "getAddressForStringOrDie", identifier)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetAddressForStringOrDie is a free data retrieval call binding the contract method 0x8932cbf4.
+//
+// Solidity: function getAddressForStringOrDie(string identifier) view returns(address)
+func (_Registry *RegistrySession) GetAddressForStringOrDie(identifier string) (common.Address, error) {
+	return _Registry.Contract.GetAddressForStringOrDie(&_Registry.CallOpts, identifier)
+}
+
+// GetAddressForStringOrDie is a free data retrieval call binding the contract method 0x8932cbf4.
+//
+// Solidity: function getAddressForStringOrDie(string identifier) view returns(address)
+func (_Registry *RegistryCallerSession) GetAddressForStringOrDie(identifier string) (common.Address, error) {
+	return _Registry.Contract.GetAddressForStringOrDie(&_Registry.CallOpts, identifier)
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() view returns(bool)
+func (_Registry *RegistryCaller) Initialized(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "initialized")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() view returns(bool)
+func (_Registry *RegistrySession) Initialized() (bool, error) {
+	return _Registry.Contract.Initialized(&_Registry.CallOpts)
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() view returns(bool)
+func (_Registry *RegistryCallerSession) Initialized() (bool, error) {
+	return _Registry.Contract.Initialized(&_Registry.CallOpts)
+}
+
+// IsOneOf is a free data retrieval call binding the contract method 0x17c50818.
+//
+// Solidity: function isOneOf(bytes32[] identifierHashes, address sender) view returns(bool)
+func (_Registry *RegistryCaller) IsOneOf(opts *bind.CallOpts, identifierHashes [][32]byte, sender common.Address) (bool, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "isOneOf", identifierHashes, sender)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOneOf is a free data retrieval call binding the contract method 0x17c50818.
+//
+// Solidity: function isOneOf(bytes32[] identifierHashes, address sender) view returns(bool)
+func (_Registry *RegistrySession) IsOneOf(identifierHashes [][32]byte, sender common.Address) (bool, error) {
+	return _Registry.Contract.IsOneOf(&_Registry.CallOpts, identifierHashes, sender)
+}
+
+// IsOneOf is a free data retrieval call binding the contract method 0x17c50818.
+//
+// Solidity: function isOneOf(bytes32[] identifierHashes, address sender) view returns(bool)
+func (_Registry *RegistryCallerSession) IsOneOf(identifierHashes [][32]byte, sender common.Address) (bool, error) {
+	return _Registry.Contract.IsOneOf(&_Registry.CallOpts, identifierHashes, sender)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_Registry *RegistryCaller) IsOwner(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "isOwner")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_Registry *RegistrySession) IsOwner() (bool, error) {
+	return _Registry.Contract.IsOwner(&_Registry.CallOpts)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_Registry *RegistryCallerSession) IsOwner() (bool, error) {
+	return _Registry.Contract.IsOwner(&_Registry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Registry *RegistryCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Registry *RegistrySession) Owner() (common.Address, error) {
+	return _Registry.Contract.Owner(&_Registry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Registry *RegistryCallerSession) Owner() (common.Address, error) {
+	return _Registry.Contract.Owner(&_Registry.CallOpts)
+}
+
+// Registry is a free data retrieval call binding the contract method 0x7ef50298.
+//
+// Solidity: function registry(bytes32 ) view returns(address)
+func (_Registry *RegistryCaller) Registry(opts *bind.CallOpts, arg0 [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := _Registry.contract.Call(opts, &out, "registry", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Registry is a free data retrieval call binding the contract method 0x7ef50298.
+//
+// Solidity: function registry(bytes32 ) view returns(address)
+func (_Registry *RegistrySession) Registry(arg0 [32]byte) (common.Address, error) {
+	return _Registry.Contract.Registry(&_Registry.CallOpts, arg0)
+}
+
+// Registry is a free data retrieval call binding the contract method 0x7ef50298.
+//
+// Solidity: function registry(bytes32 ) view returns(address)
+func (_Registry *RegistryCallerSession) Registry(arg0 [32]byte) (common.Address, error) {
+	return _Registry.Contract.Registry(&_Registry.CallOpts, arg0)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8129fc1c.
+//
+// Solidity: function initialize() returns()
+func (_Registry *RegistryTransactor) Initialize(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Registry.contract.Transact(opts, "initialize")
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8129fc1c.
+//
+// Solidity: function initialize() returns()
+func (_Registry *RegistrySession) Initialize() (*types.Transaction, error) {
+	return _Registry.Contract.Initialize(&_Registry.TransactOpts)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x8129fc1c.
+//
+// Solidity: function initialize() returns()
+func (_Registry *RegistryTransactorSession) Initialize() (*types.Transaction, error) {
+	return _Registry.Contract.Initialize(&_Registry.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Registry *RegistryTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Registry.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Registry *RegistrySession) RenounceOwnership() (*types.Transaction, error) {
+	return _Registry.Contract.RenounceOwnership(&_Registry.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Registry *RegistryTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Registry.Contract.RenounceOwnership(&_Registry.TransactOpts)
+}
+
+// SetAddressFor is a paid mutator transaction binding the contract method 0xc5865793.
+//
+// Solidity: function setAddressFor(string identifier, address addr) returns()
+func (_Registry *RegistryTransactor) SetAddressFor(opts *bind.TransactOpts, identifier string, addr common.Address) (*types.Transaction, error) {
+	return _Registry.contract.Transact(opts, "setAddressFor", identifier, addr)
+}
+
+// SetAddressFor is a paid mutator transaction binding the contract method 0xc5865793.
+//
+// Solidity: function setAddressFor(string identifier, address addr) returns()
+func (_Registry *RegistrySession) SetAddressFor(identifier string, addr common.Address) (*types.Transaction, error) {
+	return _Registry.Contract.SetAddressFor(&_Registry.TransactOpts, identifier, addr)
+}
+
+// SetAddressFor is a paid mutator transaction binding the contract method 0xc5865793.
+//
+// Solidity: function setAddressFor(string identifier, address addr) returns()
+func (_Registry *RegistryTransactorSession) SetAddressFor(identifier string, addr common.Address) (*types.Transaction, error) {
+	return _Registry.Contract.SetAddressFor(&_Registry.TransactOpts, identifier, addr)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Registry *RegistryTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _Registry.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Registry *RegistrySession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Registry.Contract.TransferOwnership(&_Registry.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Registry *RegistryTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Registry.Contract.TransferOwnership(&_Registry.TransactOpts, newOwner)
+}
+
+// RegistryOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Registry contract.
+type RegistryOwnershipTransferredIterator struct {
+	Event *RegistryOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RegistryOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RegistryOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RegistryOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RegistryOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RegistryOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RegistryOwnershipTransferred represents a OwnershipTransferred event raised by the Registry contract.
+type RegistryOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Registry *RegistryFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*RegistryOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Registry.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryOwnershipTransferredIterator{contract: _Registry.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Registry *RegistryFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *RegistryOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Registry.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RegistryOwnershipTransferred)
+				if err := _Registry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Registry *RegistryFilterer) ParseOwnershipTransferred(log types.Log) (*RegistryOwnershipTransferred, error) {
+	event := new(RegistryOwnershipTransferred)
+	if err := _Registry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RegistryRegistryUpdatedIterator is returned from FilterRegistryUpdated and is used to iterate over the raw logs and unpacked data for RegistryUpdated events raised by the Registry contract.
+type RegistryRegistryUpdatedIterator struct {
+	Event *RegistryRegistryUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RegistryRegistryUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RegistryRegistryUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RegistryRegistryUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RegistryRegistryUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RegistryRegistryUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RegistryRegistryUpdated represents a RegistryUpdated event raised by the Registry contract.
+type RegistryRegistryUpdated struct {
+	Identifier     string
+	IdentifierHash [32]byte
+	Addr           common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterRegistryUpdated is a free log retrieval operation binding the contract event 0x4166d073a7a5e704ce0db7113320f88da2457f872d46dc020c805c562c1582a0.
+//
+// Solidity: event RegistryUpdated(string identifier, bytes32 indexed identifierHash, address indexed addr)
+func (_Registry *RegistryFilterer) FilterRegistryUpdated(opts *bind.FilterOpts, identifierHash [][32]byte, addr []common.Address) (*RegistryRegistryUpdatedIterator, error) {
+
+	var identifierHashRule []interface{}
+	for _, identifierHashItem := range identifierHash {
+		identifierHashRule = append(identifierHashRule, identifierHashItem)
+	}
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+
+	logs, sub, err := _Registry.contract.FilterLogs(opts, "RegistryUpdated", identifierHashRule, addrRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RegistryRegistryUpdatedIterator{contract: _Registry.contract, event: "RegistryUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchRegistryUpdated is a free log subscription operation binding the contract event 0x4166d073a7a5e704ce0db7113320f88da2457f872d46dc020c805c562c1582a0.
+//
+// Solidity: event RegistryUpdated(string identifier, bytes32 indexed identifierHash, address indexed addr)
+func (_Registry *RegistryFilterer) WatchRegistryUpdated(opts *bind.WatchOpts, sink chan<- *RegistryRegistryUpdated, identifierHash [][32]byte, addr []common.Address) (event.Subscription, error) {
+
+	var identifierHashRule []interface{}
+	for _, identifierHashItem := range identifierHash {
+		identifierHashRule = append(identifierHashRule, identifierHashItem)
+	}
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+
+	logs, sub, err := _Registry.contract.WatchLogs(opts, "RegistryUpdated", identifierHashRule, addrRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RegistryRegistryUpdated)
+				if err := _Registry.contract.UnpackLog(event, "RegistryUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRegistryUpdated is a log parse operation binding the contract event 0x4166d073a7a5e704ce0db7113320f88da2457f872d46dc020c805c562c1582a0.
+//
+// Solidity: event RegistryUpdated(string identifier, bytes32 indexed identifierHash, address indexed addr)
+func (_Registry *RegistryFilterer) ParseRegistryUpdated(log types.Log) (*RegistryRegistryUpdated, error) {
+	event := new(RegistryRegistryUpdated)
+	if err := _Registry.contract.UnpackLog(event, "RegistryUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,4187 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ValidatorsMetaData contains all meta data concerning the Validators contract.
+var ValidatorsMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"test\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"delay\",\"type\":\"uint256\"}],\"name\":\"CommissionUpdateDelaySet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"delay\",\"type\":\"uint256\"}],\"name\":\"PledgeMultiplierInRewardSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"registryAddress\",\"type\":\"address\"}],\"name\":\"RegistrySet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"blsPublicKey\",\"type\":\"bytes\"}],\"name\":\"ValidatorBlsPublicKeyUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"commission\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"activationBlock\",\"type\":\"uint256\"}],\"name\":\"ValidatorCommissionUpdateQueued\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"commission\",\"type\":\"uint256\"}],\"name\":\"ValidatorCommissionUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"ValidatorDeregistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"ecdsaPublicKey\",\"type\":\"bytes\"}],\"name\":\"ValidatorEcdsaPublicKeyUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"validatorPayment\",\"type\":\"uint256\"}],\"name\":\"ValidatorEpochPaymentDistributed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"}],\"name\":\"ValidatorLockedGoldRequirementsSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"ValidatorPreDeregistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"commission\",\"type\":\"uint256\"}],\"name\":\"ValidatorRegistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"exponent\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"adjustmentSpeed\",\"type\":\"uint256\"}],\"name\":\"ValidatorScoreParametersSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"score\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epochScore\",\"type\":\"uint256\"}],\"name\":\"ValidatorScoreUpdated\",\"type\":\"event\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"blsKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"blsG1Key\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"blsPop\",\"type\":\"bytes\"}],\"name\":\"checkProofOfPossession\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"commissionUpdateDelay\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"downtimeGracePeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"aNumerator\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"aDenominator\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bNumerator\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bDenominator\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"exponent\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_decimals\",\"type\":\"uint256\"}],\"name\":\"fractionMulExp\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"header\",\"type\":\"bytes\"}],\"name\":\"getBlockNumberFromHeader\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getEpochNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"getEpochNumberOfBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getEpochSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"getParentSealBitmap\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"header\",\"type\":\"bytes\"}],\"name\":\"getVerifiedSealBitmapFromHeader\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"header\",\"type\":\"bytes\"}],\"name\":\"hashHeader\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"initialized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"isOwner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"minQuorumSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"minQuorumSizeInCurrentSet\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"numberValidatorsInCurrentSet\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"numberValidatorsInSet\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"pledgeMultiplierInReward\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"registry\",\"outputs\":[{\"internalType\":\"contractIRegistry\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"registryAddress\",\"type\":\"address\"}],\"name\":\"setRegistry\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"slashingMultiplierResetPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"validatorLockedGoldRequirements\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"validatorSignerAddressFromCurrentSet\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\"}],\"name\":\"validatorSignerAddressFromSet\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getVersionNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"registryAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"validatorRequirementValue\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"validatorRequirementDuration\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"validatorScoreExponent\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"validatorScoreAdjustmentSpeed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_slashingMultiplierResetPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_commissionUpdateDelay\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_pledgeMultiplierInReward\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_downtimeGracePeriod\",\"type\":\"uint256\"}],\"name\":\"initialize\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"delay\",\"type\":\"uint256\"}],\"name\":\"setCommissionUpdateDelay\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"pledgeMultiplier\",\"type\":\"uint256\"}],\"name\":\"setPledgeMultiplierInReward\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"exponent\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"adjustmentSpeed\",\"type\":\"uint256\"}],\"name\":\"setValidatorScoreParameters\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getCommissionUpdateDelay\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getPledgeMultiplierInReward\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"}],\"name\":\"setValidatorLockedGoldRequirements\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"commission\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"lesser\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"greater\",\"type\":\"address\"},{\"internalType\":\"bytes[]\",\"name\":\"blsBlsG1BlsPopEcdsaPub\",\"type\":\"bytes[]\"}],\"name\":\"registerValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"revertRegisterValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"isPendingDeRegisterValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getValidatorScoreParameters\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"uptime\",\"type\":\"uint256\"}],\"name\":\"calculateEpochScore\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"uptime\",\"type\":\"uint256\"}],\"name\":\"updateValidatorScoreFromSigner\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"maxPayment\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalScores\",\"type\":\"uint256\"}],\"name\":\"distributeEpochPaymentsFromSigner\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"deregisterValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"deRegisterAllValidatorsInPending\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"blsPublicKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"blsG1PublicKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"blsPop\",\"type\":\"bytes\"}],\"name\":\"updateBlsPublicKey\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"ecdsaPublicKey\",\"type\":\"bytes\"}],\"name\":\"updateEcdsaPublicKey\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"ecdsaPublicKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"blsPublicKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"blsG1PublicKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"blsPop\",\"type\":\"bytes\"}],\"name\":\"updatePublicKeys\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"commission\",\"type\":\"uint256\"}],\"name\":\"setNextCommissionUpdate\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"updateCommission\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getAccountLockedGoldRequirement\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"meetsAccountLockedGoldRequirements\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"}],\"name\":\"getValidatorBlsPublicKeyFromSigner\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"blsPublicKey\",\"type\":\"bytes\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"}],\"name\":\"getValidatorBlsG1PublicKeyFromSigner\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"blsG1PublicKey\",\"type\":\"bytes\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getValidator\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"ecdsaPublicKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"blsPublicKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"blsG1PublicKey\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"score\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"commission\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"nextCommission\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"nextCommissionBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"slashMultiplier\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lastSlashed\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"n\",\"type\":\"uint256\"}],\"name\":\"getTopValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getNumRegisteredValidators\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getValidatorLockedGoldRequirements\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getRegisteredValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getRegisteredValidatorSigners\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"isValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"setSlashingMultiplierResetPeriod\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"setDowntimeGracePeriod\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"resetSlashingMultiplier\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"halveSlashingMultiplier\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getValidatorSlashingMultiplier\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// ValidatorsABI is the input ABI used to generate the binding from.
+// Deprecated: Use ValidatorsMetaData.ABI instead.
+var ValidatorsABI = ValidatorsMetaData.ABI
+
+// Validators is an auto generated Go binding around an Ethereum contract.
+type Validators struct {
+	ValidatorsCaller     // Read-only binding to the contract
+	ValidatorsTransactor // Write-only binding to the contract
+	ValidatorsFilterer   // Log filterer for contract events
+}
+
+// ValidatorsCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ValidatorsCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ValidatorsTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ValidatorsTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ValidatorsFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ValidatorsFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ValidatorsSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ValidatorsSession struct {
+	Contract     *Validators       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ValidatorsCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ValidatorsCallerSession struct {
+	Contract *ValidatorsCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// ValidatorsTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ValidatorsTransactorSession struct {
+	Contract     *ValidatorsTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// ValidatorsRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ValidatorsRaw struct {
+	Contract *Validators // Generic contract binding to access the raw methods on
+}
+
+// ValidatorsCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ValidatorsCallerRaw struct {
+	Contract *ValidatorsCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ValidatorsTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ValidatorsTransactorRaw struct {
+	Contract *ValidatorsTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewValidators creates a new instance of Validators, bound to a specific deployed contract.
+func NewValidators(address common.Address, backend bind.ContractBackend) (*Validators, error) {
+	contract, err := bindValidators(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Validators{ValidatorsCaller: ValidatorsCaller{contract: contract}, ValidatorsTransactor: ValidatorsTransactor{contract: contract}, ValidatorsFilterer: ValidatorsFilterer{contract: contract}}, nil
+}
+
+// NewValidatorsCaller creates a new read-only instance of Validators, bound to a specific deployed contract.
+func NewValidatorsCaller(address common.Address, caller bind.ContractCaller) (*ValidatorsCaller, error) {
+	contract, err := bindValidators(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsCaller{contract: contract}, nil
+}
+
+// NewValidatorsTransactor creates a new write-only instance of Validators, bound to a specific deployed contract.
+func NewValidatorsTransactor(address common.Address, transactor bind.ContractTransactor) (*ValidatorsTransactor, error) {
+	contract, err := bindValidators(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsTransactor{contract: contract}, nil
+}
+
+// NewValidatorsFilterer creates a new log filterer instance of Validators, bound to a specific deployed contract.
+func NewValidatorsFilterer(address common.Address, filterer bind.ContractFilterer) (*ValidatorsFilterer, error) {
+	contract, err := bindValidators(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsFilterer{contract: contract}, nil
+}
+
+// bindValidators binds a generic wrapper to an already deployed contract.
+func bindValidators(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ValidatorsABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Validators *ValidatorsRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Validators.Contract.ValidatorsCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Validators *ValidatorsRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Validators.Contract.ValidatorsTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Validators *ValidatorsRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Validators.Contract.ValidatorsTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Validators *ValidatorsCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Validators.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Validators *ValidatorsTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Validators.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Validators *ValidatorsTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Validators.Contract.contract.Transact(opts, method, params...)
+}
+
+// CalculateEpochScore is a free data retrieval call binding the contract method 0x94903a97.
+//
+// Solidity: function calculateEpochScore(uint256 uptime) view returns(uint256)
+func (_Validators *ValidatorsCaller) CalculateEpochScore(opts *bind.CallOpts, uptime *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "calculateEpochScore", uptime)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CalculateEpochScore is a free data retrieval call binding the contract method 0x94903a97.
+//
+// Solidity: function calculateEpochScore(uint256 uptime) view returns(uint256)
+func (_Validators *ValidatorsSession) CalculateEpochScore(uptime *big.Int) (*big.Int, error) {
+	return _Validators.Contract.CalculateEpochScore(&_Validators.CallOpts, uptime)
+}
+
+// CalculateEpochScore is a free data retrieval call binding the contract method 0x94903a97.
+//
+// Solidity: function calculateEpochScore(uint256 uptime) view returns(uint256)
+func (_Validators *ValidatorsCallerSession) CalculateEpochScore(uptime *big.Int) (*big.Int, error) {
+	return _Validators.Contract.CalculateEpochScore(&_Validators.CallOpts, uptime)
+}
+
+// CheckProofOfPossession is a free data retrieval call binding the contract method 0xcab81d07.
+//
+// Solidity: function checkProofOfPossession(address sender, bytes blsKey, bytes blsG1Key, bytes blsPop) view returns(bool)
+func (_Validators *ValidatorsCaller) CheckProofOfPossession(opts *bind.CallOpts, sender common.Address, blsKey []byte, blsG1Key []byte, blsPop []byte) (bool, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "checkProofOfPossession", sender, blsKey, blsG1Key, blsPop)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// CheckProofOfPossession is a free data retrieval call binding the contract method 0xcab81d07.
+//
+// Solidity: function checkProofOfPossession(address sender, bytes blsKey, bytes blsG1Key, bytes blsPop) view returns(bool)
+func (_Validators *ValidatorsSession) CheckProofOfPossession(sender common.Address, blsKey []byte, blsG1Key []byte, blsPop []byte) (bool, error) {
+	return _Validators.Contract.CheckProofOfPossession(&_Validators.CallOpts, sender, blsKey, blsG1Key, blsPop)
+}
+
+// CheckProofOfPossession is a free data retrieval call binding the contract method 0xcab81d07.
+//
+// Solidity: function checkProofOfPossession(address sender, bytes blsKey, bytes blsG1Key, bytes blsPop) view returns(bool)
+func (_Validators *ValidatorsCallerSession) CheckProofOfPossession(sender common.Address, blsKey []byte, blsG1Key []byte, blsPop []byte) (bool, error) {
+	return _Validators.Contract.CheckProofOfPossession(&_Validators.CallOpts, sender, blsKey, blsG1Key, blsPop)
+}
+
+// CommissionUpdateDelay is a free data retrieval call binding the contract method 0xe0e3ffe6.
+//
+// Solidity: function commissionUpdateDelay() view returns(uint256)
+func (_Validators *ValidatorsCaller) CommissionUpdateDelay(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "commissionUpdateDelay")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CommissionUpdateDelay is a free data retrieval call binding the contract method 0xe0e3ffe6.
+//
+// Solidity: function commissionUpdateDelay() view returns(uint256)
+func (_Validators *ValidatorsSession) CommissionUpdateDelay() (*big.Int, error) {
+	return _Validators.Contract.CommissionUpdateDelay(&_Validators.CallOpts)
+}
+
+// CommissionUpdateDelay is a free data retrieval call binding the contract method 0xe0e3ffe6.
+//
+// Solidity: function commissionUpdateDelay() view returns(uint256)
+func (_Validators *ValidatorsCallerSession) CommissionUpdateDelay() (*big.Int, error) {
+	return _Validators.Contract.CommissionUpdateDelay(&_Validators.CallOpts)
+}
+
+// DowntimeGracePeriod is a free data retrieval call binding the contract method 0x0352a592.
+//
+// Solidity: function downtimeGracePeriod() view returns(uint256)
+func (_Validators *ValidatorsCaller) DowntimeGracePeriod(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "downtimeGracePeriod")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DowntimeGracePeriod is a free data retrieval call binding the contract method 0x0352a592.
+//
+// Solidity: function downtimeGracePeriod() view returns(uint256)
+func (_Validators *ValidatorsSession) DowntimeGracePeriod() (*big.Int, error) {
+	return _Validators.Contract.DowntimeGracePeriod(&_Validators.CallOpts)
+}
+
+// DowntimeGracePeriod is a free data retrieval call binding the contract method 0x0352a592.
+//
+// Solidity: function downtimeGracePeriod() view returns(uint256)
+func (_Validators *ValidatorsCallerSession) DowntimeGracePeriod() (*big.Int, error) {
+	return _Validators.Contract.DowntimeGracePeriod(&_Validators.CallOpts)
+}
+
+// FractionMulExp is a free data retrieval call binding the contract method 0xec683072.
+//
+// Solidity: function fractionMulExp(uint256 aNumerator, uint256 aDenominator, uint256 bNumerator, uint256 bDenominator, uint256 exponent, uint256 _decimals) view returns(uint256, uint256)
+func (_Validators *ValidatorsCaller) FractionMulExp(opts *bind.CallOpts, aNumerator *big.Int, aDenominator *big.Int, bNumerator *big.Int, bDenominator *big.Int, exponent *big.Int, _decimals *big.Int) (*big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "fractionMulExp", aNumerator, aDenominator, bNumerator, bDenominator, exponent, _decimals)
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// FractionMulExp is a free data retrieval call binding the contract method 0xec683072.
+//
+// Solidity: function fractionMulExp(uint256 aNumerator, uint256 aDenominator, uint256 bNumerator, uint256 bDenominator, uint256 exponent, uint256 _decimals) view returns(uint256, uint256)
+func (_Validators *ValidatorsSession) FractionMulExp(aNumerator *big.Int, aDenominator *big.Int, bNumerator *big.Int, bDenominator *big.Int, exponent *big.Int, _decimals *big.Int) (*big.Int, *big.Int, error) {
+	return _Validators.Contract.FractionMulExp(&_Validators.CallOpts, aNumerator, aDenominator, bNumerator, bDenominator, exponent, _decimals)
+}
+
+// FractionMulExp is a free data retrieval call binding the contract method 0xec683072.
+//
+// Solidity: function fractionMulExp(uint256 aNumerator, uint256 aDenominator, uint256 bNumerator, uint256 bDenominator, uint256 exponent, uint256 _decimals) view returns(uint256, uint256)
+func (_Validators *ValidatorsCallerSession) FractionMulExp(aNumerator *big.Int, aDenominator *big.Int, bNumerator *big.Int, bDenominator *big.Int, exponent *big.Int, _decimals *big.Int) (*big.Int, *big.Int, error) {
+	return _Validators.Contract.FractionMulExp(&_Validators.CallOpts, aNumerator, aDenominator, bNumerator, bDenominator, exponent, _decimals)
+}
+
+// GetAccountLockedGoldRequirement is a free data retrieval call binding the contract method 0xdcff4cf6.
+//
+// Solidity: function getAccountLockedGoldRequirement(address account) view returns(uint256)
+func (_Validators *ValidatorsCaller) GetAccountLockedGoldRequirement(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getAccountLockedGoldRequirement", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetAccountLockedGoldRequirement is a free data retrieval call binding the contract method 0xdcff4cf6.
+//
+// Solidity: function getAccountLockedGoldRequirement(address account) view returns(uint256)
+func (_Validators *ValidatorsSession) GetAccountLockedGoldRequirement(account common.Address) (*big.Int, error) {
+	return _Validators.Contract.GetAccountLockedGoldRequirement(&_Validators.CallOpts, account)
+}
+
+// GetAccountLockedGoldRequirement is a free data retrieval call binding the contract method 0xdcff4cf6.
+//
+// Solidity: function getAccountLockedGoldRequirement(address account) view returns(uint256)
+func (_Validators *ValidatorsCallerSession) GetAccountLockedGoldRequirement(account common.Address) (*big.Int, error) {
+	return _Validators.Contract.GetAccountLockedGoldRequirement(&_Validators.CallOpts, account)
+}
+
+// GetBlockNumberFromHeader is a free data retrieval call binding the contract method 0x8a883626.
+//
+// Solidity: function getBlockNumberFromHeader(bytes header) view returns(uint256)
+func (_Validators *ValidatorsCaller) GetBlockNumberFromHeader(opts *bind.CallOpts, header []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getBlockNumberFromHeader", header)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBlockNumberFromHeader is a free data retrieval call binding the contract method 0x8a883626.
+//
+// Solidity: function getBlockNumberFromHeader(bytes header) view returns(uint256)
+func (_Validators *ValidatorsSession) GetBlockNumberFromHeader(header []byte) (*big.Int, error) {
+	return _Validators.Contract.GetBlockNumberFromHeader(&_Validators.CallOpts, header)
+}
+
+// GetBlockNumberFromHeader is a free data retrieval call binding the contract method 0x8a883626.
+//
+// Solidity: function getBlockNumberFromHeader(bytes header) view returns(uint256)
+func (_Validators *ValidatorsCallerSession) GetBlockNumberFromHeader(header []byte) (*big.Int, error) {
+	return _Validators.Contract.GetBlockNumberFromHeader(&_Validators.CallOpts, header)
+}
+
+// GetCommissionUpdateDelay is a free data retrieval call binding the contract method 0xb915f530.
+//
+// Solidity: function getCommissionUpdateDelay() view returns(uint256)
+func (_Validators *ValidatorsCaller) GetCommissionUpdateDelay(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getCommissionUpdateDelay")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCommissionUpdateDelay is a free data retrieval call binding the contract method 0xb915f530.
+//
+// Solidity: function getCommissionUpdateDelay() view returns(uint256)
+func (_Validators *ValidatorsSession) GetCommissionUpdateDelay() (*big.Int, error) {
+	return _Validators.Contract.GetCommissionUpdateDelay(&_Validators.CallOpts)
+}
+
+// GetCommissionUpdateDelay is a free data retrieval call binding the contract method 0xb915f530.
+//
+// Solidity: function getCommissionUpdateDelay() view returns(uint256)
+func (_Validators *ValidatorsCallerSession) GetCommissionUpdateDelay() (*big.Int, error) {
+	return _Validators.Contract.GetCommissionUpdateDelay(&_Validators.CallOpts)
+}
+
+// GetEpochNumber is a free data retrieval call binding the contract method 0x9a7b3be7.
+//
+// Solidity: function getEpochNumber() view returns(uint256)
+func (_Validators *ValidatorsCaller) GetEpochNumber(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getEpochNumber")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetEpochNumber is a free data retrieval call binding the contract method 0x9a7b3be7.
+//
+// Solidity: function getEpochNumber() view returns(uint256)
+func (_Validators *ValidatorsSession) GetEpochNumber() (*big.Int, error) {
+	return _Validators.Contract.GetEpochNumber(&_Validators.CallOpts)
+}
+
+// GetEpochNumber is a free data retrieval call binding the contract method 0x9a7b3be7.
+//
+// Solidity: function getEpochNumber() view returns(uint256)
+func (_Validators *ValidatorsCallerSession) GetEpochNumber() (*big.Int, error) {
+	return _Validators.Contract.GetEpochNumber(&_Validators.CallOpts)
+}
+
+// GetEpochNumberOfBlock is a free data retrieval call binding the contract method 0x3b1eb4bf.
+//
+// Solidity: function getEpochNumberOfBlock(uint256 blockNumber) view returns(uint256)
+func (_Validators *ValidatorsCaller) GetEpochNumberOfBlock(opts *bind.CallOpts, blockNumber *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getEpochNumberOfBlock", blockNumber)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetEpochNumberOfBlock is a free data retrieval call binding the contract method 0x3b1eb4bf.
+//
+// Solidity: function getEpochNumberOfBlock(uint256 blockNumber) view returns(uint256)
+func (_Validators *ValidatorsSession) GetEpochNumberOfBlock(blockNumber *big.Int) (*big.Int, error) {
+	return _Validators.Contract.GetEpochNumberOfBlock(&_Validators.CallOpts, blockNumber)
+}
+
+// GetEpochNumberOfBlock is a free data retrieval call binding the contract method 0x3b1eb4bf.
+//
+// Solidity: function getEpochNumberOfBlock(uint256 blockNumber) view returns(uint256)
+func (_Validators *ValidatorsCallerSession) GetEpochNumberOfBlock(blockNumber *big.Int) (*big.Int, error) {
+	return _Validators.Contract.GetEpochNumberOfBlock(&_Validators.CallOpts, blockNumber)
+}
+
+// GetEpochSize is a free data retrieval call binding the contract method 0xdf4da461.
+//
+// Solidity: function getEpochSize() view returns(uint256)
+func (_Validators *ValidatorsCaller) GetEpochSize(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getEpochSize")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetEpochSize is a free data retrieval call binding the contract method 0xdf4da461.
+//
+// Solidity: function getEpochSize() view returns(uint256)
+func (_Validators *ValidatorsSession) GetEpochSize() (*big.Int, error) {
+	return _Validators.Contract.GetEpochSize(&_Validators.CallOpts)
+}
+
+// GetEpochSize is a free data retrieval call binding the contract method 0xdf4da461.
+//
+// Solidity: function getEpochSize() view returns(uint256)
+func (_Validators *ValidatorsCallerSession) GetEpochSize() (*big.Int, error) {
+	return _Validators.Contract.GetEpochSize(&_Validators.CallOpts)
+}
+
+// GetNumRegisteredValidators is a free data retrieval call binding the contract method 0x517f6d33.
+//
+// Solidity: function getNumRegisteredValidators() view returns(uint256)
+func (_Validators *ValidatorsCaller) GetNumRegisteredValidators(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getNumRegisteredValidators")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetNumRegisteredValidators is a free data retrieval call binding the contract method 0x517f6d33.
+//
+// Solidity: function getNumRegisteredValidators() view returns(uint256)
+func (_Validators *ValidatorsSession) GetNumRegisteredValidators() (*big.Int, error) {
+	return _Validators.Contract.GetNumRegisteredValidators(&_Validators.CallOpts)
+}
+
+// GetNumRegisteredValidators is a free data retrieval call binding the contract method 0x517f6d33.
+//
+// Solidity: function getNumRegisteredValidators() view returns(uint256)
+func (_Validators *ValidatorsCallerSession) GetNumRegisteredValidators() (*big.Int, error) {
+	return _Validators.Contract.GetNumRegisteredValidators(&_Validators.CallOpts)
+}
+
+// GetParentSealBitmap is a free data retrieval call binding the contract method 0xfae8db0a.
+//
+// Solidity: function getParentSealBitmap(uint256 blockNumber) view returns(bytes32)
+func (_Validators *ValidatorsCaller) GetParentSealBitmap(opts *bind.CallOpts, blockNumber *big.Int) ([32]byte, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getParentSealBitmap", blockNumber)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetParentSealBitmap is a free data retrieval call binding the contract method 0xfae8db0a.
+//
+// Solidity: function getParentSealBitmap(uint256 blockNumber) view returns(bytes32)
+func (_Validators *ValidatorsSession) GetParentSealBitmap(blockNumber *big.Int) ([32]byte, error) {
+	return _Validators.Contract.GetParentSealBitmap(&_Validators.CallOpts, blockNumber)
+}
+
+// GetParentSealBitmap is a free data retrieval call binding the contract method 0xfae8db0a.
+//
+// Solidity: function getParentSealBitmap(uint256 blockNumber) view returns(bytes32)
+func (_Validators *ValidatorsCallerSession) GetParentSealBitmap(blockNumber *big.Int) ([32]byte, error) {
+	return _Validators.Contract.GetParentSealBitmap(&_Validators.CallOpts, blockNumber)
+}
+
+// GetPledgeMultiplierInReward is a free data retrieval call binding the contract method 0x2efd59ac.
+//
+// Solidity: function getPledgeMultiplierInReward() view returns(uint256)
+func (_Validators *ValidatorsCaller) GetPledgeMultiplierInReward(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getPledgeMultiplierInReward")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetPledgeMultiplierInReward is a free data retrieval call binding the contract method 0x2efd59ac.
+//
+// Solidity: function getPledgeMultiplierInReward() view returns(uint256)
+func (_Validators *ValidatorsSession) GetPledgeMultiplierInReward() (*big.Int, error) {
+	return _Validators.Contract.GetPledgeMultiplierInReward(&_Validators.CallOpts)
+}
+
+// GetPledgeMultiplierInReward is a free data retrieval call binding the contract method 0x2efd59ac.
+//
+// Solidity: function getPledgeMultiplierInReward() view returns(uint256)
+func (_Validators *ValidatorsCallerSession) GetPledgeMultiplierInReward() (*big.Int, error) {
+	return _Validators.Contract.GetPledgeMultiplierInReward(&_Validators.CallOpts)
+}
+
+// GetRegisteredValidatorSigners is a free data retrieval call binding the contract method 0xd55dcbcf.
+//
+// Solidity: function getRegisteredValidatorSigners() view returns(address[])
+func (_Validators *ValidatorsCaller) GetRegisteredValidatorSigners(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getRegisteredValidatorSigners")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetRegisteredValidatorSigners is a free data retrieval call binding the contract method 0xd55dcbcf.
+//
+// Solidity: function getRegisteredValidatorSigners() view returns(address[])
+func (_Validators *ValidatorsSession) GetRegisteredValidatorSigners() ([]common.Address, error) {
+	return _Validators.Contract.GetRegisteredValidatorSigners(&_Validators.CallOpts)
+}
+
+// GetRegisteredValidatorSigners is a free data retrieval call binding the contract method 0xd55dcbcf.
+//
+// Solidity: function getRegisteredValidatorSigners() view returns(address[])
+func (_Validators *ValidatorsCallerSession) GetRegisteredValidatorSigners() ([]common.Address, error) {
+	return _Validators.Contract.GetRegisteredValidatorSigners(&_Validators.CallOpts)
+}
+
+// GetRegisteredValidators is a free data retrieval call binding the contract method 0xd93ab5ad.
+//
+// Solidity: function getRegisteredValidators() view returns(address[])
+func (_Validators *ValidatorsCaller) GetRegisteredValidators(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getRegisteredValidators")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetRegisteredValidators is a free data retrieval call binding the contract method 0xd93ab5ad.
+//
+// Solidity: function getRegisteredValidators() view returns(address[])
+func (_Validators *ValidatorsSession) GetRegisteredValidators() ([]common.Address, error) {
+	return _Validators.Contract.GetRegisteredValidators(&_Validators.CallOpts)
+}
+
+// GetRegisteredValidators is a free data retrieval call binding the contract method 0xd93ab5ad.
+//
+// Solidity: function getRegisteredValidators() view returns(address[])
+func (_Validators *ValidatorsCallerSession) GetRegisteredValidators() ([]common.Address, error) {
+	return _Validators.Contract.GetRegisteredValidators(&_Validators.CallOpts)
+}
+
+// GetTopValidators is a free data retrieval call binding the contract method 0x93a5b1b6.
+//
+// Solidity: function getTopValidators(uint256 n) view returns(address[])
+func (_Validators *ValidatorsCaller) GetTopValidators(opts *bind.CallOpts, n *big.Int) ([]common.Address, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getTopValidators", n)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetTopValidators is a free data retrieval call binding the contract method 0x93a5b1b6.
+//
+// Solidity: function getTopValidators(uint256 n) view returns(address[])
+func (_Validators *ValidatorsSession) GetTopValidators(n *big.Int) ([]common.Address, error) {
+	return _Validators.Contract.GetTopValidators(&_Validators.CallOpts, n)
+}
+
+// GetTopValidators is a free data retrieval call binding the contract method 0x93a5b1b6.
+//
+// Solidity: function getTopValidators(uint256 n) view returns(address[])
+func (_Validators *ValidatorsCallerSession) GetTopValidators(n *big.Int) ([]common.Address, error) {
+	return _Validators.Contract.GetTopValidators(&_Validators.CallOpts, n)
+}
+
+// GetValidator is a free data retrieval call binding the contract method 0x1904bb2e.
+//
+// Solidity: function getValidator(address account) view returns(bytes ecdsaPublicKey, bytes blsPublicKey, bytes blsG1PublicKey, uint256 score, address signer, uint256 commission, uint256 nextCommission, uint256 nextCommissionBlock, uint256 slashMultiplier, uint256 lastSlashed)
+func (_Validators *ValidatorsCaller) GetValidator(opts *bind.CallOpts, account common.Address) (struct {
+	EcdsaPublicKey      []byte
+	BlsPublicKey        []byte
+	BlsG1PublicKey      []byte
+	Score               *big.Int
+	Signer              common.Address
+	Commission          *big.Int
+	NextCommission      *big.Int
+	NextCommissionBlock *big.Int
+	SlashMultiplier     *big.Int
+	LastSlashed         *big.Int
+}, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getValidator", account)
+
+	outstruct := new(struct {
+		EcdsaPublicKey      []byte
+		BlsPublicKey        []byte
+		BlsG1PublicKey      []byte
+		Score               *big.Int
+		Signer              common.Address
+		Commission          *big.Int
+		NextCommission      *big.Int
+		NextCommissionBlock *big.Int
+		SlashMultiplier     *big.Int
+		LastSlashed         *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.EcdsaPublicKey = *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+	outstruct.BlsPublicKey = *abi.ConvertType(out[1], new([]byte)).(*[]byte)
+	outstruct.BlsG1PublicKey = *abi.ConvertType(out[2], new([]byte)).(*[]byte)
+	outstruct.Score = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.Signer = *abi.ConvertType(out[4], new(common.Address)).(*common.Address)
+	outstruct.Commission = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+	outstruct.NextCommission = *abi.ConvertType(out[6], new(*big.Int)).(**big.Int)
+	outstruct.NextCommissionBlock = *abi.ConvertType(out[7], new(*big.Int)).(**big.Int)
+	outstruct.SlashMultiplier = *abi.ConvertType(out[8], new(*big.Int)).(**big.Int)
+	outstruct.LastSlashed = *abi.ConvertType(out[9], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetValidator is a free data retrieval call binding the contract method 0x1904bb2e.
+//
+// Solidity: function getValidator(address account) view returns(bytes ecdsaPublicKey, bytes blsPublicKey, bytes blsG1PublicKey, uint256 score, address signer, uint256 commission, uint256 nextCommission, uint256 nextCommissionBlock, uint256 slashMultiplier, uint256 lastSlashed)
+func (_Validators *ValidatorsSession) GetValidator(account common.Address) (struct {
+	EcdsaPublicKey      []byte
+	BlsPublicKey        []byte
+	BlsG1PublicKey      []byte
+	Score               *big.Int
+	Signer              common.Address
+	Commission          *big.Int
+	NextCommission      *big.Int
+	NextCommissionBlock *big.Int
+	SlashMultiplier     *big.Int
+	LastSlashed         *big.Int
+}, error) {
+	return _Validators.Contract.GetValidator(&_Validators.CallOpts, account)
+}
+
+// GetValidator is a free data retrieval call binding the contract method 0x1904bb2e.
+//
+// Solidity: function getValidator(address account) view returns(bytes ecdsaPublicKey, bytes blsPublicKey, bytes blsG1PublicKey, uint256 score, address signer, uint256 commission, uint256 nextCommission, uint256 nextCommissionBlock, uint256 slashMultiplier, uint256 lastSlashed)
+func (_Validators *ValidatorsCallerSession) GetValidator(account common.Address) (struct {
+	EcdsaPublicKey      []byte
+	BlsPublicKey        []byte
+	BlsG1PublicKey      []byte
+	Score               *big.Int
+	Signer              common.Address
+	Commission          *big.Int
+	NextCommission      *big.Int
+	NextCommissionBlock *big.Int
+	SlashMultiplier     *big.Int
+	LastSlashed         *big.Int
+}, error) {
+	return _Validators.Contract.GetValidator(&_Validators.CallOpts, account)
+}
+
+// GetValidatorBlsG1PublicKeyFromSigner is a free data retrieval call binding the contract method 0xe32564bd.
+//
+// Solidity: function getValidatorBlsG1PublicKeyFromSigner(address signer) view returns(bytes blsG1PublicKey)
+func (_Validators *ValidatorsCaller) GetValidatorBlsG1PublicKeyFromSigner(opts *bind.CallOpts, signer common.Address) ([]byte, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getValidatorBlsG1PublicKeyFromSigner", signer)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetValidatorBlsG1PublicKeyFromSigner is a free data retrieval call binding the contract method 0xe32564bd.
+//
+// Solidity: function getValidatorBlsG1PublicKeyFromSigner(address signer) view returns(bytes blsG1PublicKey)
+func (_Validators *ValidatorsSession) GetValidatorBlsG1PublicKeyFromSigner(signer common.Address) ([]byte, error) {
+	return _Validators.Contract.GetValidatorBlsG1PublicKeyFromSigner(&_Validators.CallOpts, signer)
+}
+
+// GetValidatorBlsG1PublicKeyFromSigner is a free data retrieval call binding the contract method 0xe32564bd.
+//
+// Solidity: function getValidatorBlsG1PublicKeyFromSigner(address signer) view returns(bytes blsG1PublicKey)
+func (_Validators *ValidatorsCallerSession) GetValidatorBlsG1PublicKeyFromSigner(signer common.Address) ([]byte, error) {
+	return _Validators.Contract.GetValidatorBlsG1PublicKeyFromSigner(&_Validators.CallOpts, signer)
+}
+
+// GetValidatorBlsPublicKeyFromSigner is a free data retrieval call binding the contract method 0xb730a299.
+//
+// Solidity: function getValidatorBlsPublicKeyFromSigner(address signer) view returns(bytes blsPublicKey)
+func (_Validators *ValidatorsCaller) GetValidatorBlsPublicKeyFromSigner(opts *bind.CallOpts, signer common.Address) ([]byte, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getValidatorBlsPublicKeyFromSigner", signer)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetValidatorBlsPublicKeyFromSigner is a free data retrieval call binding the contract method 0xb730a299.
+//
+// Solidity: function getValidatorBlsPublicKeyFromSigner(address signer) view returns(bytes blsPublicKey)
+func (_Validators *ValidatorsSession) GetValidatorBlsPublicKeyFromSigner(signer common.Address) ([]byte, error) {
+	return _Validators.Contract.GetValidatorBlsPublicKeyFromSigner(&_Validators.CallOpts, signer)
+}
+
+// GetValidatorBlsPublicKeyFromSigner is a free data retrieval call binding the contract method 0xb730a299.
+//
+// Solidity: function getValidatorBlsPublicKeyFromSigner(address signer) view returns(bytes blsPublicKey)
+func (_Validators *ValidatorsCallerSession) GetValidatorBlsPublicKeyFromSigner(signer common.Address) ([]byte, error) {
+	return _Validators.Contract.GetValidatorBlsPublicKeyFromSigner(&_Validators.CallOpts, signer)
+}
+
+// GetValidatorLockedGoldRequirements is a free data retrieval call binding the contract method 0xc10c96ef.
+//
+// Solidity: function getValidatorLockedGoldRequirements() view returns(uint256, uint256)
+func (_Validators *ValidatorsCaller) GetValidatorLockedGoldRequirements(opts *bind.CallOpts) (*big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getValidatorLockedGoldRequirements")
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// GetValidatorLockedGoldRequirements is a free data retrieval call binding the contract method 0xc10c96ef.
+//
+// Solidity: function getValidatorLockedGoldRequirements() view returns(uint256, uint256)
+func (_Validators *ValidatorsSession) GetValidatorLockedGoldRequirements() (*big.Int, *big.Int, error) {
+	return _Validators.Contract.GetValidatorLockedGoldRequirements(&_Validators.CallOpts)
+}
+
+// GetValidatorLockedGoldRequirements is a free data retrieval call binding the contract method 0xc10c96ef.
+//
+// Solidity: function getValidatorLockedGoldRequirements() view returns(uint256, uint256)
+func (_Validators *ValidatorsCallerSession) GetValidatorLockedGoldRequirements() (*big.Int, *big.Int, error) {
+	return _Validators.Contract.GetValidatorLockedGoldRequirements(&_Validators.CallOpts)
+}
+
+// GetValidatorScoreParameters is a free data retrieval call binding the contract method 0x19113e3b.
+//
+// Solidity: function getValidatorScoreParameters() view returns(uint256, uint256)
+func (_Validators *ValidatorsCaller) GetValidatorScoreParameters(opts *bind.CallOpts) (*big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getValidatorScoreParameters")
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// GetValidatorScoreParameters is a free data retrieval call binding the contract method 0x19113e3b.
+//
+// Solidity: function getValidatorScoreParameters() view returns(uint256, uint256)
+func (_Validators *ValidatorsSession) GetValidatorScoreParameters() (*big.Int, *big.Int, error) {
+	return _Validators.Contract.GetValidatorScoreParameters(&_Validators.CallOpts)
+}
+
+// GetValidatorScoreParameters is a free data retrieval call binding the contract method 0x19113e3b.
+//
+// Solidity: function getValidatorScoreParameters() view returns(uint256, uint256)
+func (_Validators *ValidatorsCallerSession) GetValidatorScoreParameters() (*big.Int, *big.Int, error) {
+	return _Validators.Contract.GetValidatorScoreParameters(&_Validators.CallOpts)
+}
+
+// GetValidatorSlashingMultiplier is a free data retrieval call binding the contract method 0xd025a23f.
+//
+// Solidity: function getValidatorSlashingMultiplier(address account) view returns(uint256)
+func (_Validators *ValidatorsCaller) GetValidatorSlashingMultiplier(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getValidatorSlashingMultiplier", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetValidatorSlashingMultiplier is a free data retrieval call binding the contract method 0xd025a23f.
+//
+// Solidity: function getValidatorSlashingMultiplier(address account) view returns(uint256)
+func (_Validators *ValidatorsSession) GetValidatorSlashingMultiplier(account common.Address) (*big.Int, error) {
+	return _Validators.Contract.GetValidatorSlashingMultiplier(&_Validators.CallOpts, account)
+}
+
+// GetValidatorSlashingMultiplier is a free data retrieval call binding the contract method 0xd025a23f.
+//
+// Solidity: function getValidatorSlashingMultiplier(address account) view returns(uint256)
+func (_Validators *ValidatorsCallerSession) GetValidatorSlashingMultiplier(account common.Address) (*big.Int, error) {
+	return _Validators.Contract.GetValidatorSlashingMultiplier(&_Validators.CallOpts, account)
+}
+
+// GetVerifiedSealBitmapFromHeader is a free data retrieval call binding the contract method 0x4b2c2f44.
+//
+// Solidity: function getVerifiedSealBitmapFromHeader(bytes header) view returns(bytes32)
+func (_Validators *ValidatorsCaller) GetVerifiedSealBitmapFromHeader(opts *bind.CallOpts, header []byte) ([32]byte, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getVerifiedSealBitmapFromHeader", header)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetVerifiedSealBitmapFromHeader is a free data retrieval call binding the contract method 0x4b2c2f44.
+//
+// Solidity: function getVerifiedSealBitmapFromHeader(bytes header) view returns(bytes32)
+func (_Validators *ValidatorsSession) GetVerifiedSealBitmapFromHeader(header []byte) ([32]byte, error) {
+	return _Validators.Contract.GetVerifiedSealBitmapFromHeader(&_Validators.CallOpts, header)
+}
+
+// GetVerifiedSealBitmapFromHeader is a free data retrieval call binding the contract method 0x4b2c2f44.
+//
+// Solidity: function getVerifiedSealBitmapFromHeader(bytes header) view returns(bytes32)
+func (_Validators *ValidatorsCallerSession) GetVerifiedSealBitmapFromHeader(header []byte) ([32]byte, error) {
+	return _Validators.Contract.GetVerifiedSealBitmapFromHeader(&_Validators.CallOpts, header)
+}
+
+// GetVersionNumber is a free data retrieval call binding the contract method 0x54255be0.
+//
+// Solidity: function getVersionNumber() pure returns(uint256, uint256, uint256, uint256)
+func (_Validators *ValidatorsCaller) GetVersionNumber(opts *bind.CallOpts) (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "getVersionNumber")
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	out2 := *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	out3 := *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return out0, out1, out2, out3, err
+
+}
+
+// GetVersionNumber is a free data retrieval call binding the contract method 0x54255be0.
+//
+// Solidity: function getVersionNumber() pure returns(uint256, uint256, uint256, uint256)
+func (_Validators *ValidatorsSession) GetVersionNumber() (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	return _Validators.Contract.GetVersionNumber(&_Validators.CallOpts)
+}
+
+// GetVersionNumber is a free data retrieval call binding the contract method 0x54255be0.
+//
+// Solidity: function getVersionNumber() pure returns(uint256, uint256, uint256, uint256)
+func (_Validators *ValidatorsCallerSession) GetVersionNumber() (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	return _Validators.Contract.GetVersionNumber(&_Validators.CallOpts)
+}
+
+// HashHeader is a free data retrieval call binding the contract method 0x67960e91.
+//
+// Solidity: function hashHeader(bytes header) view returns(bytes32)
+func (_Validators *ValidatorsCaller) HashHeader(opts *bind.CallOpts, header []byte) ([32]byte, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "hashHeader", header)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// HashHeader is a free data retrieval call binding the contract method 0x67960e91.
+//
+// Solidity: function hashHeader(bytes header) view returns(bytes32)
+func (_Validators *ValidatorsSession) HashHeader(header []byte) ([32]byte, error) {
+	return _Validators.Contract.HashHeader(&_Validators.CallOpts, header)
+}
+
+// HashHeader is a free data retrieval call binding the contract method 0x67960e91.
+//
+// Solidity: function hashHeader(bytes header) view returns(bytes32)
+func (_Validators *ValidatorsCallerSession) HashHeader(header []byte) ([32]byte, error) {
+	return _Validators.Contract.HashHeader(&_Validators.CallOpts, header)
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() view returns(bool)
+func (_Validators *ValidatorsCaller) Initialized(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "initialized")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() view returns(bool)
+func (_Validators *ValidatorsSession) Initialized() (bool, error) {
+	return _Validators.Contract.Initialized(&_Validators.CallOpts)
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() view returns(bool)
+func (_Validators *ValidatorsCallerSession) Initialized() (bool, error) {
+	return _Validators.Contract.Initialized(&_Validators.CallOpts)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_Validators *ValidatorsCaller) IsOwner(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "isOwner")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_Validators *ValidatorsSession) IsOwner() (bool, error) {
+	return _Validators.Contract.IsOwner(&_Validators.CallOpts)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x8f32d59b.
+//
+// Solidity: function isOwner() view returns(bool)
+func (_Validators *ValidatorsCallerSession) IsOwner() (bool, error) {
+	return _Validators.Contract.IsOwner(&_Validators.CallOpts)
+}
+
+// IsPendingDeRegisterValidator is a free data retrieval call binding the contract method 0xf2918ef3.
+//
+// Solidity: function isPendingDeRegisterValidator() view returns(bool)
+func (_Validators *ValidatorsCaller) IsPendingDeRegisterValidator(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "isPendingDeRegisterValidator")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsPendingDeRegisterValidator is a free data retrieval call binding the contract method 0xf2918ef3.
+//
+// Solidity: function isPendingDeRegisterValidator() view returns(bool)
+func (_Validators *ValidatorsSession) IsPendingDeRegisterValidator() (bool, error) {
+	return _Validators.Contract.IsPendingDeRegisterValidator(&_Validators.CallOpts)
+}
+
+// IsPendingDeRegisterValidator is a free data retrieval call binding the contract method 0xf2918ef3.
+//
+// Solidity: function isPendingDeRegisterValidator() view returns(bool)
+func (_Validators *ValidatorsCallerSession) IsPendingDeRegisterValidator() (bool, error) {
+	return _Validators.Contract.IsPendingDeRegisterValidator(&_Validators.CallOpts)
+}
+
+// IsValidator is a free data retrieval call binding the contract method 0xfacd743b.
+//
+// Solidity: function isValidator(address account) view returns(bool)
+func (_Validators *ValidatorsCaller) IsValidator(opts *bind.CallOpts, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "isValidator", account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsValidator is a free data retrieval call binding the contract method 0xfacd743b.
+//
+// Solidity: function isValidator(address account) view returns(bool)
+func (_Validators *ValidatorsSession) IsValidator(account common.Address) (bool, error) {
+	return _Validators.Contract.IsValidator(&_Validators.CallOpts, account)
+}
+
+// IsValidator is a free data retrieval call binding the contract method 0xfacd743b.
+//
+// Solidity: function isValidator(address account) view returns(bool)
+func (_Validators *ValidatorsCallerSession) IsValidator(account common.Address) (bool, error) {
+	return _Validators.Contract.IsValidator(&_Validators.CallOpts, account)
+}
+
+// MeetsAccountLockedGoldRequirements is a free data retrieval call binding the contract method 0xc54c1cd4.
+//
+// Solidity: function meetsAccountLockedGoldRequirements(address account) view returns(bool)
+func (_Validators *ValidatorsCaller) MeetsAccountLockedGoldRequirements(opts *bind.CallOpts, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "meetsAccountLockedGoldRequirements", account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// MeetsAccountLockedGoldRequirements is a free data retrieval call binding the contract method 0xc54c1cd4.
+//
+// Solidity: function meetsAccountLockedGoldRequirements(address account) view returns(bool)
+func (_Validators *ValidatorsSession) MeetsAccountLockedGoldRequirements(account common.Address) (bool, error) {
+	return _Validators.Contract.MeetsAccountLockedGoldRequirements(&_Validators.CallOpts, account)
+}
+
+// MeetsAccountLockedGoldRequirements is a free data retrieval call binding the contract method 0xc54c1cd4.
+//
+// Solidity: function meetsAccountLockedGoldRequirements(address account) view returns(bool)
+func (_Validators *ValidatorsCallerSession) MeetsAccountLockedGoldRequirements(account common.Address) (bool, error) {
+	return _Validators.Contract.MeetsAccountLockedGoldRequirements(&_Validators.CallOpts, account)
+}
+
+// MinQuorumSize is a free data retrieval call binding the contract method 0xe50e652d.
+//
+// Solidity: function minQuorumSize(uint256 blockNumber) view returns(uint256)
+func (_Validators *ValidatorsCaller) MinQuorumSize(opts *bind.CallOpts, blockNumber *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "minQuorumSize", blockNumber)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinQuorumSize is a free data retrieval call binding the contract method 0xe50e652d.
+//
+// Solidity: function minQuorumSize(uint256 blockNumber) view returns(uint256)
+func (_Validators *ValidatorsSession) MinQuorumSize(blockNumber *big.Int) (*big.Int, error) {
+	return _Validators.Contract.MinQuorumSize(&_Validators.CallOpts, blockNumber)
+}
+
+// MinQuorumSize is a free data retrieval call binding the contract method 0xe50e652d.
+//
+// Solidity: function minQuorumSize(uint256 blockNumber) view returns(uint256)
+func (_Validators *ValidatorsCallerSession) MinQuorumSize(blockNumber *big.Int) (*big.Int, error) {
+	return _Validators.Contract.MinQuorumSize(&_Validators.CallOpts, blockNumber)
+}
+
+// MinQuorumSizeInCurrentSet is a free data retrieval call binding the contract method 0x7385e5da.
+//
+// Solidity: function minQuorumSizeInCurrentSet() view returns(uint256)
+func (_Validators *ValidatorsCaller) MinQuorumSizeInCurrentSet(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "minQuorumSizeInCurrentSet")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinQuorumSizeInCurrentSet is a free data retrieval call binding the contract method 0x7385e5da.
+//
+// Solidity: function minQuorumSizeInCurrentSet() view returns(uint256)
+func (_Validators *ValidatorsSession) MinQuorumSizeInCurrentSet() (*big.Int, error) {
+	return _Validators.Contract.MinQuorumSizeInCurrentSet(&_Validators.CallOpts)
+}
+
+// MinQuorumSizeInCurrentSet is a free data retrieval call binding the contract method 0x7385e5da.
+//
+// Solidity: function minQuorumSizeInCurrentSet() view returns(uint256)
+func (_Validators *ValidatorsCallerSession) MinQuorumSizeInCurrentSet() (*big.Int, error) {
+	return _Validators.Contract.MinQuorumSizeInCurrentSet(&_Validators.CallOpts)
+}
+
+// NumberValidatorsInCurrentSet is a free data retrieval call binding the contract method 0x87ee8a0f.
+//
+// Solidity: function numberValidatorsInCurrentSet() view returns(uint256)
+func (_Validators *ValidatorsCaller) NumberValidatorsInCurrentSet(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "numberValidatorsInCurrentSet")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NumberValidatorsInCurrentSet is a free data retrieval call binding the contract method 0x87ee8a0f.
+//
+// Solidity: function numberValidatorsInCurrentSet() view returns(uint256)
+func (_Validators *ValidatorsSession) NumberValidatorsInCurrentSet() (*big.Int, error) {
+	return _Validators.Contract.NumberValidatorsInCurrentSet(&_Validators.CallOpts)
+}
+
+// NumberValidatorsInCurrentSet is a free data retrieval call binding the contract method 0x87ee8a0f.
+//
+// Solidity: function numberValidatorsInCurrentSet() view returns(uint256)
+func (_Validators *ValidatorsCallerSession) NumberValidatorsInCurrentSet() (*big.Int, error) {
+	return _Validators.Contract.NumberValidatorsInCurrentSet(&_Validators.CallOpts)
+}
+
+// NumberValidatorsInSet is a free data retrieval call binding the contract method 0x9b2b592f.
+//
+// Solidity: function numberValidatorsInSet(uint256 blockNumber) view returns(uint256)
+func (_Validators *ValidatorsCaller) NumberValidatorsInSet(opts *bind.CallOpts, blockNumber *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "numberValidatorsInSet", blockNumber)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NumberValidatorsInSet is a free data retrieval call binding the contract method 0x9b2b592f.
+//
+// Solidity: function numberValidatorsInSet(uint256 blockNumber) view returns(uint256)
+func (_Validators *ValidatorsSession) NumberValidatorsInSet(blockNumber *big.Int) (*big.Int, error) {
+	return _Validators.Contract.NumberValidatorsInSet(&_Validators.CallOpts, blockNumber)
+}
+
+// NumberValidatorsInSet is a free data retrieval call binding the contract method 0x9b2b592f.
+//
+// Solidity: function numberValidatorsInSet(uint256 blockNumber) view returns(uint256)
+func (_Validators *ValidatorsCallerSession) NumberValidatorsInSet(blockNumber *big.Int) (*big.Int, error) {
+	return _Validators.Contract.NumberValidatorsInSet(&_Validators.CallOpts, blockNumber)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Validators *ValidatorsCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Validators *ValidatorsSession) Owner() (common.Address, error) {
+	return _Validators.Contract.Owner(&_Validators.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Validators *ValidatorsCallerSession) Owner() (common.Address, error) {
+	return _Validators.Contract.Owner(&_Validators.CallOpts)
+}
+
+// PledgeMultiplierInReward is a free data retrieval call binding the contract method 0xb22b636a.
+//
+// Solidity: function pledgeMultiplierInReward() view returns(uint256 value)
+func (_Validators *ValidatorsCaller) PledgeMultiplierInReward(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "pledgeMultiplierInReward")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PledgeMultiplierInReward is a free data retrieval call binding the contract method 0xb22b636a.
+//
+// Solidity: function pledgeMultiplierInReward() view returns(uint256 value)
+func (_Validators *ValidatorsSession) PledgeMultiplierInReward() (*big.Int, error) {
+	return _Validators.Contract.PledgeMultiplierInReward(&_Validators.CallOpts)
+}
+
+// PledgeMultiplierInReward is a free data retrieval call binding the contract method 0xb22b636a.
+//
+// Solidity: function pledgeMultiplierInReward() view returns(uint256 value)
+func (_Validators *ValidatorsCallerSession) PledgeMultiplierInReward() (*big.Int, error) {
+	return _Validators.Contract.PledgeMultiplierInReward(&_Validators.CallOpts)
+}
+
+// Registry is a free data retrieval call binding the contract method 0x7b103999.
+//
+// Solidity: function registry() view returns(address)
+func (_Validators *ValidatorsCaller) Registry(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "registry")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Registry is a free data retrieval call binding the contract method 0x7b103999.
+//
+// Solidity: function registry() view returns(address)
+func (_Validators *ValidatorsSession) Registry() (common.Address, error) {
+	return _Validators.Contract.Registry(&_Validators.CallOpts)
+}
+
+// Registry is a free data retrieval call binding the contract method 0x7b103999.
+//
+// Solidity: function registry() view returns(address)
+func (_Validators *ValidatorsCallerSession) Registry() (common.Address, error) {
+	return _Validators.Contract.Registry(&_Validators.CallOpts)
+}
+
+// SlashingMultiplierResetPeriod is a free data retrieval call binding the contract method 0x36407b70.
+//
+// Solidity: function slashingMultiplierResetPeriod() view returns(uint256)
+func (_Validators *ValidatorsCaller) SlashingMultiplierResetPeriod(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "slashingMultiplierResetPeriod")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SlashingMultiplierResetPeriod is a free data retrieval call binding the contract method 0x36407b70.
+//
+// Solidity: function slashingMultiplierResetPeriod() view returns(uint256)
+func (_Validators *ValidatorsSession) SlashingMultiplierResetPeriod() (*big.Int, error) {
+	return _Validators.Contract.SlashingMultiplierResetPeriod(&_Validators.CallOpts)
+}
+
+// SlashingMultiplierResetPeriod is a free data retrieval call binding the contract method 0x36407b70.
+//
+// Solidity: function slashingMultiplierResetPeriod() view returns(uint256)
+func (_Validators *ValidatorsCallerSession) SlashingMultiplierResetPeriod() (*big.Int, error) {
+	return _Validators.Contract.SlashingMultiplierResetPeriod(&_Validators.CallOpts)
+}
+
+// ValidatorLockedGoldRequirements is a free data retrieval call binding the contract method 0xbd9e9d94.
+//
+// Solidity: function validatorLockedGoldRequirements() view returns(uint256 value, uint256 duration)
+func (_Validators *ValidatorsCaller) ValidatorLockedGoldRequirements(opts *bind.CallOpts) (struct {
+	Value    *big.Int
+	Duration *big.Int
+}, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "validatorLockedGoldRequirements")
+
+	outstruct := new(struct {
+		Value    *big.Int
+		Duration *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Value = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Duration = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// ValidatorLockedGoldRequirements is a free data retrieval call binding the contract method 0xbd9e9d94.
+//
+// Solidity: function validatorLockedGoldRequirements() view returns(uint256 value, uint256 duration)
+func (_Validators *ValidatorsSession) ValidatorLockedGoldRequirements() (struct {
+	Value    *big.Int
+	Duration *big.Int
+}, error) {
+	return _Validators.Contract.ValidatorLockedGoldRequirements(&_Validators.CallOpts)
+}
+
+// ValidatorLockedGoldRequirements is a free data retrieval call binding the contract method 0xbd9e9d94.
+//
+// Solidity: function validatorLockedGoldRequirements() view returns(uint256 value, uint256 duration)
+func (_Validators *ValidatorsCallerSession) ValidatorLockedGoldRequirements() (struct {
+	Value    *big.Int
+	Duration *big.Int
+}, error) {
+	return _Validators.Contract.ValidatorLockedGoldRequirements(&_Validators.CallOpts)
+}
+
+// ValidatorSignerAddressFromCurrentSet is a free data retrieval call binding the contract method 0x123633ea.
+//
+// Solidity: function validatorSignerAddressFromCurrentSet(uint256 index) view returns(address)
+func (_Validators *ValidatorsCaller) ValidatorSignerAddressFromCurrentSet(opts *bind.CallOpts, index *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "validatorSignerAddressFromCurrentSet", index)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ValidatorSignerAddressFromCurrentSet is a free data retrieval call binding the contract method 0x123633ea.
+//
+// Solidity: function validatorSignerAddressFromCurrentSet(uint256 index) view returns(address)
+func (_Validators *ValidatorsSession) ValidatorSignerAddressFromCurrentSet(index *big.Int) (common.Address, error) {
+	return _Validators.Contract.ValidatorSignerAddressFromCurrentSet(&_Validators.CallOpts, index)
+}
+
+// ValidatorSignerAddressFromCurrentSet is a free data retrieval call binding the contract method 0x123633ea.
+//
+// Solidity: function validatorSignerAddressFromCurrentSet(uint256 index) view returns(address)
+func (_Validators *ValidatorsCallerSession) ValidatorSignerAddressFromCurrentSet(index *big.Int) (common.Address, error) {
+	return _Validators.Contract.ValidatorSignerAddressFromCurrentSet(&_Validators.CallOpts, index)
+}
+
+// ValidatorSignerAddressFromSet is a free data retrieval call binding the contract method 0x5d180adb.
+//
+// Solidity: function validatorSignerAddressFromSet(uint256 index, uint256 blockNumber) view returns(address)
+func (_Validators *ValidatorsCaller) ValidatorSignerAddressFromSet(opts *bind.CallOpts, index *big.Int, blockNumber *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _Validators.contract.Call(opts, &out, "validatorSignerAddressFromSet", index, blockNumber)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ValidatorSignerAddressFromSet is a free data retrieval call binding the contract method 0x5d180adb.
+//
+// Solidity: function validatorSignerAddressFromSet(uint256 index, uint256 blockNumber) view returns(address)
+func (_Validators *ValidatorsSession) ValidatorSignerAddressFromSet(index *big.Int, blockNumber *big.Int) (common.Address, error) {
+	return _Validators.Contract.ValidatorSignerAddressFromSet(&_Validators.CallOpts, index, blockNumber)
+}
+
+// ValidatorSignerAddressFromSet is a free data retrieval call binding the contract method 0x5d180adb.
+//
+// Solidity: function validatorSignerAddressFromSet(uint256 index, uint256 blockNumber) view returns(address)
+func (_Validators *ValidatorsCallerSession) ValidatorSignerAddressFromSet(index *big.Int, blockNumber *big.Int) (common.Address, error) {
+	return _Validators.Contract.ValidatorSignerAddressFromSet(&_Validators.CallOpts, index, blockNumber)
+}
+
+// DeRegisterAllValidatorsInPending is a paid mutator transaction binding the contract method 0xe8c24b2d.
+//
+// Solidity: function deRegisterAllValidatorsInPending() returns(address[])
+func (_Validators *ValidatorsTransactor) DeRegisterAllValidatorsInPending(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "deRegisterAllValidatorsInPending")
+}
+
+// DeRegisterAllValidatorsInPending is a paid mutator transaction binding the contract method 0xe8c24b2d.
+//
+// Solidity: function deRegisterAllValidatorsInPending() returns(address[])
+func (_Validators *ValidatorsSession) DeRegisterAllValidatorsInPending() (*types.Transaction, error) {
+	return _Validators.Contract.DeRegisterAllValidatorsInPending(&_Validators.TransactOpts)
+}
+
+// DeRegisterAllValidatorsInPending is a paid mutator transaction binding the contract method 0xe8c24b2d.
+//
+// Solidity: function deRegisterAllValidatorsInPending() returns(address[])
+func (_Validators *ValidatorsTransactorSession) DeRegisterAllValidatorsInPending() (*types.Transaction, error) {
+	return _Validators.Contract.DeRegisterAllValidatorsInPending(&_Validators.TransactOpts)
+}
+
+// DeregisterValidator is a paid mutator transaction binding the contract method 0x6a911ccf.
+//
+// Solidity: function deregisterValidator() returns(bool)
+func (_Validators *ValidatorsTransactor) DeregisterValidator(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "deregisterValidator")
+}
+
+// DeregisterValidator is a paid mutator transaction binding the contract method 0x6a911ccf.
+//
+// Solidity: function deregisterValidator() returns(bool)
+func (_Validators *ValidatorsSession) DeregisterValidator() (*types.Transaction, error) {
+	return _Validators.Contract.DeregisterValidator(&_Validators.TransactOpts)
+}
+
+// DeregisterValidator is a paid mutator transaction binding the contract method 0x6a911ccf.
+//
+// Solidity: function deregisterValidator() returns(bool)
+func (_Validators *ValidatorsTransactorSession) DeregisterValidator() (*types.Transaction, error) {
+	return _Validators.Contract.DeregisterValidator(&_Validators.TransactOpts)
+}
+
+// DistributeEpochPaymentsFromSigner is a paid mutator transaction binding the contract method 0x99f91b0a.
+//
+// Solidity: function distributeEpochPaymentsFromSigner(address signer, uint256 maxPayment, uint256 totalScores) returns(uint256, uint256)
+func (_Validators *ValidatorsTransactor) DistributeEpochPaymentsFromSigner(opts *bind.TransactOpts, signer common.Address, maxPayment *big.Int, totalScores *big.Int) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "distributeEpochPaymentsFromSigner", signer, maxPayment, totalScores)
+}
+
+// DistributeEpochPaymentsFromSigner is a paid mutator transaction binding the contract method 0x99f91b0a.
+//
+// Solidity: function distributeEpochPaymentsFromSigner(address signer, uint256 maxPayment, uint256 totalScores) returns(uint256, uint256)
+func (_Validators *ValidatorsSession) DistributeEpochPaymentsFromSigner(signer common.Address, maxPayment *big.Int, totalScores *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.DistributeEpochPaymentsFromSigner(&_Validators.TransactOpts, signer, maxPayment, totalScores)
+}
+
+// DistributeEpochPaymentsFromSigner is a paid mutator transaction binding the contract method 0x99f91b0a.
+//
+// Solidity: function distributeEpochPaymentsFromSigner(address signer, uint256 maxPayment, uint256 totalScores) returns(uint256, uint256)
+func (_Validators *ValidatorsTransactorSession) DistributeEpochPaymentsFromSigner(signer common.Address, maxPayment *big.Int, totalScores *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.DistributeEpochPaymentsFromSigner(&_Validators.TransactOpts, signer, maxPayment, totalScores)
+}
+
+// HalveSlashingMultiplier is a paid mutator transaction binding the contract method 0xc22d3bba.
+//
+// Solidity: function halveSlashingMultiplier(address account) returns()
+func (_Validators *ValidatorsTransactor) HalveSlashingMultiplier(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "halveSlashingMultiplier", account)
+}
+
+// HalveSlashingMultiplier is a paid mutator transaction binding the contract method 0xc22d3bba.
+//
+// Solidity: function halveSlashingMultiplier(address account) returns()
+func (_Validators *ValidatorsSession) HalveSlashingMultiplier(account common.Address) (*types.Transaction, error) {
+	return _Validators.Contract.HalveSlashingMultiplier(&_Validators.TransactOpts, account)
+}
+
+// HalveSlashingMultiplier is a paid mutator transaction binding the contract method 0xc22d3bba.
+//
+// Solidity: function halveSlashingMultiplier(address account) returns()
+func (_Validators *ValidatorsTransactorSession) HalveSlashingMultiplier(account common.Address) (*types.Transaction, error) {
+	return _Validators.Contract.HalveSlashingMultiplier(&_Validators.TransactOpts, account)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xd114e6fc.
+//
+// Solidity: function initialize(address registryAddress, uint256 validatorRequirementValue, uint256 validatorRequirementDuration, uint256 validatorScoreExponent, uint256 validatorScoreAdjustmentSpeed, uint256 _slashingMultiplierResetPeriod, uint256 _commissionUpdateDelay, uint256 _pledgeMultiplierInReward, uint256 _downtimeGracePeriod) returns()
+func (_Validators *ValidatorsTransactor) Initialize(opts *bind.TransactOpts, registryAddress common.Address, validatorRequirementValue *big.Int, validatorRequirementDuration *big.Int, validatorScoreExponent *big.Int, validatorScoreAdjustmentSpeed *big.Int, _slashingMultiplierResetPeriod *big.Int, _commissionUpdateDelay *big.Int, _pledgeMultiplierInReward *big.Int, _downtimeGracePeriod *big.Int) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "initialize", registryAddress, validatorRequirementValue, validatorRequirementDuration, validatorScoreExponent, validatorScoreAdjustmentSpeed, _slashingMultiplierResetPeriod, _commissionUpdateDelay, _pledgeMultiplierInReward, _downtimeGracePeriod)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xd114e6fc.
+//
+// Solidity: function initialize(address registryAddress, uint256 validatorRequirementValue, uint256 validatorRequirementDuration, uint256 validatorScoreExponent, uint256 validatorScoreAdjustmentSpeed, uint256 _slashingMultiplierResetPeriod, uint256 _commissionUpdateDelay, uint256 _pledgeMultiplierInReward, uint256 _downtimeGracePeriod) returns()
+func (_Validators *ValidatorsSession) Initialize(registryAddress common.Address, validatorRequirementValue *big.Int, validatorRequirementDuration *big.Int, validatorScoreExponent *big.Int, validatorScoreAdjustmentSpeed *big.Int, _slashingMultiplierResetPeriod *big.Int, _commissionUpdateDelay *big.Int, _pledgeMultiplierInReward *big.Int, _downtimeGracePeriod *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.Initialize(&_Validators.TransactOpts, registryAddress, validatorRequirementValue, validatorRequirementDuration, validatorScoreExponent, validatorScoreAdjustmentSpeed, _slashingMultiplierResetPeriod, _commissionUpdateDelay, _pledgeMultiplierInReward, _downtimeGracePeriod)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xd114e6fc.
+//
+// Solidity: function initialize(address registryAddress, uint256 validatorRequirementValue, uint256 validatorRequirementDuration, uint256 validatorScoreExponent, uint256 validatorScoreAdjustmentSpeed, uint256 _slashingMultiplierResetPeriod, uint256 _commissionUpdateDelay, uint256 _pledgeMultiplierInReward, uint256 _downtimeGracePeriod) returns()
+func (_Validators *ValidatorsTransactorSession) Initialize(registryAddress common.Address, validatorRequirementValue *big.Int, validatorRequirementDuration *big.Int, validatorScoreExponent *big.Int, validatorScoreAdjustmentSpeed *big.Int, _slashingMultiplierResetPeriod *big.Int, _commissionUpdateDelay *big.Int, _pledgeMultiplierInReward *big.Int, _downtimeGracePeriod *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.Initialize(&_Validators.TransactOpts, registryAddress, validatorRequirementValue, validatorRequirementDuration, validatorScoreExponent, validatorScoreAdjustmentSpeed, _slashingMultiplierResetPeriod, _commissionUpdateDelay, _pledgeMultiplierInReward, _downtimeGracePeriod)
+}
+
+// RegisterValidator is a paid mutator transaction binding the contract method 0xbf186703.
+//
+// Solidity: function registerValidator(uint256 commission, address lesser, address greater, bytes[] blsBlsG1BlsPopEcdsaPub) returns(bool)
+func (_Validators *ValidatorsTransactor) RegisterValidator(opts *bind.TransactOpts, commission *big.Int, lesser common.Address, greater common.Address, blsBlsG1BlsPopEcdsaPub [][]byte) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "registerValidator", commission, lesser, greater, blsBlsG1BlsPopEcdsaPub)
+}
+
+// RegisterValidator is a paid mutator transaction binding the contract method 0xbf186703.
+//
+// Solidity: function registerValidator(uint256 commission, address lesser, address greater, bytes[] blsBlsG1BlsPopEcdsaPub) returns(bool)
+func (_Validators *ValidatorsSession) RegisterValidator(commission *big.Int, lesser common.Address, greater common.Address, blsBlsG1BlsPopEcdsaPub [][]byte) (*types.Transaction, error) {
+	return _Validators.Contract.RegisterValidator(&_Validators.TransactOpts, commission, lesser, greater, blsBlsG1BlsPopEcdsaPub)
+}
+
+// RegisterValidator is a paid mutator transaction binding the contract method 0xbf186703.
+//
+// Solidity: function registerValidator(uint256 commission, address lesser, address greater, bytes[] blsBlsG1BlsPopEcdsaPub) returns(bool)
+func (_Validators *ValidatorsTransactorSession) RegisterValidator(commission *big.Int, lesser common.Address, greater common.Address, blsBlsG1BlsPopEcdsaPub [][]byte) (*types.Transaction, error) {
+	return _Validators.Contract.RegisterValidator(&_Validators.TransactOpts, commission, lesser, greater, blsBlsG1BlsPopEcdsaPub)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Validators *ValidatorsTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Validators *ValidatorsSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Validators.Contract.RenounceOwnership(&_Validators.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Validators *ValidatorsTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Validators.Contract.RenounceOwnership(&_Validators.TransactOpts)
+}
+
+// ResetSlashingMultiplier is a paid mutator transaction binding the contract method 0xb8f93943.
+//
+// Solidity: function resetSlashingMultiplier() returns()
+func (_Validators *ValidatorsTransactor) ResetSlashingMultiplier(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "resetSlashingMultiplier")
+}
+
+// ResetSlashingMultiplier is a paid mutator transaction binding the contract method 0xb8f93943.
+//
+// Solidity: function resetSlashingMultiplier() returns()
+func (_Validators *ValidatorsSession) ResetSlashingMultiplier() (*types.Transaction, error) {
+	return _Validators.Contract.ResetSlashingMultiplier(&_Validators.TransactOpts)
+}
+
+// ResetSlashingMultiplier is a paid mutator transaction binding the contract method 0xb8f93943.
+//
+// Solidity: function resetSlashingMultiplier() returns()
+func (_Validators *ValidatorsTransactorSession) ResetSlashingMultiplier() (*types.Transaction, error) {
+	return _Validators.Contract.ResetSlashingMultiplier(&_Validators.TransactOpts)
+}
+
+// RevertRegisterValidator is a paid mutator transaction binding the contract method 0x8f0176aa.
+//
+// Solidity: function revertRegisterValidator() returns(bool)
+func (_Validators *ValidatorsTransactor) RevertRegisterValidator(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "revertRegisterValidator")
+}
+
+// RevertRegisterValidator is a paid mutator transaction binding the contract method 0x8f0176aa.
+//
+// Solidity: function revertRegisterValidator() returns(bool)
+func (_Validators *ValidatorsSession) RevertRegisterValidator() (*types.Transaction, error) {
+	return _Validators.Contract.RevertRegisterValidator(&_Validators.TransactOpts)
+}
+
+// RevertRegisterValidator is a paid mutator transaction binding the contract method 0x8f0176aa.
+//
+// Solidity: function revertRegisterValidator() returns(bool)
+func (_Validators *ValidatorsTransactorSession) RevertRegisterValidator() (*types.Transaction, error) {
+	return _Validators.Contract.RevertRegisterValidator(&_Validators.TransactOpts)
+}
+
+// SetCommissionUpdateDelay is a paid mutator transaction binding the contract method 0x6c620d90.
+//
+// Solidity: function setCommissionUpdateDelay(uint256 delay) returns()
+func (_Validators *ValidatorsTransactor) SetCommissionUpdateDelay(opts *bind.TransactOpts, delay *big.Int) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "setCommissionUpdateDelay", delay)
+}
+
+// SetCommissionUpdateDelay is a paid mutator transaction binding the contract method 0x6c620d90.
+//
+// Solidity: function setCommissionUpdateDelay(uint256 delay) returns()
+func (_Validators *ValidatorsSession) SetCommissionUpdateDelay(delay *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.SetCommissionUpdateDelay(&_Validators.TransactOpts, delay)
+}
+
+// SetCommissionUpdateDelay is a paid mutator transaction binding the contract method 0x6c620d90.
+//
+// Solidity: function setCommissionUpdateDelay(uint256 delay) returns()
+func (_Validators *ValidatorsTransactorSession) SetCommissionUpdateDelay(delay *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.SetCommissionUpdateDelay(&_Validators.TransactOpts, delay)
+}
+
+// SetDowntimeGracePeriod is a paid mutator transaction binding the contract method 0xa57bff90.
+//
+// Solidity: function setDowntimeGracePeriod(uint256 value) returns()
+func (_Validators *ValidatorsTransactor) SetDowntimeGracePeriod(opts *bind.TransactOpts, value *big.Int) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "setDowntimeGracePeriod", value)
+}
+
+// SetDowntimeGracePeriod is a paid mutator transaction binding the contract method 0xa57bff90.
+//
+// Solidity: function setDowntimeGracePeriod(uint256 value) returns()
+func (_Validators *ValidatorsSession) SetDowntimeGracePeriod(value *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.SetDowntimeGracePeriod(&_Validators.TransactOpts, value)
+}
+
+// SetDowntimeGracePeriod is a paid mutator transaction binding the contract method 0xa57bff90.
+//
+// Solidity: function setDowntimeGracePeriod(uint256 value) returns()
+func (_Validators *ValidatorsTransactorSession) SetDowntimeGracePeriod(value *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.SetDowntimeGracePeriod(&_Validators.TransactOpts, value)
+}
+
+// SetNextCommissionUpdate is a paid mutator transaction binding the contract method 0x86d81a5a.
+//
+// Solidity: function setNextCommissionUpdate(uint256 commission) returns()
+func (_Validators *ValidatorsTransactor) SetNextCommissionUpdate(opts *bind.TransactOpts, commission *big.Int) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "setNextCommissionUpdate", commission)
+}
+
+// SetNextCommissionUpdate is a paid mutator transaction binding the contract method 0x86d81a5a.
+//
+// Solidity: function setNextCommissionUpdate(uint256 commission) returns()
+func (_Validators *ValidatorsSession) SetNextCommissionUpdate(commission *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.SetNextCommissionUpdate(&_Validators.TransactOpts, commission)
+}
+
+// SetNextCommissionUpdate is a paid mutator transaction binding the contract method 0x86d81a5a.
+//
+// Solidity: function setNextCommissionUpdate(uint256 commission) returns()
+func (_Validators *ValidatorsTransactorSession) SetNextCommissionUpdate(commission *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.SetNextCommissionUpdate(&_Validators.TransactOpts, commission)
+}
+
+// SetPledgeMultiplierInReward is a paid mutator transaction binding the contract method 0xdc3fa4cf.
+//
+// Solidity: function setPledgeMultiplierInReward(uint256 pledgeMultiplier) returns()
+func (_Validators *ValidatorsTransactor) SetPledgeMultiplierInReward(opts *bind.TransactOpts, pledgeMultiplier *big.Int) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "setPledgeMultiplierInReward", pledgeMultiplier)
+}
+
+// SetPledgeMultiplierInReward is a paid mutator transaction binding the contract method 0xdc3fa4cf.
+//
+// Solidity: function setPledgeMultiplierInReward(uint256 pledgeMultiplier) returns()
+func (_Validators *ValidatorsSession) SetPledgeMultiplierInReward(pledgeMultiplier *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.SetPledgeMultiplierInReward(&_Validators.TransactOpts, pledgeMultiplier)
+}
+
+// SetPledgeMultiplierInReward is a paid mutator transaction binding the contract method 0xdc3fa4cf.
+//
+// Solidity: function setPledgeMultiplierInReward(uint256 pledgeMultiplier) returns()
+func (_Validators *ValidatorsTransactorSession) SetPledgeMultiplierInReward(pledgeMultiplier *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.SetPledgeMultiplierInReward(&_Validators.TransactOpts, pledgeMultiplier)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address registryAddress) returns()
+func (_Validators *ValidatorsTransactor) SetRegistry(opts *bind.TransactOpts, registryAddress common.Address) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "setRegistry", registryAddress)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address registryAddress) returns()
+func (_Validators *ValidatorsSession) SetRegistry(registryAddress common.Address) (*types.Transaction, error) {
+	return _Validators.Contract.SetRegistry(&_Validators.TransactOpts, registryAddress)
+}
+
+// SetRegistry is a paid mutator transaction binding the contract method 0xa91ee0dc.
+//
+// Solidity: function setRegistry(address registryAddress) returns()
+func (_Validators *ValidatorsTransactorSession) SetRegistry(registryAddress common.Address) (*types.Transaction, error) {
+	return _Validators.Contract.SetRegistry(&_Validators.TransactOpts, registryAddress)
+}
+
+// SetSlashingMultiplierResetPeriod is a paid mutator transaction binding the contract method 0x6ab951a0.
+//
+// Solidity: function setSlashingMultiplierResetPeriod(uint256 value) returns()
+func (_Validators *ValidatorsTransactor) SetSlashingMultiplierResetPeriod(opts *bind.TransactOpts, value *big.Int) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "setSlashingMultiplierResetPeriod", value)
+}
+
+// SetSlashingMultiplierResetPeriod is a paid mutator transaction binding the contract method 0x6ab951a0.
+//
+// Solidity: function setSlashingMultiplierResetPeriod(uint256 value) returns()
+func (_Validators *ValidatorsSession) SetSlashingMultiplierResetPeriod(value *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.SetSlashingMultiplierResetPeriod(&_Validators.TransactOpts, value)
+}
+
+// SetSlashingMultiplierResetPeriod is a paid mutator transaction binding the contract method 0x6ab951a0.
+//
+// Solidity: function setSlashingMultiplierResetPeriod(uint256 value) returns()
+func (_Validators *ValidatorsTransactorSession) SetSlashingMultiplierResetPeriod(value *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.SetSlashingMultiplierResetPeriod(&_Validators.TransactOpts, value)
+}
+
+// SetValidatorLockedGoldRequirements is a paid mutator transaction binding the contract method 0x76c0a9ed.
+//
+// Solidity: function setValidatorLockedGoldRequirements(uint256 value, uint256 duration) returns(bool)
+func (_Validators *ValidatorsTransactor) SetValidatorLockedGoldRequirements(opts *bind.TransactOpts, value *big.Int, duration *big.Int) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "setValidatorLockedGoldRequirements", value, duration)
+}
+
+// SetValidatorLockedGoldRequirements is a paid mutator transaction binding the contract method 0x76c0a9ed.
+//
+// Solidity: function setValidatorLockedGoldRequirements(uint256 value, uint256 duration) returns(bool)
+func (_Validators *ValidatorsSession) SetValidatorLockedGoldRequirements(value *big.Int, duration *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.SetValidatorLockedGoldRequirements(&_Validators.TransactOpts, value, duration)
+}
+
+// SetValidatorLockedGoldRequirements is a paid mutator transaction binding the contract method 0x76c0a9ed.
+//
+// Solidity: function setValidatorLockedGoldRequirements(uint256 value, uint256 duration) returns(bool)
+func (_Validators *ValidatorsTransactorSession) SetValidatorLockedGoldRequirements(value *big.Int, duration *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.SetValidatorLockedGoldRequirements(&_Validators.TransactOpts, value, duration)
+}
+
+// SetValidatorScoreParameters is a paid mutator transaction binding the contract method 0xcb8f98e0.
+//
+// Solidity: function setValidatorScoreParameters(uint256 exponent, uint256 adjustmentSpeed) returns(bool)
+func (_Validators *ValidatorsTransactor) SetValidatorScoreParameters(opts *bind.TransactOpts, exponent *big.Int, adjustmentSpeed *big.Int) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "setValidatorScoreParameters", exponent, adjustmentSpeed)
+}
+
+// SetValidatorScoreParameters is a paid mutator transaction binding the contract method 0xcb8f98e0.
+//
+// Solidity: function setValidatorScoreParameters(uint256 exponent, uint256 adjustmentSpeed) returns(bool)
+func (_Validators *ValidatorsSession) SetValidatorScoreParameters(exponent *big.Int, adjustmentSpeed *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.SetValidatorScoreParameters(&_Validators.TransactOpts, exponent, adjustmentSpeed)
+}
+
+// SetValidatorScoreParameters is a paid mutator transaction binding the contract method 0xcb8f98e0.
+//
+// Solidity: function setValidatorScoreParameters(uint256 exponent, uint256 adjustmentSpeed) returns(bool)
+func (_Validators *ValidatorsTransactorSession) SetValidatorScoreParameters(exponent *big.Int, adjustmentSpeed *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.SetValidatorScoreParameters(&_Validators.TransactOpts, exponent, adjustmentSpeed)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Validators *ValidatorsTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Validators *ValidatorsSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Validators.Contract.TransferOwnership(&_Validators.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Validators *ValidatorsTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Validators.Contract.TransferOwnership(&_Validators.TransactOpts, newOwner)
+}
+
+// UpdateBlsPublicKey is a paid mutator transaction binding the contract method 0xebe82396.
+//
+// Solidity: function updateBlsPublicKey(bytes blsPublicKey, bytes blsG1PublicKey, bytes blsPop) returns(bool)
+func (_Validators *ValidatorsTransactor) UpdateBlsPublicKey(opts *bind.TransactOpts, blsPublicKey []byte, blsG1PublicKey []byte, blsPop []byte) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "updateBlsPublicKey", blsPublicKey, blsG1PublicKey, blsPop)
+}
+
+// UpdateBlsPublicKey is a paid mutator transaction binding the contract method 0xebe82396.
+//
+// Solidity: function updateBlsPublicKey(bytes blsPublicKey, bytes blsG1PublicKey, bytes blsPop) returns(bool)
+func (_Validators *ValidatorsSession) UpdateBlsPublicKey(blsPublicKey []byte, blsG1PublicKey []byte, blsPop []byte) (*types.Transaction, error) {
+	return _Validators.Contract.UpdateBlsPublicKey(&_Validators.TransactOpts, blsPublicKey, blsG1PublicKey, blsPop)
+}
+
+// UpdateBlsPublicKey is a paid mutator transaction binding the contract method 0xebe82396.
+//
+// Solidity: function updateBlsPublicKey(bytes blsPublicKey, bytes blsG1PublicKey, bytes blsPop) returns(bool)
+func (_Validators *ValidatorsTransactorSession) UpdateBlsPublicKey(blsPublicKey []byte, blsG1PublicKey []byte, blsPop []byte) (*types.Transaction, error) {
+	return _Validators.Contract.UpdateBlsPublicKey(&_Validators.TransactOpts, blsPublicKey, blsG1PublicKey, blsPop)
+}
+
+// UpdateCommission is a paid mutator transaction binding the contract method 0xe7f03766.
+//
+// Solidity: function updateCommission() returns()
+func (_Validators *ValidatorsTransactor) UpdateCommission(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "updateCommission")
+}
+
+// UpdateCommission is a paid mutator transaction binding the contract method 0xe7f03766.
+//
+// Solidity: function updateCommission() returns()
+func (_Validators *ValidatorsSession) UpdateCommission() (*types.Transaction, error) {
+	return _Validators.Contract.UpdateCommission(&_Validators.TransactOpts)
+}
+
+// UpdateCommission is a paid mutator transaction binding the contract method 0xe7f03766.
+//
+// Solidity: function updateCommission() returns()
+func (_Validators *ValidatorsTransactorSession) UpdateCommission() (*types.Transaction, error) {
+	return _Validators.Contract.UpdateCommission(&_Validators.TransactOpts)
+}
+
+// UpdateEcdsaPublicKey is a paid mutator transaction binding the contract method 0x4e06fd8a.
+//
+// Solidity: function updateEcdsaPublicKey(address account, address signer, bytes ecdsaPublicKey) returns(bool)
+func (_Validators *ValidatorsTransactor) UpdateEcdsaPublicKey(opts *bind.TransactOpts, account common.Address, signer common.Address, ecdsaPublicKey []byte) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "updateEcdsaPublicKey", account, signer, ecdsaPublicKey)
+}
+
+// UpdateEcdsaPublicKey is a paid mutator transaction binding the contract method 0x4e06fd8a.
+//
+// Solidity: function updateEcdsaPublicKey(address account, address signer, bytes ecdsaPublicKey) returns(bool)
+func (_Validators *ValidatorsSession) UpdateEcdsaPublicKey(account common.Address, signer common.Address, ecdsaPublicKey []byte) (*types.Transaction, error) {
+	return _Validators.Contract.UpdateEcdsaPublicKey(&_Validators.TransactOpts, account, signer, ecdsaPublicKey)
+}
+
+// UpdateEcdsaPublicKey is a paid mutator transaction binding the contract method 0x4e06fd8a.
+//
+// Solidity: function updateEcdsaPublicKey(address account, address signer, bytes ecdsaPublicKey) returns(bool)
+func (_Validators *ValidatorsTransactorSession) UpdateEcdsaPublicKey(account common.Address, signer common.Address, ecdsaPublicKey []byte) (*types.Transaction, error) {
+	return _Validators.Contract.UpdateEcdsaPublicKey(&_Validators.TransactOpts, account, signer, ecdsaPublicKey)
+}
+
+// UpdatePublicKeys is a paid mutator transaction binding the contract method 0xa108f0d9.
+//
+// Solidity: function updatePublicKeys(address account, address signer, bytes ecdsaPublicKey, bytes blsPublicKey, bytes blsG1PublicKey, bytes blsPop) returns(bool)
+func (_Validators *ValidatorsTransactor) UpdatePublicKeys(opts *bind.TransactOpts, account common.Address, signer common.Address, ecdsaPublicKey []byte, blsPublicKey []byte, blsG1PublicKey []byte, blsPop []byte) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "updatePublicKeys", account, signer, ecdsaPublicKey, blsPublicKey, blsG1PublicKey, blsPop)
+}
+
+// UpdatePublicKeys is a paid mutator transaction binding the contract method 0xa108f0d9.
+//
+// Solidity: function updatePublicKeys(address account, address signer, bytes ecdsaPublicKey, bytes blsPublicKey, bytes blsG1PublicKey, bytes blsPop) returns(bool)
+func (_Validators *ValidatorsSession) UpdatePublicKeys(account common.Address, signer common.Address, ecdsaPublicKey []byte, blsPublicKey []byte, blsG1PublicKey []byte, blsPop []byte) (*types.Transaction, error) {
+	return _Validators.Contract.UpdatePublicKeys(&_Validators.TransactOpts, account, signer, ecdsaPublicKey, blsPublicKey, blsG1PublicKey, blsPop)
+}
+
+// UpdatePublicKeys is a paid mutator transaction binding the contract method 0xa108f0d9.
+//
+// Solidity: function updatePublicKeys(address account, address signer, bytes ecdsaPublicKey, bytes blsPublicKey, bytes blsG1PublicKey, bytes blsPop) returns(bool)
+func (_Validators *ValidatorsTransactorSession) UpdatePublicKeys(account common.Address, signer common.Address, ecdsaPublicKey []byte, blsPublicKey []byte, blsG1PublicKey []byte, blsPop []byte) (*types.Transaction, error) {
+	return _Validators.Contract.UpdatePublicKeys(&_Validators.TransactOpts, account, signer, ecdsaPublicKey, blsPublicKey, blsG1PublicKey, blsPop)
+}
+
+// UpdateValidatorScoreFromSigner is a paid mutator transaction binding the contract method 0xc0c6ad6f.
+//
+// Solidity: function updateValidatorScoreFromSigner(address signer, uint256 uptime) returns(uint256, bool)
+func (_Validators *ValidatorsTransactor) UpdateValidatorScoreFromSigner(opts *bind.TransactOpts, signer common.Address, uptime *big.Int) (*types.Transaction, error) {
+	return _Validators.contract.Transact(opts, "updateValidatorScoreFromSigner", signer, uptime)
+}
+
+// UpdateValidatorScoreFromSigner is a paid mutator transaction binding the contract method 0xc0c6ad6f.
+//
+// Solidity: function updateValidatorScoreFromSigner(address signer, uint256 uptime) returns(uint256, bool)
+func (_Validators *ValidatorsSession) UpdateValidatorScoreFromSigner(signer common.Address, uptime *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.UpdateValidatorScoreFromSigner(&_Validators.TransactOpts, signer, uptime)
+}
+
+// UpdateValidatorScoreFromSigner is a paid mutator transaction binding the contract method 0xc0c6ad6f.
+//
+// Solidity: function updateValidatorScoreFromSigner(address signer, uint256 uptime) returns(uint256, bool)
+func (_Validators *ValidatorsTransactorSession) UpdateValidatorScoreFromSigner(signer common.Address, uptime *big.Int) (*types.Transaction, error) {
+	return _Validators.Contract.UpdateValidatorScoreFromSigner(&_Validators.TransactOpts, signer, uptime)
+}
+
+// ValidatorsCommissionUpdateDelaySetIterator is returned from FilterCommissionUpdateDelaySet and is used to iterate over the raw logs and unpacked data for CommissionUpdateDelaySet events raised by the Validators contract.
+type ValidatorsCommissionUpdateDelaySetIterator struct {
+	Event *ValidatorsCommissionUpdateDelaySet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsCommissionUpdateDelaySetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsCommissionUpdateDelaySet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsCommissionUpdateDelaySet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsCommissionUpdateDelaySetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsCommissionUpdateDelaySetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsCommissionUpdateDelaySet represents a CommissionUpdateDelaySet event raised by the Validators contract.
+type ValidatorsCommissionUpdateDelaySet struct {
+	Delay *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterCommissionUpdateDelaySet is a free log retrieval operation binding the contract event 0xf2da07d08fd8dc9c5dcf87ad6f540e306f884a47dd8de14b718a4d5395f1ca9b.
+//
+// Solidity: event CommissionUpdateDelaySet(uint256 delay)
+func (_Validators *ValidatorsFilterer) FilterCommissionUpdateDelaySet(opts *bind.FilterOpts) (*ValidatorsCommissionUpdateDelaySetIterator, error) {
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "CommissionUpdateDelaySet")
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsCommissionUpdateDelaySetIterator{contract: _Validators.contract, event: "CommissionUpdateDelaySet", logs: logs, sub: sub}, nil
+}
+
+// WatchCommissionUpdateDelaySet is a free log subscription operation binding the contract event 0xf2da07d08fd8dc9c5dcf87ad6f540e306f884a47dd8de14b718a4d5395f1ca9b.
+//
+// Solidity: event CommissionUpdateDelaySet(uint256 delay)
+func (_Validators *ValidatorsFilterer) WatchCommissionUpdateDelaySet(opts *bind.WatchOpts, sink chan<- *ValidatorsCommissionUpdateDelaySet) (event.Subscription, error) {
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "CommissionUpdateDelaySet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsCommissionUpdateDelaySet)
+				if err := _Validators.contract.UnpackLog(event, "CommissionUpdateDelaySet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCommissionUpdateDelaySet is a log parse operation binding the contract event 0xf2da07d08fd8dc9c5dcf87ad6f540e306f884a47dd8de14b718a4d5395f1ca9b.
+//
+// Solidity: event CommissionUpdateDelaySet(uint256 delay)
+func (_Validators *ValidatorsFilterer) ParseCommissionUpdateDelaySet(log types.Log) (*ValidatorsCommissionUpdateDelaySet, error) {
+	event := new(ValidatorsCommissionUpdateDelaySet)
+	if err := _Validators.contract.UnpackLog(event, "CommissionUpdateDelaySet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorsOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Validators contract.
+type ValidatorsOwnershipTransferredIterator struct {
+	Event *ValidatorsOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsOwnershipTransferred represents a OwnershipTransferred event raised by the Validators contract.
+type ValidatorsOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Validators *ValidatorsFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ValidatorsOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsOwnershipTransferredIterator{contract: _Validators.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Validators *ValidatorsFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ValidatorsOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsOwnershipTransferred)
+				if err := _Validators.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Validators *ValidatorsFilterer) ParseOwnershipTransferred(log types.Log) (*ValidatorsOwnershipTransferred, error) {
+	event := new(ValidatorsOwnershipTransferred)
+	if err := _Validators.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorsPledgeMultiplierInRewardSetIterator is returned from FilterPledgeMultiplierInRewardSet and is used to iterate over the raw logs and unpacked data for PledgeMultiplierInRewardSet events raised by the Validators contract.
+type ValidatorsPledgeMultiplierInRewardSetIterator struct {
+	Event *ValidatorsPledgeMultiplierInRewardSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsPledgeMultiplierInRewardSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsPledgeMultiplierInRewardSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsPledgeMultiplierInRewardSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsPledgeMultiplierInRewardSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsPledgeMultiplierInRewardSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsPledgeMultiplierInRewardSet represents a PledgeMultiplierInRewardSet event raised by the Validators contract.
+type ValidatorsPledgeMultiplierInRewardSet struct {
+	Delay *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterPledgeMultiplierInRewardSet is a free log retrieval operation binding the contract event 0x10342a509a8a18c0d74df1c5f32814c910c027dbd86b1e7d4bf340b28960a489.
+//
+// Solidity: event PledgeMultiplierInRewardSet(uint256 delay)
+func (_Validators *ValidatorsFilterer) FilterPledgeMultiplierInRewardSet(opts *bind.FilterOpts) (*ValidatorsPledgeMultiplierInRewardSetIterator, error) {
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "PledgeMultiplierInRewardSet")
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsPledgeMultiplierInRewardSetIterator{contract: _Validators.contract, event: "PledgeMultiplierInRewardSet", logs: logs, sub: sub}, nil
+}
+
+// WatchPledgeMultiplierInRewardSet is a free log subscription operation binding the contract event 0x10342a509a8a18c0d74df1c5f32814c910c027dbd86b1e7d4bf340b28960a489.
+//
+// Solidity: event PledgeMultiplierInRewardSet(uint256 delay)
+func (_Validators *ValidatorsFilterer) WatchPledgeMultiplierInRewardSet(opts *bind.WatchOpts, sink chan<- *ValidatorsPledgeMultiplierInRewardSet) (event.Subscription, error) {
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "PledgeMultiplierInRewardSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsPledgeMultiplierInRewardSet)
+				if err := _Validators.contract.UnpackLog(event, "PledgeMultiplierInRewardSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePledgeMultiplierInRewardSet is a log parse operation binding the contract event 0x10342a509a8a18c0d74df1c5f32814c910c027dbd86b1e7d4bf340b28960a489.
+//
+// Solidity: event PledgeMultiplierInRewardSet(uint256 delay)
+func (_Validators *ValidatorsFilterer) ParsePledgeMultiplierInRewardSet(log types.Log) (*ValidatorsPledgeMultiplierInRewardSet, error) {
+	event := new(ValidatorsPledgeMultiplierInRewardSet)
+	if err := _Validators.contract.UnpackLog(event, "PledgeMultiplierInRewardSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorsRegistrySetIterator is returned from FilterRegistrySet and is used to iterate over the raw logs and unpacked data for RegistrySet events raised by the Validators contract.
+type ValidatorsRegistrySetIterator struct {
+	Event *ValidatorsRegistrySet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsRegistrySetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsRegistrySet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsRegistrySet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsRegistrySetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsRegistrySetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsRegistrySet represents a RegistrySet event raised by the Validators contract.
+type ValidatorsRegistrySet struct {
+	RegistryAddress common.Address
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterRegistrySet is a free log retrieval operation binding the contract event 0x27fe5f0c1c3b1ed427cc63d0f05759ffdecf9aec9e18d31ef366fc8a6cb5dc3b.
+//
+// Solidity: event RegistrySet(address indexed registryAddress)
+func (_Validators *ValidatorsFilterer) FilterRegistrySet(opts *bind.FilterOpts, registryAddress []common.Address) (*ValidatorsRegistrySetIterator, error) {
+
+	var registryAddressRule []interface{}
+	for _, registryAddressItem := range registryAddress {
+		registryAddressRule = append(registryAddressRule, registryAddressItem)
+	}
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "RegistrySet", registryAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsRegistrySetIterator{contract: _Validators.contract, event: "RegistrySet", logs: logs, sub: sub}, nil
+}
+
+// WatchRegistrySet is a free log subscription operation binding the contract event 0x27fe5f0c1c3b1ed427cc63d0f05759ffdecf9aec9e18d31ef366fc8a6cb5dc3b.
+//
+// Solidity: event RegistrySet(address indexed registryAddress)
+func (_Validators *ValidatorsFilterer) WatchRegistrySet(opts *bind.WatchOpts, sink chan<- *ValidatorsRegistrySet, registryAddress []common.Address) (event.Subscription, error) {
+
+	var registryAddressRule []interface{}
+	for _, registryAddressItem := range registryAddress {
+		registryAddressRule = append(registryAddressRule, registryAddressItem)
+	}
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "RegistrySet", registryAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsRegistrySet)
+				if err := _Validators.contract.UnpackLog(event, "RegistrySet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRegistrySet is a log parse operation binding the contract event 0x27fe5f0c1c3b1ed427cc63d0f05759ffdecf9aec9e18d31ef366fc8a6cb5dc3b.
+//
+// Solidity: event RegistrySet(address indexed registryAddress)
+func (_Validators *ValidatorsFilterer) ParseRegistrySet(log types.Log) (*ValidatorsRegistrySet, error) {
+	event := new(ValidatorsRegistrySet)
+	if err := _Validators.contract.UnpackLog(event, "RegistrySet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorsValidatorBlsPublicKeyUpdatedIterator is returned from FilterValidatorBlsPublicKeyUpdated and is used to iterate over the raw logs and unpacked data for ValidatorBlsPublicKeyUpdated events raised by the Validators contract.
+type ValidatorsValidatorBlsPublicKeyUpdatedIterator struct {
+	Event *ValidatorsValidatorBlsPublicKeyUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsValidatorBlsPublicKeyUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsValidatorBlsPublicKeyUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsValidatorBlsPublicKeyUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsValidatorBlsPublicKeyUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsValidatorBlsPublicKeyUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsValidatorBlsPublicKeyUpdated represents a ValidatorBlsPublicKeyUpdated event raised by the Validators contract.
+type ValidatorsValidatorBlsPublicKeyUpdated struct {
+	Validator    common.Address
+	BlsPublicKey []byte
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorBlsPublicKeyUpdated is a free log retrieval operation binding the contract event 0x36a1aabe506bbe8802233cbb9aad628e91269e77077c953f9db3e02d7092ee33.
+//
+// Solidity: event ValidatorBlsPublicKeyUpdated(address indexed validator, bytes blsPublicKey)
+func (_Validators *ValidatorsFilterer) FilterValidatorBlsPublicKeyUpdated(opts *bind.FilterOpts, validator []common.Address) (*ValidatorsValidatorBlsPublicKeyUpdatedIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "ValidatorBlsPublicKeyUpdated", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsValidatorBlsPublicKeyUpdatedIterator{contract: _Validators.contract, event: "ValidatorBlsPublicKeyUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorBlsPublicKeyUpdated is a free log subscription operation binding the contract event 0x36a1aabe506bbe8802233cbb9aad628e91269e77077c953f9db3e02d7092ee33.
+//
+// Solidity: event ValidatorBlsPublicKeyUpdated(address indexed validator, bytes blsPublicKey)
+func (_Validators *ValidatorsFilterer) WatchValidatorBlsPublicKeyUpdated(opts *bind.WatchOpts, sink chan<- *ValidatorsValidatorBlsPublicKeyUpdated, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "ValidatorBlsPublicKeyUpdated", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsValidatorBlsPublicKeyUpdated)
+				if err := _Validators.contract.UnpackLog(event, "ValidatorBlsPublicKeyUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorBlsPublicKeyUpdated is a log parse operation binding the contract event 0x36a1aabe506bbe8802233cbb9aad628e91269e77077c953f9db3e02d7092ee33.
+//
+// Solidity: event ValidatorBlsPublicKeyUpdated(address indexed validator, bytes blsPublicKey)
+func (_Validators *ValidatorsFilterer) ParseValidatorBlsPublicKeyUpdated(log types.Log) (*ValidatorsValidatorBlsPublicKeyUpdated, error) {
+	event := new(ValidatorsValidatorBlsPublicKeyUpdated)
+	if err := _Validators.contract.UnpackLog(event, "ValidatorBlsPublicKeyUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorsValidatorCommissionUpdateQueuedIterator is returned from FilterValidatorCommissionUpdateQueued and is used to iterate over the raw logs and unpacked data for ValidatorCommissionUpdateQueued events raised by the Validators contract.
+type ValidatorsValidatorCommissionUpdateQueuedIterator struct {
+	Event *ValidatorsValidatorCommissionUpdateQueued // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsValidatorCommissionUpdateQueuedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsValidatorCommissionUpdateQueued)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsValidatorCommissionUpdateQueued)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsValidatorCommissionUpdateQueuedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsValidatorCommissionUpdateQueuedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsValidatorCommissionUpdateQueued represents a ValidatorCommissionUpdateQueued event raised by the Validators contract.
+type ValidatorsValidatorCommissionUpdateQueued struct {
+	Validator       common.Address
+	Commission      *big.Int
+	ActivationBlock *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorCommissionUpdateQueued is a free log retrieval operation binding the contract event 0xfa1076396746dc82c1151e6947e5ad419ea81bb606c02d0a1c9b33cc76eba7e6.
+//
+// Solidity: event ValidatorCommissionUpdateQueued(address indexed validator, uint256 commission, uint256 activationBlock)
+func (_Validators *ValidatorsFilterer) FilterValidatorCommissionUpdateQueued(opts *bind.FilterOpts, validator []common.Address) (*ValidatorsValidatorCommissionUpdateQueuedIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "ValidatorCommissionUpdateQueued", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsValidatorCommissionUpdateQueuedIterator{contract: _Validators.contract, event: "ValidatorCommissionUpdateQueued", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorCommissionUpdateQueued is a free log subscription operation binding the contract event 0xfa1076396746dc82c1151e6947e5ad419ea81bb606c02d0a1c9b33cc76eba7e6.
+//
+// Solidity: event ValidatorCommissionUpdateQueued(address indexed validator, uint256 commission, uint256 activationBlock)
+func (_Validators *ValidatorsFilterer) WatchValidatorCommissionUpdateQueued(opts *bind.WatchOpts, sink chan<- *ValidatorsValidatorCommissionUpdateQueued, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "ValidatorCommissionUpdateQueued", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsValidatorCommissionUpdateQueued)
+				if err := _Validators.contract.UnpackLog(event, "ValidatorCommissionUpdateQueued", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorCommissionUpdateQueued is a log parse operation binding the contract event 0xfa1076396746dc82c1151e6947e5ad419ea81bb606c02d0a1c9b33cc76eba7e6.
+//
+// Solidity: event ValidatorCommissionUpdateQueued(address indexed validator, uint256 commission, uint256 activationBlock)
+func (_Validators *ValidatorsFilterer) ParseValidatorCommissionUpdateQueued(log types.Log) (*ValidatorsValidatorCommissionUpdateQueued, error) {
+	event := new(ValidatorsValidatorCommissionUpdateQueued)
+	if err := _Validators.contract.UnpackLog(event, "ValidatorCommissionUpdateQueued", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorsValidatorCommissionUpdatedIterator is returned from FilterValidatorCommissionUpdated and is used to iterate over the raw logs and unpacked data for ValidatorCommissionUpdated events raised by the Validators contract.
+type ValidatorsValidatorCommissionUpdatedIterator struct {
+	Event *ValidatorsValidatorCommissionUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsValidatorCommissionUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsValidatorCommissionUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsValidatorCommissionUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsValidatorCommissionUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsValidatorCommissionUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsValidatorCommissionUpdated represents a ValidatorCommissionUpdated event raised by the Validators contract.
+type ValidatorsValidatorCommissionUpdated struct {
+	Validator  common.Address
+	Commission *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorCommissionUpdated is a free log retrieval operation binding the contract event 0x81e2b5e21bcc7efd7e14c188371875cd03ca9090493eb276ad267661aad9381a.
+//
+// Solidity: event ValidatorCommissionUpdated(address indexed validator, uint256 commission)
+func (_Validators *ValidatorsFilterer) FilterValidatorCommissionUpdated(opts *bind.FilterOpts, validator []common.Address) (*ValidatorsValidatorCommissionUpdatedIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "ValidatorCommissionUpdated", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsValidatorCommissionUpdatedIterator{contract: _Validators.contract, event: "ValidatorCommissionUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorCommissionUpdated is a free log subscription operation binding the contract event 0x81e2b5e21bcc7efd7e14c188371875cd03ca9090493eb276ad267661aad9381a.
+//
+// Solidity: event ValidatorCommissionUpdated(address indexed validator, uint256 commission)
+func (_Validators *ValidatorsFilterer) WatchValidatorCommissionUpdated(opts *bind.WatchOpts, sink chan<- *ValidatorsValidatorCommissionUpdated, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "ValidatorCommissionUpdated", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsValidatorCommissionUpdated)
+				if err := _Validators.contract.UnpackLog(event, "ValidatorCommissionUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorCommissionUpdated is a log parse operation binding the contract event 0x81e2b5e21bcc7efd7e14c188371875cd03ca9090493eb276ad267661aad9381a.
+//
+// Solidity: event ValidatorCommissionUpdated(address indexed validator, uint256 commission)
+func (_Validators *ValidatorsFilterer) ParseValidatorCommissionUpdated(log types.Log) (*ValidatorsValidatorCommissionUpdated, error) {
+	event := new(ValidatorsValidatorCommissionUpdated)
+	if err := _Validators.contract.UnpackLog(event, "ValidatorCommissionUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorsValidatorDeregisteredIterator is returned from FilterValidatorDeregistered and is used to iterate over the raw logs and unpacked data for ValidatorDeregistered events raised by the Validators contract.
+type ValidatorsValidatorDeregisteredIterator struct {
+	Event *ValidatorsValidatorDeregistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsValidatorDeregisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsValidatorDeregistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsValidatorDeregistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsValidatorDeregisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsValidatorDeregisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsValidatorDeregistered represents a ValidatorDeregistered event raised by the Validators contract.
+type ValidatorsValidatorDeregistered struct {
+	Validator common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorDeregistered is a free log retrieval operation binding the contract event 0x51407fafe7ef9bec39c65a12a4885a274190991bf1e9057fcc384fc77ff1a7f0.
+//
+// Solidity: event ValidatorDeregistered(address indexed validator)
+func (_Validators *ValidatorsFilterer) FilterValidatorDeregistered(opts *bind.FilterOpts, validator []common.Address) (*ValidatorsValidatorDeregisteredIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "ValidatorDeregistered", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsValidatorDeregisteredIterator{contract: _Validators.contract, event: "ValidatorDeregistered", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorDeregistered is a free log subscription operation binding the contract event 0x51407fafe7ef9bec39c65a12a4885a274190991bf1e9057fcc384fc77ff1a7f0.
+//
+// Solidity: event ValidatorDeregistered(address indexed validator)
+func (_Validators *ValidatorsFilterer) WatchValidatorDeregistered(opts *bind.WatchOpts, sink chan<- *ValidatorsValidatorDeregistered, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "ValidatorDeregistered", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsValidatorDeregistered)
+				if err := _Validators.contract.UnpackLog(event, "ValidatorDeregistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorDeregistered is a log parse operation binding the contract event 0x51407fafe7ef9bec39c65a12a4885a274190991bf1e9057fcc384fc77ff1a7f0.
+//
+// Solidity: event ValidatorDeregistered(address indexed validator)
+func (_Validators *ValidatorsFilterer) ParseValidatorDeregistered(log types.Log) (*ValidatorsValidatorDeregistered, error) {
+	event := new(ValidatorsValidatorDeregistered)
+	if err := _Validators.contract.UnpackLog(event, "ValidatorDeregistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorsValidatorEcdsaPublicKeyUpdatedIterator is returned from FilterValidatorEcdsaPublicKeyUpdated and is used to iterate over the raw logs and unpacked data for ValidatorEcdsaPublicKeyUpdated events raised by the Validators contract.
+type ValidatorsValidatorEcdsaPublicKeyUpdatedIterator struct {
+	Event *ValidatorsValidatorEcdsaPublicKeyUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsValidatorEcdsaPublicKeyUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsValidatorEcdsaPublicKeyUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsValidatorEcdsaPublicKeyUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsValidatorEcdsaPublicKeyUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsValidatorEcdsaPublicKeyUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsValidatorEcdsaPublicKeyUpdated represents a ValidatorEcdsaPublicKeyUpdated event raised by the Validators contract.
+type ValidatorsValidatorEcdsaPublicKeyUpdated struct {
+	Validator      common.Address
+	EcdsaPublicKey []byte
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorEcdsaPublicKeyUpdated is a free log retrieval operation binding the contract event 0x213377eec2c15b21fa7abcbb0cb87a67e893cdb94a2564aa4bb4d380869473c8.
+//
+// Solidity: event ValidatorEcdsaPublicKeyUpdated(address indexed validator, bytes ecdsaPublicKey)
+func (_Validators *ValidatorsFilterer) FilterValidatorEcdsaPublicKeyUpdated(opts *bind.FilterOpts, validator []common.Address) (*ValidatorsValidatorEcdsaPublicKeyUpdatedIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "ValidatorEcdsaPublicKeyUpdated", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsValidatorEcdsaPublicKeyUpdatedIterator{contract: _Validators.contract, event: "ValidatorEcdsaPublicKeyUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorEcdsaPublicKeyUpdated is a free log subscription operation binding the contract event 0x213377eec2c15b21fa7abcbb0cb87a67e893cdb94a2564aa4bb4d380869473c8.
+//
+// Solidity: event ValidatorEcdsaPublicKeyUpdated(address indexed validator, bytes ecdsaPublicKey)
+func (_Validators *ValidatorsFilterer) WatchValidatorEcdsaPublicKeyUpdated(opts *bind.WatchOpts, sink chan<- *ValidatorsValidatorEcdsaPublicKeyUpdated, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "ValidatorEcdsaPublicKeyUpdated", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsValidatorEcdsaPublicKeyUpdated)
+				if err := _Validators.contract.UnpackLog(event, "ValidatorEcdsaPublicKeyUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorEcdsaPublicKeyUpdated is a log parse operation binding the contract event 0x213377eec2c15b21fa7abcbb0cb87a67e893cdb94a2564aa4bb4d380869473c8.
+//
+// Solidity: event ValidatorEcdsaPublicKeyUpdated(address indexed validator, bytes ecdsaPublicKey)
+func (_Validators *ValidatorsFilterer) ParseValidatorEcdsaPublicKeyUpdated(log types.Log) (*ValidatorsValidatorEcdsaPublicKeyUpdated, error) {
+	event := new(ValidatorsValidatorEcdsaPublicKeyUpdated)
+	if err := _Validators.contract.UnpackLog(event, "ValidatorEcdsaPublicKeyUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorsValidatorEpochPaymentDistributedIterator is returned from FilterValidatorEpochPaymentDistributed and is used to iterate over the raw logs and unpacked data for ValidatorEpochPaymentDistributed events raised by the Validators contract.
+type ValidatorsValidatorEpochPaymentDistributedIterator struct {
+	Event *ValidatorsValidatorEpochPaymentDistributed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsValidatorEpochPaymentDistributedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsValidatorEpochPaymentDistributed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsValidatorEpochPaymentDistributed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsValidatorEpochPaymentDistributedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsValidatorEpochPaymentDistributedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsValidatorEpochPaymentDistributed represents a ValidatorEpochPaymentDistributed event raised by the Validators contract.
+type ValidatorsValidatorEpochPaymentDistributed struct {
+	Validator        common.Address
+	ValidatorPayment *big.Int
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorEpochPaymentDistributed is a free log retrieval operation binding the contract event 0x78a2c235f809fd19c0fd05748230c581befffa6741471b0230d97bd4b7bcd346.
+//
+// Solidity: event ValidatorEpochPaymentDistributed(address indexed validator, uint256 validatorPayment)
+func (_Validators *ValidatorsFilterer) FilterValidatorEpochPaymentDistributed(opts *bind.FilterOpts, validator []common.Address) (*ValidatorsValidatorEpochPaymentDistributedIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "ValidatorEpochPaymentDistributed", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsValidatorEpochPaymentDistributedIterator{contract: _Validators.contract, event: "ValidatorEpochPaymentDistributed", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorEpochPaymentDistributed is a free log subscription operation binding the contract event 0x78a2c235f809fd19c0fd05748230c581befffa6741471b0230d97bd4b7bcd346.
+//
+// Solidity: event ValidatorEpochPaymentDistributed(address indexed validator, uint256 validatorPayment)
+func (_Validators *ValidatorsFilterer) WatchValidatorEpochPaymentDistributed(opts *bind.WatchOpts, sink chan<- *ValidatorsValidatorEpochPaymentDistributed, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "ValidatorEpochPaymentDistributed", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsValidatorEpochPaymentDistributed)
+				if err := _Validators.contract.UnpackLog(event, "ValidatorEpochPaymentDistributed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorEpochPaymentDistributed is a log parse operation binding the contract event 0x78a2c235f809fd19c0fd05748230c581befffa6741471b0230d97bd4b7bcd346.
+//
+// Solidity: event ValidatorEpochPaymentDistributed(address indexed validator, uint256 validatorPayment)
+func (_Validators *ValidatorsFilterer) ParseValidatorEpochPaymentDistributed(log types.Log) (*ValidatorsValidatorEpochPaymentDistributed, error) {
+	event := new(ValidatorsValidatorEpochPaymentDistributed)
+	if err := _Validators.contract.UnpackLog(event, "ValidatorEpochPaymentDistributed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorsValidatorLockedGoldRequirementsSetIterator is returned from FilterValidatorLockedGoldRequirementsSet and is used to iterate over the raw logs and unpacked data for ValidatorLockedGoldRequirementsSet events raised by the Validators contract.
+type ValidatorsValidatorLockedGoldRequirementsSetIterator struct {
+	Event *ValidatorsValidatorLockedGoldRequirementsSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsValidatorLockedGoldRequirementsSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsValidatorLockedGoldRequirementsSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsValidatorLockedGoldRequirementsSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsValidatorLockedGoldRequirementsSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsValidatorLockedGoldRequirementsSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsValidatorLockedGoldRequirementsSet represents a ValidatorLockedGoldRequirementsSet event raised by the Validators contract.
+type ValidatorsValidatorLockedGoldRequirementsSet struct {
+	Value    *big.Int
+	Duration *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorLockedGoldRequirementsSet is a free log retrieval operation binding the contract event 0x62d947118dd4c1f5ece7f787a9cad4e1127d14d403b71133e95792b473bf8389.
+//
+// Solidity: event ValidatorLockedGoldRequirementsSet(uint256 value, uint256 duration)
+func (_Validators *ValidatorsFilterer) FilterValidatorLockedGoldRequirementsSet(opts *bind.FilterOpts) (*ValidatorsValidatorLockedGoldRequirementsSetIterator, error) {
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "ValidatorLockedGoldRequirementsSet")
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsValidatorLockedGoldRequirementsSetIterator{contract: _Validators.contract, event: "ValidatorLockedGoldRequirementsSet", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorLockedGoldRequirementsSet is a free log subscription operation binding the contract event 0x62d947118dd4c1f5ece7f787a9cad4e1127d14d403b71133e95792b473bf8389.
+//
+// Solidity: event ValidatorLockedGoldRequirementsSet(uint256 value, uint256 duration)
+func (_Validators *ValidatorsFilterer) WatchValidatorLockedGoldRequirementsSet(opts *bind.WatchOpts, sink chan<- *ValidatorsValidatorLockedGoldRequirementsSet) (event.Subscription, error) {
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "ValidatorLockedGoldRequirementsSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsValidatorLockedGoldRequirementsSet)
+				if err := _Validators.contract.UnpackLog(event, "ValidatorLockedGoldRequirementsSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorLockedGoldRequirementsSet is a log parse operation binding the contract event 0x62d947118dd4c1f5ece7f787a9cad4e1127d14d403b71133e95792b473bf8389.
+//
+// Solidity: event ValidatorLockedGoldRequirementsSet(uint256 value, uint256 duration)
+func (_Validators *ValidatorsFilterer) ParseValidatorLockedGoldRequirementsSet(log types.Log) (*ValidatorsValidatorLockedGoldRequirementsSet, error) {
+	event := new(ValidatorsValidatorLockedGoldRequirementsSet)
+	if err := _Validators.contract.UnpackLog(event, "ValidatorLockedGoldRequirementsSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorsValidatorPreDeregisteredIterator is returned from FilterValidatorPreDeregistered and is used to iterate over the raw logs and unpacked data for ValidatorPreDeregistered events raised by the Validators contract.
+type ValidatorsValidatorPreDeregisteredIterator struct {
+	Event *ValidatorsValidatorPreDeregistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsValidatorPreDeregisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsValidatorPreDeregistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsValidatorPreDeregistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsValidatorPreDeregisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsValidatorPreDeregisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsValidatorPreDeregistered represents a ValidatorPreDeregistered event raised by the Validators contract.
+type ValidatorsValidatorPreDeregistered struct {
+	Validator common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorPreDeregistered is a free log retrieval operation binding the contract event 0x1b16b68bf79c35fd86f53c9e460e9f76d726aded03323eaea46f522ccb446d27.
+//
+// Solidity: event ValidatorPreDeregistered(address indexed validator)
+func (_Validators *ValidatorsFilterer) FilterValidatorPreDeregistered(opts *bind.FilterOpts, validator []common.Address) (*ValidatorsValidatorPreDeregisteredIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "ValidatorPreDeregistered", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsValidatorPreDeregisteredIterator{contract: _Validators.contract, event: "ValidatorPreDeregistered", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorPreDeregistered is a free log subscription operation binding the contract event 0x1b16b68bf79c35fd86f53c9e460e9f76d726aded03323eaea46f522ccb446d27.
+//
+// Solidity: event ValidatorPreDeregistered(address indexed validator)
+func (_Validators *ValidatorsFilterer) WatchValidatorPreDeregistered(opts *bind.WatchOpts, sink chan<- *ValidatorsValidatorPreDeregistered, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "ValidatorPreDeregistered", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsValidatorPreDeregistered)
+				if err := _Validators.contract.UnpackLog(event, "ValidatorPreDeregistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorPreDeregistered is a log parse operation binding the contract event 0x1b16b68bf79c35fd86f53c9e460e9f76d726aded03323eaea46f522ccb446d27.
+//
+// Solidity: event ValidatorPreDeregistered(address indexed validator)
+func (_Validators *ValidatorsFilterer) ParseValidatorPreDeregistered(log types.Log) (*ValidatorsValidatorPreDeregistered, error) {
+	event := new(ValidatorsValidatorPreDeregistered)
+	if err := _Validators.contract.UnpackLog(event, "ValidatorPreDeregistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorsValidatorRegisteredIterator is returned from FilterValidatorRegistered and is used to iterate over the raw logs and unpacked data for ValidatorRegistered events raised by the Validators contract.
+type ValidatorsValidatorRegisteredIterator struct {
+	Event *ValidatorsValidatorRegistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsValidatorRegisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsValidatorRegistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsValidatorRegistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsValidatorRegisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsValidatorRegisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsValidatorRegistered represents a ValidatorRegistered event raised by the Validators contract.
+type ValidatorsValidatorRegistered struct {
+	Validator  common.Address
+	Commission *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorRegistered is a free log retrieval operation binding the contract event 0xb4a7f5c563a0e35593d156394ed681bdc9c39467d7d722749d23862c2e4b712c.
+//
+// Solidity: event ValidatorRegistered(address indexed validator, uint256 indexed commission)
+func (_Validators *ValidatorsFilterer) FilterValidatorRegistered(opts *bind.FilterOpts, validator []common.Address, commission []*big.Int) (*ValidatorsValidatorRegisteredIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var commissionRule []interface{}
+	for _, commissionItem := range commission {
+		commissionRule = append(commissionRule, commissionItem)
+	}
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "ValidatorRegistered", validatorRule, commissionRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsValidatorRegisteredIterator{contract: _Validators.contract, event: "ValidatorRegistered", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorRegistered is a free log subscription operation binding the contract event 0xb4a7f5c563a0e35593d156394ed681bdc9c39467d7d722749d23862c2e4b712c.
+//
+// Solidity: event ValidatorRegistered(address indexed validator, uint256 indexed commission)
+func (_Validators *ValidatorsFilterer) WatchValidatorRegistered(opts *bind.WatchOpts, sink chan<- *ValidatorsValidatorRegistered, validator []common.Address, commission []*big.Int) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+	var commissionRule []interface{}
+	for _, commissionItem := range commission {
+		commissionRule = append(commissionRule, commissionItem)
+	}
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "ValidatorRegistered", validatorRule, commissionRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsValidatorRegistered)
+				if err := _Validators.contract.UnpackLog(event, "ValidatorRegistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorRegistered is a log parse operation binding the contract event 0xb4a7f5c563a0e35593d156394ed681bdc9c39467d7d722749d23862c2e4b712c.
+//
+// Solidity: event ValidatorRegistered(address indexed validator, uint256 indexed commission)
+func (_Validators *ValidatorsFilterer) ParseValidatorRegistered(log types.Log) (*ValidatorsValidatorRegistered, error) {
+	event := new(ValidatorsValidatorRegistered)
+	if err := _Validators.contract.UnpackLog(event, "ValidatorRegistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorsValidatorScoreParametersSetIterator is returned from FilterValidatorScoreParametersSet and is used to iterate over the raw logs and unpacked data for ValidatorScoreParametersSet events raised by the Validators contract.
+type ValidatorsValidatorScoreParametersSetIterator struct {
+	Event *ValidatorsValidatorScoreParametersSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsValidatorScoreParametersSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsValidatorScoreParametersSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsValidatorScoreParametersSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsValidatorScoreParametersSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsValidatorScoreParametersSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsValidatorScoreParametersSet represents a ValidatorScoreParametersSet event raised by the Validators contract.
+type ValidatorsValidatorScoreParametersSet struct {
+	Exponent        *big.Int
+	AdjustmentSpeed *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorScoreParametersSet is a free log retrieval operation binding the contract event 0x4b48724280029c2ea7a445c9cea30838525342e7a9ea9468f630b52e75d6c536.
+//
+// Solidity: event ValidatorScoreParametersSet(uint256 exponent, uint256 adjustmentSpeed)
+func (_Validators *ValidatorsFilterer) FilterValidatorScoreParametersSet(opts *bind.FilterOpts) (*ValidatorsValidatorScoreParametersSetIterator, error) {
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "ValidatorScoreParametersSet")
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsValidatorScoreParametersSetIterator{contract: _Validators.contract, event: "ValidatorScoreParametersSet", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorScoreParametersSet is a free log subscription operation binding the contract event 0x4b48724280029c2ea7a445c9cea30838525342e7a9ea9468f630b52e75d6c536.
+//
+// Solidity: event ValidatorScoreParametersSet(uint256 exponent, uint256 adjustmentSpeed)
+func (_Validators *ValidatorsFilterer) WatchValidatorScoreParametersSet(opts *bind.WatchOpts, sink chan<- *ValidatorsValidatorScoreParametersSet) (event.Subscription, error) {
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "ValidatorScoreParametersSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsValidatorScoreParametersSet)
+				if err := _Validators.contract.UnpackLog(event, "ValidatorScoreParametersSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorScoreParametersSet is a log parse operation binding the contract event 0x4b48724280029c2ea7a445c9cea30838525342e7a9ea9468f630b52e75d6c536.
+//
+// Solidity: event ValidatorScoreParametersSet(uint256 exponent, uint256 adjustmentSpeed)
+func (_Validators *ValidatorsFilterer) ParseValidatorScoreParametersSet(log types.Log) (*ValidatorsValidatorScoreParametersSet, error) {
+	event := new(ValidatorsValidatorScoreParametersSet)
+	if err := _Validators.contract.UnpackLog(event, "ValidatorScoreParametersSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorsValidatorScoreUpdatedIterator is returned from FilterValidatorScoreUpdated and is used to iterate over the raw logs and unpacked data for ValidatorScoreUpdated events raised by the Validators contract.
+type ValidatorsValidatorScoreUpdatedIterator struct {
+	Event *ValidatorsValidatorScoreUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorsValidatorScoreUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorsValidatorScoreUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorsValidatorScoreUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorsValidatorScoreUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorsValidatorScoreUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorsValidatorScoreUpdated represents a ValidatorScoreUpdated event raised by the Validators contract.
+type ValidatorsValidatorScoreUpdated struct {
+	Validator  common.Address
+	Score      *big.Int
+	EpochScore *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorScoreUpdated is a free log retrieval operation binding the contract event 0xedf9f87e50e10c533bf3ae7f5a7894ae66c23e6cbbe8773d7765d20ad6f995e9.
+//
+// Solidity: event ValidatorScoreUpdated(address indexed validator, uint256 score, uint256 epochScore)
+func (_Validators *ValidatorsFilterer) FilterValidatorScoreUpdated(opts *bind.FilterOpts, validator []common.Address) (*ValidatorsValidatorScoreUpdatedIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.FilterLogs(opts, "ValidatorScoreUpdated", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorsValidatorScoreUpdatedIterator{contract: _Validators.contract, event: "ValidatorScoreUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorScoreUpdated is a free log subscription operation binding the contract event 0xedf9f87e50e10c533bf3ae7f5a7894ae66c23e6cbbe8773d7765d20ad6f995e9.
+//
+// Solidity: event ValidatorScoreUpdated(address indexed validator, uint256 score, uint256 epochScore)
+func (_Validators *ValidatorsFilterer) WatchValidatorScoreUpdated(opts *bind.WatchOpts, sink chan<- *ValidatorsValidatorScoreUpdated, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Validators.contract.WatchLogs(opts, "ValidatorScoreUpdated", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorsValidatorScoreUpdated)
+				if err := _Validators.contract.UnpackLog(event, "ValidatorScoreUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorScoreUpdated is a log parse operation binding the contract event 0xedf9f87e50e10c533bf3ae7f5a7894ae66c23e6cbbe8773d7765d20ad6f995e9.
+//
+// Solidity: event ValidatorScoreUpdated(address indexed validator, uint256 score, uint256 epochScore)
+func (_Validators *ValidatorsFilterer) ParseValidatorScoreUpdated(log types.Log) (*ValidatorsValidatorScoreUpdated, error) {
+	event := new(ValidatorsValidatorScoreUpdated)
+	if err := _Validators.contract.UnpackLog(event, "ValidatorScoreUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,533 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/mapprotocol/marker_tool01/handler"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	minFlag = cli.Uint64Flag{
+		Name:  "min",
+		Usage: "minimum number of electable validators",
+	}
+	maxFlag = cli.Uint64Flag{
+		Name:  "max",
+		Usage: "maximum number of electable validators",
+	}
+	outFlag = cli.StringFlag{
+		Name:  "out",
+		Usage: "file to write to (- for stdout)",
+		Value: "-",
+	}
+	formatFlag = cli.StringFlag{
+		Name:  "format",
+		Usage: "output format: csv or json",
+		Value: "csv",
+	}
+)
+
+var electionCommand = cli.Command{
+	Name:  "election",
+	Usage: "Election contract operations",
+	Subcommands: []cli.Command{
+		{
+			Name:   "get-electable",
+			Usage:  "show the min and max electable validators",
+			Flags:  callFlags,
+			Action: getElectableValidators,
+		},
+		{
+			Name:   "set-electable",
+			Usage:  "set the min and max electable validators",
+			Flags:  append([]cli.Flag{minFlag, maxFlag}, txFlags...),
+			Action: setElectableValidators,
+		},
+		{
+			Name:   "params",
+			Usage:  "show the owner and all Election parameters",
+			Flags:  callFlags,
+			Action: showElectionParams,
+		},
+		{
+			Name:      "set-max-voted-for",
+			Usage:     "set how many validators one account may vote for",
+			ArgsUsage: "<n>",
+			Flags:     txFlags,
+			Action:    setMaxNumValidatorsVotedFor,
+		},
+		{
+			Name:      "set-electability-threshold",
+			Usage:     "set the share of the total votes a validator needs to be elected, e.g. 0.5%",
+			ArgsUsage: "<percent>",
+			Flags:     txFlags,
+			Action:    setElectabilityThreshold,
+		},
+		{
+			Name:      "mark-eligible",
+			Usage:     "add a validator to the eligible validators",
+			ArgsUsage: "<validator>",
+			Flags:     txFlags,
+			Action:    markValidatorEligible,
+		},
+		{
+			Name:      "mark-ineligible",
+			Usage:     "remove a validator from the eligible validators",
+			ArgsUsage: "<validator>",
+			Flags:     txFlags,
+			Action:    markValidatorIneligible,
+		},
+		{
+			Name:      "active-votes",
+			Usage:     "show the active votes of a validator",
+			ArgsUsage: "<validator>",
+			Flags:     append([]cli.Flag{blockFlag}, callFlags...),
+			Action:    getActiveVotesForValidator,
+		},
+		{
+			Name:      "vote",
+			Usage:     "vote for a validator with nonvoting locked gold",
+			ArgsUsage: "<validator> <wei>",
+			Flags:     txFlags,
+			Action:    voteValidator,
+		},
+		{
+			Name:      "activate",
+			Usage:     "activate the pending votes for a validator, of another account if given",
+			ArgsUsage: "<validator> [<account>]",
+			Flags:     txFlags,
+			Action:    activateVotes,
+		},
+		{
+			Name:   "activate-all",
+			Usage:  "activate the pending votes for every validator where they can be",
+			Flags:  txFlags,
+			Action: activateAllPendingVotes,
+		},
+		{
+			Name:      "revoke-pending",
+			Usage:     "revoke pending votes for a validator",
+			ArgsUsage: "<validator> <wei>",
+			Flags:     txFlags,
+			Action:    revokePendingVotes,
+		},
+		{
+			Name:      "revoke-active",
+			Usage:     "revoke active votes for a validator, all of them if no amount is given",
+			ArgsUsage: "<validator> [<wei>]",
+			Flags:     txFlags,
+			Action:    revokeActiveVotes,
+		},
+		{
+			Name:      "votes",
+			Usage:     "show the locked gold of an account and its pending and active votes per validator",
+			ArgsUsage: "<account>",
+			Flags:     callFlags,
+			Action:    showVotingPortfolio,
+		},
+		{
+			Name:   "leaderboard",
+			Usage:  "rank the validators by votes and simulate the next election",
+			Flags:  callFlags,
+			Action: showLeaderboard,
+		},
+		{
+			Name:      "pending-voters",
+			Usage:     "export the pending voters of validators, every registered and eligible one if none are given",
+			ArgsUsage: "[<validator>...]",
+			Flags:     append([]cli.Flag{blockFlag, outFlag, formatFlag}, callFlags...),
+			Action:    exportPendingVoters,
+		},
+	},
+}
+
+func getElectableValidators(ctx *cli.Context) error {
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	min, max, err := client.GetElectableValidators()
+	if err != nil {
+		return err
+	}
+	log.Info("getElectableValidators", "minElectableValidators", min, "maxElectableValidators", max)
+	return nil
+}
+
+func setElectableValidators(ctx *cli.Context) error {
+	if !ctx.IsSet(minFlag.Name) || !ctx.IsSet(maxFlag.Name) {
+		return errors.New("both --min and --max are required")
+	}
+	min := new(big.Int).SetUint64(ctx.Uint64(minFlag.Name))
+	max := new(big.Int).SetUint64(ctx.Uint64(maxFlag.Name))
+	if min.Cmp(max) > 0 {
+		return errors.New("--min must not exceed --max")
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := client.SetElectableValidators(signer, min, max)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info("setElectableValidators", "minElectableValidators", min, "maxElectableValidators", max)
+	return nil
+}
+
+func showElectionParams(ctx *cli.Context) error {
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	p, err := client.ElectionParams()
+	if err != nil {
+		return err
+	}
+	log.Info("election params", "owner", p.Owner, "minElectableValidators", p.MinElectable,
+		"maxElectableValidators", p.MaxElectable, "maxNumValidatorsVotedFor", p.MaxNumValidatorsVotedFor,
+		"electabilityThreshold", handler.FormatPercent(p.ElectabilityThreshold))
+	return nil
+}
+
+func setMaxNumValidatorsVotedFor(ctx *cli.Context) error {
+	n, err := argBig(ctx, 0, "n")
+	if err != nil {
+		return err
+	}
+	return sendElectionOwnerTx(ctx, "setMaxNumValidatorsVotedFor", func(client *handler.Client, signer handler.Signer) (common.Hash, error) {
+		return client.SetMaxNumValidatorsVotedFor(signer, n)
+	}, "maxNumValidatorsVotedFor", n)
+}
+
+func setElectabilityThreshold(ctx *cli.Context) error {
+	if ctx.NArg() < 1 {
+		return errors.New("missing percent argument")
+	}
+	threshold, err := handler.ParsePercent(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	return sendElectionOwnerTx(ctx, "setElectabilityThreshold", func(client *handler.Client, signer handler.Signer) (common.Hash, error) {
+		return client.SetElectabilityThreshold(signer, threshold)
+	}, "electabilityThreshold", handler.FormatPercent(threshold))
+}
+
+func markValidatorEligible(ctx *cli.Context) error {
+	validator, err := argAddress(ctx, 0, "validator")
+	if err != nil {
+		return err
+	}
+	return sendElectionOwnerTx(ctx, "markValidatorEligible", func(client *handler.Client, signer handler.Signer) (common.Hash, error) {
+		return client.MarkValidatorEligible(signer, validator)
+	}, "validator", validator)
+}
+
+func markValidatorIneligible(ctx *cli.Context) error {
+	validator, err := argAddress(ctx, 0, "validator")
+	if err != nil {
+		return err
+	}
+	return sendElectionOwnerTx(ctx, "markValidatorIneligible", func(client *handler.Client, signer handler.Signer) (common.Hash, error) {
+		return client.MarkValidatorIneligible(signer, validator)
+	}, "validator", validator)
+}
+
+// sendElectionOwnerTx sends the Election transaction built by send and
+// waits for it.
+func sendElectionOwnerTx(ctx *cli.Context, name string, send func(*handler.Client, handler.Signer) (common.Hash, error), logCtx ...interface{}) error {
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := send(client, signer)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info(name, append([]interface{}{"from", signer.Address()}, logCtx...)...)
+	return nil
+}
+
+func getActiveVotesForValidator(ctx *cli.Context) error {
+	validator, err := argAddress(ctx, 0, "validator")
+	if err != nil {
+		return err
+	}
+	height, err := blockFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	res, err := client.GetActiveVotesForValidator(validator, height)
+	if err != nil {
+		return err
+	}
+	log.Info("getActiveVotesForValidator", "validator", validator, "height", height, "val", handler.ToCoin(res))
+	return nil
+}
+
+func voteValidator(ctx *cli.Context) error {
+	validator, err := argAddress(ctx, 0, "validator")
+	if err != nil {
+		return err
+	}
+	value, err := argBig(ctx, 1, "wei")
+	if err != nil {
+		return err
+	}
+	return sendElectionTx(ctx, "vote", func(client *handler.Client, signer handler.Signer) (common.Hash, error) {
+		return client.VoteValidator(signer, validator, value)
+	}, "validator", validator, "value", value)
+}
+
+func activateVotes(ctx *cli.Context) error {
+	validator, err := argAddress(ctx, 0, "validator")
+	if err != nil {
+		return err
+	}
+	var account common.Address
+	if ctx.NArg() > 1 {
+		if account, err = argAddress(ctx, 1, "account"); err != nil {
+			return err
+		}
+	}
+	return sendElectionTx(ctx, "activate", func(client *handler.Client, signer handler.Signer) (common.Hash, error) {
+		return client.ActivateVotes(signer, validator, account)
+	}, "validator", validator)
+}
+
+func activateAllPendingVotes(ctx *cli.Context) error {
+	return sendElectionTx(ctx, "activeAllPending", func(client *handler.Client, signer handler.Signer) (common.Hash, error) {
+		txHash, validators, err := client.ActivateAllPendingVotes(signer)
+		if err == nil {
+			log.Info("activating pending votes", "validators", validators)
+		}
+		return txHash, err
+	})
+}
+
+func revokePendingVotes(ctx *cli.Context) error {
+	validator, err := argAddress(ctx, 0, "validator")
+	if err != nil {
+		return err
+	}
+	value, err := argBig(ctx, 1, "wei")
+	if err != nil {
+		return err
+	}
+	return sendElectionTx(ctx, "revokePending", func(client *handler.Client, signer handler.Signer) (common.Hash, error) {
+		return client.RevokePendingVotes(signer, validator, value)
+	}, "validator", validator, "value", value)
+}
+
+func revokeActiveVotes(ctx *cli.Context) error {
+	validator, err := argAddress(ctx, 0, "validator")
+	if err != nil {
+		return err
+	}
+	var value *big.Int
+	if ctx.NArg() > 1 {
+		if value, err = argBig(ctx, 1, "wei"); err != nil {
+			return err
+		}
+	}
+	name := "revokeActive"
+	if value == nil {
+		name = "revokeAllActive"
+	}
+	return sendElectionTx(ctx, name, func(client *handler.Client, signer handler.Signer) (common.Hash, error) {
+		return client.RevokeActiveVotes(signer, validator, value)
+	}, "validator", validator)
+}
+
+// sendElectionTx sends the Election transaction built by send, waits for it
+// and prints the sender's vote split afterwards.
+func sendElectionTx(ctx *cli.Context, name string, send func(*handler.Client, handler.Signer) (common.Hash, error), logCtx ...interface{}) error {
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := send(client, signer)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info(name, append([]interface{}{"from", signer.Address()}, logCtx...)...)
+	split, err := client.VoteSplit(signer.Address())
+	if err != nil {
+		return err
+	}
+	logVoteSplit(split)
+	return nil
+}
+
+func showVotingPortfolio(ctx *cli.Context) error {
+	account, err := argAddress(ctx, 0, "account")
+	if err != nil {
+		return err
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	p, err := client.VotingPortfolio(account)
+	if err != nil {
+		return err
+	}
+	log.Info("voting portfolio", "account", p.Account, "epoch", p.Epoch, "totalLocked", handler.ToCoin(p.TotalLocked),
+		"nonvoting", handler.ToCoin(p.Nonvoting), "voting", handler.ToCoin(p.Voting()),
+		"totalVotes", handler.ToCoin(p.TotalVotes), "validators", len(p.Votes))
+	if p.Unaccounted().Sign() != 0 {
+		log.Warn("voting locked gold and total votes differ", "voting", p.Voting(), "totalVotes", p.TotalVotes)
+	}
+	for _, v := range p.Votes {
+		total := new(big.Int).Add(v.Pending, v.Active)
+		share := new(big.Float)
+		if p.TotalVotes.Sign() > 0 {
+			share.Quo(new(big.Float).SetInt(new(big.Int).Mul(total, big.NewInt(100))), new(big.Float).SetInt(p.TotalVotes))
+		}
+		logCtx := []interface{}{"validator", v.Validator, "active", handler.ToCoin(v.Active), "pending", handler.ToCoin(v.Pending),
+			"share", share.Text('f', 2) + "%"}
+		if v.Pending.Sign() > 0 {
+			logCtx = append(logCtx, "pendingEpoch", v.PendingEpoch, "activatable", v.Activatable)
+		}
+		log.Info("votes", logCtx...)
+	}
+	return nil
+}
+
+func showLeaderboard(ctx *cli.Context) error {
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	b, err := client.Leaderboard()
+	if err != nil {
+		return err
+	}
+	log.Info("leaderboard", "validators", len(b.Entries), "totalVotes", handler.ToCoin(b.TotalVotes),
+		"electabilityThreshold", handler.FormatPercent(b.ElectabilityThreshold), "requiredVotes", handler.ToCoin(b.RequiredVotes),
+		"minElectable", b.MinElectable, "maxElectable", b.MaxElectable, "elected", b.Elected)
+	for _, e := range b.Entries {
+		logCtx := []interface{}{"rank", e.Rank, "validator", e.Validator, "votes", handler.ToCoin(e.Votes),
+			"eligible", e.Eligible, "elected", e.Elected}
+		if !e.Marginal {
+			log.Info("validator", logCtx...)
+			continue
+		}
+		if e.Elected {
+			logCtx = append(logCtx, "aboveCut", handler.ToCoin(e.Margin))
+		} else {
+			logCtx = append(logCtx, "belowCut", handler.ToCoin(e.Margin))
+		}
+		log.Warn("marginal seat", logCtx...)
+	}
+	switch {
+	case int64(b.Elected) < b.MinElectable.Int64():
+		log.Warn("the election would fail", "elected", b.Elected, "minElectable", b.MinElectable)
+	case b.ElectErr != nil:
+		log.Warn("electValidatorSigners reverted", "err", b.ElectErr)
+	case len(b.Signers) != b.Elected:
+		log.Warn("electValidatorSigners disagrees with the simulation", "signers", len(b.Signers), "elected", b.Elected)
+	}
+	return nil
+}
+
+func logVoteSplit(s *handler.VoteSplit) {
+	log.Info("vote split", "account", s.Account, "nonvoting", handler.ToCoin(s.Nonvoting), "validators", len(s.Votes))
+	for _, v := range s.Votes {
+		log.Info("votes", "validator", v.Validator, "pending", handler.ToCoin(v.Pending),
+			"active", handler.ToCoin(v.Active), "activatable", v.Activatable)
+	}
+}
+
+func exportPendingVoters(ctx *cli.Context) error {
+	var write func(io.Writer, []handler.PendingVoter) error
+	switch format := ctx.String(formatFlag.Name); format {
+	case "csv":
+		write = handler.WritePendingVotersCSV
+	case "json":
+		write = handler.WritePendingVotersJSON
+	default:
+		return fmt.Errorf("unknown format %q, want csv or json", format)
+	}
+	var validators []common.Address
+	for i := 0; i < ctx.NArg(); i++ {
+		validator, err := argAddress(ctx, i, "validator")
+		if err != nil {
+			return err
+		}
+		validators = append(validators, validator)
+	}
+	height, err := blockFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	voters, err := client.PendingVoters(validators, height)
+	if err != nil {
+		return err
+	}
+	file := ctx.String(outFlag.Name)
+	if file == "-" {
+		return write(os.Stdout, voters)
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if err := write(f, voters); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	log.Info("pending voters exported", "file", file, "height", height, "voters", len(voters))
+	return nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"github.com/ethereum/go-ethereum/log"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var epochRewardsCommand = cli.Command{
+	Name:  "epochrewards",
+	Usage: "EpochRewards contract operations",
+	Subcommands: []cli.Command{
+		{
+			Name:   "get-maintainer",
+			Usage:  "show the mgr maintainer address",
+			Flags:  callFlags,
+			Action: getMaintainer,
+		},
+		{
+			Name:      "set-maintainer",
+			Usage:     "set the mgr maintainer address",
+			ArgsUsage: "<address>",
+			Flags:     txFlags,
+			Action:    setMaintainer,
+		},
+		{
+			Name:   "get-payment",
+			Usage:  "show the target epoch payment",
+			Flags:  callFlags,
+			Action: getTargetEpochPayment,
+		},
+		{
+			Name:      "set-payment",
+			Usage:     "set the target epoch payment in wei",
+			ArgsUsage: "<wei>",
+			Flags:     txFlags,
+			Action:    setTargetEpochPayment,
+		},
+	},
+}
+
+func getMaintainer(ctx *cli.Context) error {
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	addr, err := client.GetMgrMaintainerAddress()
+	if err != nil {
+		return err
+	}
+	log.Info("getMgrMaintainerAddress", "address", addr)
+	return nil
+}
+
+func setMaintainer(ctx *cli.Context) error {
+	target, err := argAddress(ctx, 0, "address")
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := client.SetMgrMaintainerAddress(signer, target)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info("setMgrMaintainerAddress", "address", target)
+	return nil
+}
+
+func getTargetEpochPayment(ctx *cli.Context) error {
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	value, err := client.GetTargetEpochPayment()
+	if err != nil {
+		return err
+	}
+	log.Info("getTargetEpochPayment", "value", value)
+	return nil
+}
+
+func setTargetEpochPayment(ctx *cli.Context) error {
+	value, err := argBig(ctx, 0, "wei")
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := client.SetTargetEpochPayment(signer, value)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info("setTargetEpochPayment", "value", value)
+	return nil
+}
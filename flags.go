@@ -0,0 +1,327 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/console/prompt"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/mapprotocol/marker_tool01/handler"
+	"github.com/shopspring/decimal"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	endpointFlag = cli.StringFlag{
+		Name:   "endpoint",
+		Usage:  "JSON-RPC endpoint of the node",
+		Value:  "https://rpc.maplabs.io",
+		EnvVar: "MARKER_ENDPOINT",
+	}
+	fromFlag = cli.StringFlag{
+		Name:  "from",
+		Usage: "sender address, required with --signer and checked against the key otherwise",
+	}
+	keyFlag = cli.StringFlag{
+		Name:   "key",
+		Usage:  "hex encoded private key of the sender",
+		EnvVar: "MARKER_KEY",
+	}
+	keyFileFlag = cli.StringFlag{
+		Name:  "keyfile",
+		Usage: "file holding the hex encoded private key of the sender",
+	}
+	keystoreFlag = cli.StringFlag{
+		Name:   "keystore",
+		Usage:  "encrypted keystore JSON file of the sender",
+		EnvVar: "MARKER_KEYSTORE",
+	}
+	passwordFlag = cli.StringFlag{
+		Name:   "password",
+		Usage:  "file holding the keystore passphrase (default prompt)",
+		EnvVar: "MARKER_PASSWORD_FILE",
+	}
+	signerFlag = cli.StringFlag{
+		Name:   "signer",
+		Usage:  "external signer endpoint such as clef's IPC path or HTTP address",
+		EnvVar: "MARKER_SIGNER",
+	}
+	blockFlag = cli.StringFlag{
+		Name:  "block",
+		Usage: "block number to query at (default latest)",
+	}
+	feeModeFlag = cli.StringFlag{
+		Name:   "fee-mode",
+		Usage:  "transaction type: auto, legacy or dynamic (EIP-1559)",
+		Value:  handler.FeeModeAuto.String(),
+		EnvVar: "MARKER_FEE_MODE",
+	}
+	gasLimitFlag = cli.Uint64Flag{
+		Name:   "gas-limit",
+		Usage:  "fixed gas limit (default estimate)",
+		EnvVar: "MARKER_GAS_LIMIT",
+	}
+	gasMultiplierFlag = cli.Float64Flag{
+		Name:   "gas-multiplier",
+		Usage:  "safety multiplier applied to the gas estimate",
+		Value:  handler.DefaultGasMultiplier,
+		EnvVar: "MARKER_GAS_MULTIPLIER",
+	}
+	gasPriceFlag = cli.StringFlag{
+		Name:   "gas-price",
+		Usage:  "legacy gas price in gwei (default suggested by the node)",
+		EnvVar: "MARKER_GAS_PRICE",
+	}
+	tipCapFlag = cli.StringFlag{
+		Name:   "tip-cap",
+		Usage:  "EIP-1559 priority fee in gwei (default suggested by the node)",
+		EnvVar: "MARKER_TIP_CAP",
+	}
+	maxFeeFlag = cli.StringFlag{
+		Name:   "max-fee",
+		Usage:  "cap on the gas price or EIP-1559 fee cap in gwei",
+		EnvVar: "MARKER_MAX_FEE",
+	}
+	timeoutFlag = cli.DurationFlag{
+		Name:   "timeout",
+		Usage:  "how long to wait for the transaction to be mined, 0 waits forever",
+		Value:  handler.DefaultWaitTimeout,
+		EnvVar: "MARKER_TIMEOUT",
+	}
+	confirmationsFlag = cli.Uint64Flag{
+		Name:   "confirmations",
+		Usage:  "number of blocks to wait for on top of the including block",
+		EnvVar: "MARKER_CONFIRMATIONS",
+	}
+	forceFlag = cli.BoolFlag{
+		Name:   "force",
+		Usage:  "send the transaction even if its simulation reverts",
+		EnvVar: "MARKER_FORCE",
+	}
+)
+
+// callFlags are shared by every read only command.
+var callFlags = []cli.Flag{endpointFlag}
+
+// txFlags are shared by every command that sends a transaction.
+var txFlags = []cli.Flag{
+	endpointFlag, fromFlag, keyFlag, keyFileFlag, keystoreFlag, passwordFlag, signerFlag,
+	feeModeFlag, gasLimitFlag, gasMultiplierFlag, gasPriceFlag, tipCapFlag, maxFeeFlag,
+	forceFlag, timeoutFlag, confirmationsFlag,
+}
+
+// clientFromContext dials the node given by --endpoint, applies the fee
+// and --force flags of transaction commands and logs the client's warnings.
+func clientFromContext(ctx *cli.Context) (*handler.Client, error) {
+	policy, err := feePolicyFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client, err := handler.Dial(ctx.String(endpointFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	client.SetFeePolicy(policy)
+	client.SetForce(ctx.Bool(forceFlag.Name))
+	client.SetLogger(log.Root())
+	return client, nil
+}
+
+// feePolicyFromContext builds the fee policy from the fee flags, falling
+// back to the defaults for commands that do not define them.
+func feePolicyFromContext(ctx *cli.Context) (handler.FeePolicy, error) {
+	policy := handler.DefaultFeePolicy()
+	if s := ctx.String(feeModeFlag.Name); s != "" {
+		mode, err := handler.ParseFeeMode(s)
+		if err != nil {
+			return policy, err
+		}
+		policy.Mode = mode
+	}
+	policy.GasLimit = ctx.Uint64(gasLimitFlag.Name)
+	if ctx.IsSet(gasMultiplierFlag.Name) {
+		policy.GasMultiplier = ctx.Float64(gasMultiplierFlag.Name)
+		if policy.GasMultiplier < 1 {
+			return policy, fmt.Errorf("--%s must be at least 1", gasMultiplierFlag.Name)
+		}
+	}
+	var err error
+	if policy.GasPrice, err = gweiFromContext(ctx, gasPriceFlag.Name); err != nil {
+		return policy, err
+	}
+	if policy.GasTipCap, err = gweiFromContext(ctx, tipCapFlag.Name); err != nil {
+		return policy, err
+	}
+	if policy.MaxFee, err = gweiFromContext(ctx, maxFeeFlag.Name); err != nil {
+		return policy, err
+	}
+	return policy, nil
+}
+
+// gweiFromContext parses a decimal gwei flag into wei, nil when unset.
+func gweiFromContext(ctx *cli.Context, name string) (*big.Int, error) {
+	s := ctx.String(name)
+	if s == "" {
+		return nil, nil
+	}
+	d, err := decimal.NewFromString(s)
+	if err != nil || d.Sign() < 0 {
+		return nil, fmt.Errorf("invalid --%s %q", name, s)
+	}
+	wei := d.Shift(9)
+	if !wei.Equal(wei.Truncate(0)) {
+		return nil, fmt.Errorf("--%s %q has more precision than 1 wei", name, s)
+	}
+	return wei.BigInt(), nil
+}
+
+// waitTx logs the sent transaction and waits, bounded by --timeout, until
+// it is mined with --confirmations blocks on top.
+func waitTx(ctx *cli.Context, client *handler.Client, txHash common.Hash) error {
+	waitCtx := context.Background()
+	if timeout := ctx.Duration(timeoutFlag.Name); timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(waitCtx, timeout)
+		defer cancel()
+	}
+	log.Info("Please waiting ", "txHash", txHash.Hex())
+	result, err := client.Wait(waitCtx, txHash, handler.WaitOpts{Confirmations: ctx.Uint64(confirmationsFlag.Name)})
+	if err != nil {
+		return err
+	}
+	log.Info("Transaction Success", "block Number", result.BlockNumber, "gasUsed", result.GasUsed,
+		"effectiveGasPrice", result.EffectiveGasPrice, "logs", len(result.Logs), "confirmations", result.Confirmations)
+	return nil
+}
+
+// signerFromContext builds the sender's signer from exactly one of --key,
+// --keyfile, --keystore and --signer. The keystore passphrase is read from
+// --password or prompted for. The returned func releases the signer, e.g.
+// the connection to a remote signer, and is deferred like client.Close.
+func signerFromContext(ctx *cli.Context) (handler.Signer, func(), error) {
+	var set []string
+	for _, flag := range []cli.StringFlag{keyFlag, keyFileFlag, keystoreFlag, signerFlag} {
+		if ctx.String(flag.Name) != "" {
+			set = append(set, "--"+flag.Name)
+		}
+	}
+	switch len(set) {
+	case 0:
+		return nil, nil, fmt.Errorf("missing sender, use --%s, --%s, --%s or --%s", keyFlag.Name, keyFileFlag.Name, keystoreFlag.Name, signerFlag.Name)
+	case 1:
+	default:
+		return nil, nil, fmt.Errorf("%s are mutually exclusive", strings.Join(set, " and "))
+	}
+
+	var from common.Address
+	if s := ctx.String(fromFlag.Name); s != "" {
+		var err error
+		if from, err = parseAddress(s); err != nil {
+			return nil, nil, err
+		}
+	}
+	var (
+		signer handler.Signer
+		err    error
+	)
+	switch {
+	case ctx.String(signerFlag.Name) != "":
+		if from == (common.Address{}) {
+			return nil, nil, fmt.Errorf("--%s needs --%s", signerFlag.Name, fromFlag.Name)
+		}
+		remote, err := handler.DialRemoteSigner(ctx.String(signerFlag.Name), from)
+		if err != nil {
+			return nil, nil, err
+		}
+		return remote, remote.Close, nil
+	case ctx.String(keyFlag.Name) != "":
+		signer, err = handler.HexKeySigner(ctx.String(keyFlag.Name))
+	case ctx.String(keyFileFlag.Name) != "":
+		signer, err = handler.KeyFileSigner(ctx.String(keyFileFlag.Name))
+	default:
+		var passphrase string
+		if passphrase, err = passphraseFromContext(ctx); err == nil {
+			signer, err = handler.KeystoreSigner(ctx.String(keystoreFlag.Name), passphrase)
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if from != (common.Address{}) && from != signer.Address() {
+		return nil, nil, fmt.Errorf("--%s %s does not match key address %s", fromFlag.Name, from.Hex(), signer.Address().Hex())
+	}
+	return signer, func() {}, nil
+}
+
+// passphraseFromContext reads the first line of --password, or prompts.
+func passphraseFromContext(ctx *cli.Context) (string, error) {
+	if file := ctx.String(passwordFlag.Name); file != "" {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(strings.SplitN(string(data), "\n", 2)[0], "\r"), nil
+	}
+	return prompt.Stdin.PromptPassword("Keystore passphrase: ")
+}
+
+// blockFromContext returns the --block height, nil meaning latest.
+func blockFromContext(ctx *cli.Context) (*big.Int, error) {
+	s := ctx.String(blockFlag.Name)
+	if s == "" || s == "latest" {
+		return nil, nil
+	}
+	return parseBig(s)
+}
+
+// parseAddress accepts full addresses as well as the short system contract
+// form such as 0xd013.
+func parseAddress(s string) (common.Address, error) {
+	raw := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if _, err := hex.DecodeString(strings.Repeat("0", len(raw)%2) + raw); err != nil || raw == "" || len(raw) > 2*common.AddressLength {
+		return common.Address{}, fmt.Errorf("invalid address %q", s)
+	}
+	return common.HexToAddress(s), nil
+}
+
+func parseBig(s string) (*big.Int, error) {
+	v, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+	return v, nil
+}
+
+// argAddress parses the positional argument at index i as an address.
+func argAddress(ctx *cli.Context, i int, name string) (common.Address, error) {
+	if ctx.NArg() <= i {
+		return common.Address{}, fmt.Errorf("missing %s argument", name)
+	}
+	return parseAddress(ctx.Args().Get(i))
+}
+
+// argBig parses the positional argument at index i as an integer.
+func argBig(ctx *cli.Context, i int, name string) (*big.Int, error) {
+	if ctx.NArg() <= i {
+		return nil, fmt.Errorf("missing %s argument", name)
+	}
+	return parseBig(ctx.Args().Get(i))
+}
+
+// argHash parses the positional argument at index i as a 32 byte hash.
+func argHash(ctx *cli.Context, i int, name string) (common.Hash, error) {
+	if ctx.NArg() <= i {
+		return common.Hash{}, fmt.Errorf("missing %s argument", name)
+	}
+	s := ctx.Args().Get(i)
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil || len(b) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid hash %q", s)
+	}
+	return common.BytesToHash(b), nil
+}
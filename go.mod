@@ -5,4 +5,5 @@ go 1.15
 require (
 	github.com/ethereum/go-ethereum v1.10.10
 	github.com/shopspring/decimal v1.3.1
+	gopkg.in/urfave/cli.v1 v1.20.0
 )
@@ -0,0 +1,563 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/mapprotocol/marker_tool01/handler"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	actionFlag = cli.StringSliceFlag{
+		Name:  "action",
+		Usage: `proposal action "<Contract>.<method> [args...] [on <destination>] [value <wei>]", repeatable`,
+	}
+	actionsFileFlag = cli.StringFlag{
+		Name:  "actions",
+		Usage: "file with one proposal action per line, # starts a comment",
+	}
+	descriptionURLFlag = cli.StringFlag{
+		Name:  "description-url",
+		Usage: "URL describing the proposal",
+	}
+	dequeueFlag = cli.BoolFlag{
+		Name:  "dequeue",
+		Usage: "call dequeueProposalsIfReady when the next dequeue is due",
+	}
+	exportFlag = cli.StringFlag{
+		Name:  "export",
+		Usage: "write the proposal transaction as JSON to this file (- for stdout) instead of sending it",
+	}
+)
+
+var governanceCommand = cli.Command{
+	Name:  "governance",
+	Usage: "Governance contract operations",
+	Subcommands: append([]cli.Command{
+		{
+			Name:   "propose",
+			Usage:  "build a proposal from actions and submit it with the minimum deposit",
+			Flags:  append([]cli.Flag{actionFlag, actionsFileFlag, descriptionURLFlag, exportFlag}, txFlags...),
+			Action: propose,
+		},
+		{
+			Name:      "show",
+			Usage:     "print a proposal and decode its transactions",
+			ArgsUsage: "<proposalId>",
+			Flags:     callFlags,
+			Action:    showProposal,
+		},
+		{
+			Name:   "queue",
+			Usage:  "list queued and dequeued proposals and the dequeue schedule",
+			Flags:  append([]cli.Flag{dequeueFlag}, txFlags...),
+			Action: showQueue,
+		},
+		{
+			Name:      "upvote",
+			Usage:     "upvote a queued proposal with all locked gold",
+			ArgsUsage: "<proposalId>",
+			Flags:     txFlags,
+			Action:    upvote,
+		},
+		{
+			Name:   "revoke-upvote",
+			Usage:  "withdraw the upvote of the sender",
+			Flags:  txFlags,
+			Action: revokeUpvote,
+		},
+		{
+			Name:      "vote",
+			Usage:     "vote on a proposal in referendum",
+			ArgsUsage: "<proposalId> yes|no|abstain",
+			Flags:     txFlags,
+			Action:    vote,
+		},
+		{
+			Name:   "revoke-votes",
+			Usage:  "withdraw the votes of the sender on all proposals in referendum",
+			Flags:  txFlags,
+			Action: revokeVotes,
+		},
+		{
+			Name:      "approve",
+			Usage:     "approve a dequeued proposal as the approver",
+			ArgsUsage: "<proposalId>",
+			Flags:     txFlags,
+			Action:    approve,
+		},
+		{
+			Name:      "execute",
+			Usage:     "execute an approved, passing proposal within its execution window",
+			ArgsUsage: "<proposalId>",
+			Flags:     txFlags,
+			Action:    execute,
+		},
+		{
+			Name:      "participation",
+			Usage:     "show the participation, quorum and support of a proposal in referendum and the baseline after it",
+			ArgsUsage: "<proposalId>",
+			Flags:     callFlags,
+			Action:    showParticipation,
+		},
+		hotfixCommand,
+	}, governanceParamCommands()...),
+}
+
+func propose(ctx *cli.Context) error {
+	if ctx.String(descriptionURLFlag.Name) == "" {
+		return fmt.Errorf("missing --%s", descriptionURLFlag.Name)
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	actions, err := actionsFromContext(ctx, client)
+	if err != nil {
+		return err
+	}
+	proposal := &handler.Proposal{Actions: actions, DescriptionURL: ctx.String(descriptionURLFlag.Name)}
+
+	if file := ctx.String(exportFlag.Name); file != "" {
+		return exportProposal(client, proposal, file)
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	txHash, err := client.Propose(signer, proposal)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info("propose", "actions", len(proposal.Actions), "descriptionUrl", proposal.DescriptionURL)
+	return nil
+}
+
+func showProposal(ctx *cli.Context) error {
+	id, err := argBig(ctx, 0, "proposalId")
+	if err != nil {
+		return err
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	info, err := client.Proposal(id)
+	if err != nil {
+		return err
+	}
+	log.Info("proposal", "id", info.ID, "proposer", info.Proposer, "deposit", info.Deposit,
+		"timestamp", info.Timestamp, "descriptionUrl", info.DescriptionURL, "stage", info.Stage,
+		"transactions", len(info.Transactions))
+	for i, tx := range info.Transactions {
+		contract := tx.Contract
+		if contract == "" {
+			contract = "unknown"
+		}
+		if tx.DecodeErr != nil {
+			log.Warn("undecodable call data", "index", i, "destination", tx.Destination, "contract", contract,
+				"value", tx.Value, "data", hexutil.Bytes(tx.Data), "err", tx.DecodeErr)
+			continue
+		}
+		if len(tx.Data) == 0 {
+			log.Info("transfer", "index", i, "destination", tx.Destination, "contract", contract, "value", tx.Value)
+			continue
+		}
+		args := make([]string, len(tx.Args))
+		for j, arg := range tx.Args {
+			args[j] = fmt.Sprintf("%s %s=%v", arg.Type, arg.Name, formatArg(arg.Value))
+		}
+		log.Info("call", "index", i, "destination", tx.Destination, "contract", contract, "value", tx.Value,
+			"method", tx.Method, "args", strings.Join(args, ", "))
+	}
+	return nil
+}
+
+func showQueue(ctx *cli.Context) error {
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	q, err := client.Queue()
+	if err != nil {
+		return err
+	}
+	for _, p := range q.Queued {
+		log.Info("queued", "id", p.ID, "upvotes", p.Upvotes, "stage", p.Stage, "expired", p.Expired)
+	}
+	for _, p := range q.Dequeued {
+		log.Info("dequeued", "id", p.ID, "stage", p.Stage, "expired", p.Expired)
+	}
+	next := q.NextDequeue()
+	wait := time.Duration(0)
+	if q.Now.Before(next) {
+		wait = next.Sub(q.Now)
+	}
+	log.Info("governance queue", "block", q.Block, "queued", len(q.Queued), "dequeued", len(q.Dequeued),
+		"lastDequeue", q.LastDequeue.UTC(), "nextDequeue", next.UTC(), "in", wait,
+		"dequeueFrequency", q.DequeueFrequency, "queueExpiry", q.QueueExpiry)
+
+	if !ctx.Bool(dequeueFlag.Name) {
+		return nil
+	}
+	if !q.DequeueDue() {
+		log.Info("dequeue not due", "queued", len(q.Queued), "in", wait)
+		return nil
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	txHash, err := client.DequeueProposalsIfReady(signer)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info("dequeueProposalsIfReady")
+	return nil
+}
+
+func upvote(ctx *cli.Context) error {
+	id, err := argBig(ctx, 0, "proposalId")
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := client.Upvote(signer, id)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	return logUpvote(client, signer.Address())
+}
+
+func revokeUpvote(ctx *cli.Context) error {
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := client.RevokeUpvote(signer)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	return logUpvote(client, signer.Address())
+}
+
+func logUpvote(client *handler.Client, account common.Address) error {
+	record, err := client.UpvoteRecord(account)
+	if err != nil {
+		return err
+	}
+	q, err := client.Queue()
+	if err != nil {
+		return err
+	}
+	for _, p := range q.Queued {
+		if p.ID.Cmp(record.ProposalID) == 0 {
+			log.Info("upvote", "account", account, "proposal", record.ProposalID, "weight", record.Weight, "upvotes", p.Upvotes)
+			return nil
+		}
+	}
+	log.Info("upvote", "account", account, "proposal", record.ProposalID, "weight", record.Weight)
+	return nil
+}
+
+func vote(ctx *cli.Context) error {
+	id, err := argBig(ctx, 0, "proposalId")
+	if err != nil {
+		return err
+	}
+	if ctx.NArg() < 2 {
+		return fmt.Errorf("missing vote argument, want yes, no or abstain")
+	}
+	value, err := handler.ParseVoteValue(ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := client.Vote(signer, id, value)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	return logVote(client, signer.Address(), id)
+}
+
+func revokeVotes(ctx *cli.Context) error {
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := client.RevokeVotes(signer)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	q, err := client.Queue()
+	if err != nil {
+		return err
+	}
+	for _, p := range q.Dequeued {
+		if p.Stage == handler.StageReferendum {
+			if err := logVote(client, signer.Address(), p.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func logVote(client *handler.Client, account common.Address, id *big.Int) error {
+	record, err := client.VoteRecord(account, id)
+	if err != nil {
+		return err
+	}
+	totals, err := client.VoteTotals(id)
+	if err != nil {
+		return err
+	}
+	log.Info("vote", "account", account, "proposal", id, "index", record.Index, "value", record.Value,
+		"weight", record.Weight, "yes", totals.Yes, "no", totals.No, "abstain", totals.Abstain)
+	return nil
+}
+
+func approve(ctx *cli.Context) error {
+	return sendProposalTx(ctx, "approve", (*handler.Client).Approve)
+}
+
+func execute(ctx *cli.Context) error {
+	return sendProposalTx(ctx, "execute", (*handler.Client).Execute)
+}
+
+// sendProposalTx logs the status of the proposal, sends the transaction
+// built by send for it and waits for it.
+func sendProposalTx(ctx *cli.Context, name string, send func(*handler.Client, handler.Signer, *big.Int) (common.Hash, error)) error {
+	id, err := argBig(ctx, 0, "proposalId")
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	s, err := client.ProposalStatus(id)
+	if err != nil {
+		return err
+	}
+	log.Info("proposal status", "id", id, "index", s.Index, "stage", s.Stage, "approved", s.Approved,
+		"passing", s.Passing, "yes", s.Votes.Yes, "no", s.Votes.No, "abstain", s.Votes.Abstain,
+		"approvalEnd", s.ApprovalEnd.UTC(), "referendumEnd", s.ReferendumEnd.UTC(), "executionEnd", s.ExecutionEnd.UTC())
+	txHash, err := send(client, signer, id)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info(name, "proposal", id)
+	return nil
+}
+
+func showParticipation(ctx *cli.Context) error {
+	id, err := argBig(ctx, 0, "proposalId")
+	if err != nil {
+		return err
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	p, err := client.Participation(id)
+	if err != nil {
+		return err
+	}
+	log.Info("votes", "proposal", id, "yes", p.Votes.Yes, "no", p.Votes.No, "abstain", p.Votes.Abstain,
+		"totalLocked", p.TotalLocked, "participation", handler.FormatFixidity(p.Participation))
+	log.Info("quorum", "baseline", handler.FormatFixidity(p.Baseline), "quorumFactor", handler.FormatFixidity(p.QuorumFactor),
+		"quorum", handler.FormatFixidity(p.Quorum), "quorumVotes", p.QuorumVotes, "reached", p.QuorumReached())
+	log.Info("support", "support", handler.FormatFixidity(p.Support), "threshold", handler.FormatFixidity(p.Threshold),
+		"wouldPass", p.WouldPass(), "passing", p.Passing)
+	log.Info("baseline after execution", "baseline", handler.FormatFixidity(p.NextBaseline),
+		"updateFactor", handler.FormatFixidity(p.UpdateFactor), "floor", handler.FormatFixidity(p.Floor))
+	return nil
+}
+
+// formatArg prints addresses and byte arrays in hex rather than as Go values.
+func formatArg(v interface{}) interface{} {
+	switch v := v.(type) {
+	case common.Address:
+		return v.Hex()
+	case []byte:
+		return hexutil.Bytes(v)
+	case [32]byte:
+		return common.Hash(v).Hex()
+	}
+	return v
+}
+
+// actionsFromContext parses the --action and --actions flags.
+func actionsFromContext(ctx *cli.Context, client *handler.Client) ([]handler.ProposalAction, error) {
+	specs := ctx.StringSlice(actionFlag.Name)
+	if file := ctx.String(actionsFileFlag.Name); file != "" {
+		lines, err := readActions(file)
+		if err != nil {
+			return nil, err
+		}
+		specs = append(specs, lines...)
+	}
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("no actions, use --%s or --%s", actionFlag.Name, actionsFileFlag.Name)
+	}
+	var actions []handler.ProposalAction
+	for _, spec := range specs {
+		action, err := client.ParseAction(spec)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("action", "contract", action.Contract, "method", action.Method,
+			"args", action.Args, "destination", action.Destination, "value", action.Value)
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+// readActions returns the non-empty, non-comment lines of file.
+func readActions(file string) ([]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var specs []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			specs = append(specs, line)
+		}
+	}
+	return specs, scanner.Err()
+}
+
+type exportedAction struct {
+	Contract    string         `json:"contract"`
+	Method      string         `json:"method"`
+	Destination common.Address `json:"destination"`
+	Value       *hexutil.Big   `json:"value"`
+	Data        hexutil.Bytes  `json:"data"`
+}
+
+type exportedProposal struct {
+	To             common.Address   `json:"to"`
+	Value          *hexutil.Big     `json:"value"`
+	Data           hexutil.Bytes    `json:"data"`
+	DescriptionURL string           `json:"descriptionUrl"`
+	Actions        []exportedAction `json:"actions"`
+}
+
+// exportProposal writes the propose transaction, unsigned, to file.
+func exportProposal(client *handler.Client, proposal *handler.Proposal, file string) error {
+	governance, deposit, input, err := client.ProposeInput(proposal)
+	if err != nil {
+		return err
+	}
+	out := exportedProposal{
+		To:             governance,
+		Value:          (*hexutil.Big)(deposit),
+		Data:           input,
+		DescriptionURL: proposal.DescriptionURL,
+	}
+	for _, action := range proposal.Actions {
+		value := action.Value
+		if value == nil {
+			value = new(big.Int)
+		}
+		out.Actions = append(out.Actions, exportedAction{
+			Contract:    action.Contract,
+			Method:      action.Method,
+			Destination: action.Destination,
+			Value:       (*hexutil.Big)(value),
+			Data:        action.Data,
+		})
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if file == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	if err := ioutil.WriteFile(file, data, 0644); err != nil {
+		return err
+	}
+	log.Info("proposal exported", "file", file, "governance", governance, "deposit", deposit)
+	return nil
+}
@@ -0,0 +1,410 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/mapprotocol/marker_tool01/handler"
+	"gopkg.in/urfave/cli.v1"
+)
+
+// paramKind says how a Governance parameter is written on the command line.
+type paramKind int
+
+const (
+	paramCount    paramKind = iota // a plain integer
+	paramWei                       // an amount in wei
+	paramDuration                  // seconds, written as 30m, 24h, 4w
+	paramFraction                  // a fixidity fraction, written as 0.005
+)
+
+var paramArgs = map[paramKind]string{
+	paramCount:    "<n>",
+	paramWei:      "<wei>",
+	paramDuration: "<duration>",
+	paramFraction: "<fraction>",
+}
+
+func (k paramKind) parse(s string) (*big.Int, error) {
+	switch k {
+	case paramDuration:
+		return handler.ParseDuration(s)
+	case paramFraction:
+		return handler.ParseFixidity(s)
+	}
+	v, err := parseBig(s)
+	if err != nil {
+		return nil, err
+	}
+	if v.Sign() < 0 {
+		return nil, fmt.Errorf("negative value %q", s)
+	}
+	return v, nil
+}
+
+func (k paramKind) format(v *big.Int) string {
+	switch k {
+	case paramDuration:
+		return handler.FormatDuration(v)
+	case paramFraction:
+		return handler.FormatFixidity(v)
+	}
+	return v.String()
+}
+
+// governanceSetter is a Governance setter taking a single integer.
+type governanceSetter struct {
+	name   string // subcommand set-<name>
+	method string
+	usage  string
+	kind   paramKind
+	set    func(*handler.Client, handler.Signer, *big.Int) (common.Hash, error)
+}
+
+var governanceSetters = []governanceSetter{
+	{"concurrent-proposals", "setConcurrentProposals", "number of proposals dequeued at a time", paramCount, (*handler.Client).SetConcurrentProposals},
+	{"min-deposit", "setMinDeposit", "minimum deposit of a proposal", paramWei, (*handler.Client).SetMinDeposit},
+	{"queue-expiry", "setQueueExpiry", "time a proposal may stay queued", paramDuration, (*handler.Client).SetQueueExpiry},
+	{"dequeue-frequency", "setDequeueFrequency", "time between dequeues", paramDuration, (*handler.Client).SetDequeueFrequency},
+	{"referendum-stage-duration", "setReferendumStageDuration", "duration of the referendum stage", paramDuration, (*handler.Client).SetReferendumStageDuration},
+	{"execution-stage-duration", "setExecutionStageDuration", "duration of the execution stage", paramDuration, (*handler.Client).SetExecutionStageDuration},
+	{"participation-baseline", "setParticipationBaseline", "participation baseline", paramFraction, (*handler.Client).SetParticipationBaseline},
+	{"participation-floor", "setParticipationFloor", "lowest participation baseline", paramFraction, (*handler.Client).SetParticipationFloor},
+	{"baseline-update-factor", "setBaselineUpdateFactor", "weight of the last participation in the new baseline", paramFraction, (*handler.Client).SetBaselineUpdateFactor},
+	{"baseline-quorum-factor", "setBaselineQuorumFactor", "fraction of the baseline required as quorum", paramFraction, (*handler.Client).SetBaselineQuorumFactor},
+}
+
+// governanceParamCommands returns params and a set-<name> command for every
+// Governance setter.
+func governanceParamCommands() []cli.Command {
+	commands := []cli.Command{
+		{
+			Name:   "params",
+			Usage:  "show all Governance parameters",
+			Flags:  callFlags,
+			Action: governanceParams,
+		},
+		{
+			Name:      "set-approver",
+			Usage:     "set the approver of proposals and hotfixes",
+			ArgsUsage: "<address>",
+			Flags:     txFlags,
+			Action:    setApprover,
+		},
+		{
+			Name:      "set-constitution",
+			Usage:     "set the fraction of yes votes a proposal calling a function needs, 0x00000000 for the default of a destination",
+			ArgsUsage: "<destination> <functionId> <fraction>",
+			Flags:     txFlags,
+			Action:    setConstitution,
+		},
+		{
+			Name:      "constitution",
+			Usage:     "show the threshold of a contract function from the embedded ABIs, or set it when a fraction is given",
+			ArgsUsage: "<Contract>[.<method>] [<fraction>]",
+			Flags:     txFlags,
+			Action:    constitution,
+		},
+		{
+			Name:      "initialize",
+			Usage:     "initialize a freshly deployed Governance contract, every parameter flag is required",
+			ArgsUsage: "<governance>",
+			Flags:     append(initializeFlags(), txFlags...),
+			Action:    initializeGovernance,
+		},
+		{
+			Name:   "constitution-report",
+			Usage:  "list the threshold of every function, view functions included, of every embedded contract",
+			Flags:  callFlags,
+			Action: constitutionReport,
+		},
+	}
+	for _, s := range governanceSetters {
+		s := s
+		commands = append(commands, cli.Command{
+			Name:      "set-" + s.name,
+			Usage:     "set the " + s.usage,
+			ArgsUsage: paramArgs[s.kind],
+			Flags:     txFlags,
+			Action: func(ctx *cli.Context) error {
+				return setGovernanceParam(ctx, s)
+			},
+		})
+	}
+	return commands
+}
+
+var (
+	registryFlag = cli.StringFlag{
+		Name:  "registry",
+		Usage: "address of the Registry",
+		Value: handler.GenesisAddresses["RegistryProxy"].Hex(),
+	}
+	approverFlag = cli.StringFlag{
+		Name:  "approver",
+		Usage: "approver of proposals and hotfixes",
+	}
+)
+
+// initializeFlags are the flags of initialize: the registry, the approver
+// and one named after every Governance setter.
+func initializeFlags() []cli.Flag {
+	flags := []cli.Flag{registryFlag, approverFlag}
+	for _, s := range governanceSetters {
+		flags = append(flags, cli.StringFlag{Name: s.name, Usage: s.usage + " " + paramArgs[s.kind]})
+	}
+	return flags
+}
+
+func initializeGovernance(ctx *cli.Context) error {
+	governance, err := argAddress(ctx, 0, "governance")
+	if err != nil {
+		return err
+	}
+	registry, err := parseAddress(ctx.String(registryFlag.Name))
+	if err != nil {
+		return err
+	}
+	if !ctx.IsSet(approverFlag.Name) {
+		return fmt.Errorf("missing --%s", approverFlag.Name)
+	}
+	approver, err := parseAddress(ctx.String(approverFlag.Name))
+	if err != nil {
+		return err
+	}
+	values := make(map[string]*big.Int)
+	for _, s := range governanceSetters {
+		if !ctx.IsSet(s.name) {
+			return fmt.Errorf("missing --%s", s.name)
+		}
+		if values[s.name], err = s.kind.parse(ctx.String(s.name)); err != nil {
+			return fmt.Errorf("--%s: %w", s.name, err)
+		}
+	}
+	cfg := &handler.GovernanceConfig{
+		Registry:                registry,
+		Approver:                approver,
+		ConcurrentProposals:     values["concurrent-proposals"],
+		MinDeposit:              values["min-deposit"],
+		QueueExpiry:             values["queue-expiry"],
+		DequeueFrequency:        values["dequeue-frequency"],
+		ReferendumStageDuration: values["referendum-stage-duration"],
+		ExecutionStageDuration:  values["execution-stage-duration"],
+		ParticipationBaseline:   values["participation-baseline"],
+		ParticipationFloor:      values["participation-floor"],
+		BaselineUpdateFactor:    values["baseline-update-factor"],
+		BaselineQuorumFactor:    values["baseline-quorum-factor"],
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := client.InitializeGovernance(signer, governance, cfg)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info("initialize", "from", signer.Address(), "governance", governance, "registry", registry, "approver", approver)
+	return nil
+}
+
+func governanceParams(ctx *cli.Context) error {
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	p, err := client.GovernanceParams()
+	if err != nil {
+		return err
+	}
+	log.Info("governance params", "approver", p.Approver, "concurrentProposals", p.ConcurrentProposals,
+		"minDeposit", p.MinDeposit, "queueExpiry", handler.FormatDuration(p.QueueExpiry),
+		"dequeueFrequency", handler.FormatDuration(p.DequeueFrequency),
+		"approvalStageDuration", handler.FormatDuration(p.ApprovalStageDuration),
+		"referendumStageDuration", handler.FormatDuration(p.ReferendumStageDuration),
+		"executionStageDuration", handler.FormatDuration(p.ExecutionStageDuration),
+		"participationBaseline", handler.FormatFixidity(p.ParticipationBaseline),
+		"participationFloor", handler.FormatFixidity(p.ParticipationFloor),
+		"baselineUpdateFactor", handler.FormatFixidity(p.BaselineUpdateFactor),
+		"baselineQuorumFactor", handler.FormatFixidity(p.BaselineQuorumFactor))
+	return nil
+}
+
+func setGovernanceParam(ctx *cli.Context, s governanceSetter) error {
+	if ctx.NArg() < 1 {
+		return fmt.Errorf("missing %s argument", strings.Trim(paramArgs[s.kind], "<>"))
+	}
+	value, err := s.kind.parse(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := s.set(client, signer, value)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info(s.method, "from", signer.Address(), "value", s.kind.format(value))
+	return nil
+}
+
+func setApprover(ctx *cli.Context) error {
+	approver, err := argAddress(ctx, 0, "address")
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := client.SetApprover(signer, approver)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info("setApprover", "from", signer.Address(), "approver", approver)
+	return nil
+}
+
+func setConstitution(ctx *cli.Context) error {
+	destination, err := argAddress(ctx, 0, "destination")
+	if err != nil {
+		return err
+	}
+	var functionID [4]byte
+	if ctx.NArg() < 2 {
+		return fmt.Errorf("missing functionId argument")
+	}
+	b, err := hex.DecodeString(strings.TrimPrefix(ctx.Args().Get(1), "0x"))
+	if err != nil || len(b) != len(functionID) {
+		return fmt.Errorf("invalid functionId %q, want 4 hex bytes", ctx.Args().Get(1))
+	}
+	copy(functionID[:], b)
+	if ctx.NArg() < 3 {
+		return fmt.Errorf("missing fraction argument")
+	}
+	threshold, err := handler.ParseFixidity(ctx.Args().Get(2))
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := client.SetConstitution(signer, destination, functionID, threshold)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info("setConstitution", "from", signer.Address(), "destination", destination,
+		"functionId", hex.EncodeToString(functionID[:]), "threshold", handler.FormatFixidity(threshold))
+	return nil
+}
+
+func constitution(ctx *cli.Context) error {
+	if ctx.NArg() < 1 {
+		return fmt.Errorf("missing <Contract>[.<method>] argument")
+	}
+	var threshold *big.Int
+	if ctx.NArg() > 1 {
+		var err error
+		if threshold, err = handler.ParseFixidity(ctx.Args().Get(1)); err != nil {
+			return err
+		}
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	entry, err := client.Constitution(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	logConstitution("constitution", entry)
+	if threshold == nil {
+		return nil
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	txHash, err := client.SetConstitution(signer, entry.Destination, entry.FunctionID, threshold)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	if entry, err = client.Constitution(ctx.Args().Get(0)); err != nil {
+		return err
+	}
+	logConstitution("setConstitution", entry)
+	return nil
+}
+
+func constitutionReport(ctx *cli.Context) error {
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	entries, err := client.ConstitutionReport()
+	if err != nil {
+		return err
+	}
+	for i := range entries {
+		logConstitution("constitution", &entries[i])
+	}
+	return nil
+}
+
+func logConstitution(msg string, e *handler.ConstitutionEntry) {
+	method := e.Method
+	if method == "" {
+		method = "default"
+	}
+	log.Info(msg, "contract", e.Contract, "method", method, "functionId", hex.EncodeToString(e.FunctionID[:]),
+		"destination", e.Destination, "threshold", handler.FormatFixidity(e.Threshold))
+}
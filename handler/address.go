@@ -1,9 +1,16 @@
 package handler
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 var addr = common.HexToAddress
 
+// GenesisAddresses holds the proxy addresses the system contracts are
+// deployed at in genesis. They are the fallback for, and are cross-checked
+// against, the on-chain Registry.
 var GenesisAddresses = map[string]common.Address{
 	"RegistryProxy":             addr("0xce10"),
 	"GoldTokenProxy":            addr("0xd003"),
@@ -16,3 +23,13 @@ var GenesisAddresses = map[string]common.Address{
 	"RandomProxy":               addr("0xd015"),
 	"BlockchainParametersProxy": addr("0xd018"),
 }
+
+// genesisNames returns the GenesisAddresses keys in a stable order.
+func genesisNames() []string {
+	names := make([]string, 0, len(GenesisAddresses))
+	for name := range GenesisAddresses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
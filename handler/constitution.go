@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ConstitutionEntry is the fraction of yes votes a proposal calling one
+// function needs to pass.
+type ConstitutionEntry struct {
+	Contract string
+	// Method is the signature of the function, empty for the default of
+	// the destination.
+	Method      string
+	Destination common.Address
+	FunctionID  [4]byte
+	// Threshold falls back to the destination's default and then to a
+	// simple majority where no threshold is set for the function.
+	Threshold *big.Int
+}
+
+// Constitution reads the threshold of spec, written as <Contract>.<method>
+// against the embedded ABIs or as <Contract> alone for the default of the
+// contract. Methods of the Proxy ABI are looked up as well, since every
+// contract sits behind a proxy. The method may be a name or a full
+// signature such as vote(uint256,uint256,uint8).
+func (c *Client) Constitution(spec string) (*ConstitutionEntry, error) {
+	contract, method := spec, ""
+	if dot := strings.Index(spec, "."); dot >= 0 {
+		contract, method = spec[:dot], spec[dot+1:]
+	}
+	name, parsed, err := embeddedABI(contract)
+	if err != nil {
+		return nil, err
+	}
+	if name == ProxyID {
+		return nil, fmt.Errorf("%s is behind every contract, use <Contract>.<method> instead", ProxyID)
+	}
+	destination, err := c.addressOf(name)
+	if err != nil {
+		return nil, err
+	}
+	entry := &ConstitutionEntry{Contract: name, Destination: destination}
+	if method != "" {
+		m, err := findMethod(name, parsed, method)
+		if err != nil {
+			return nil, err
+		}
+		entry.Method = m.Sig
+		copy(entry.FunctionID[:], m.ID)
+	}
+	if entry.Threshold, err = c.GetConstitution(destination, entry.FunctionID); err != nil {
+		return nil, fmt.Errorf("constitution of %s: %w", spec, err)
+	}
+	return entry, nil
+}
+
+// ConstitutionReport reads the thresholds of every function of every
+// embedded contract, view functions and its proxy functions included,
+// preceded by the default of each contract. A contract that does not
+// resolve fails the report rather than being left out of it.
+func (c *Client) ConstitutionReport() ([]ConstitutionEntry, error) {
+	_, proxy, err := embeddedABI(ProxyID)
+	if err != nil {
+		return nil, err
+	}
+	var entries []ConstitutionEntry
+	for _, contract := range embeddedContracts {
+		if contract.name == ProxyID {
+			continue
+		}
+		destination, err := c.addressOf(contract.name)
+		if err != nil {
+			return nil, fmt.Errorf("constitution report: %w", err)
+		}
+		parsed, err := contract.meta.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		methods := append(sortedMethods(parsed), sortedMethods(proxy)...)
+		entries = append(entries, ConstitutionEntry{Contract: contract.name, Destination: destination})
+		for _, m := range methods {
+			entry := ConstitutionEntry{Contract: contract.name, Method: m.Sig, Destination: destination}
+			copy(entry.FunctionID[:], m.ID)
+			entries = append(entries, entry)
+		}
+	}
+	for i := range entries {
+		e := &entries[i]
+		if e.Threshold, err = c.GetConstitution(e.Destination, e.FunctionID); err != nil {
+			return nil, fmt.Errorf("constitution of %s %s: %w", e.Contract, e.Method, err)
+		}
+	}
+	return entries, nil
+}
+
+// findMethod looks up method by name or signature.
+func findMethod(contract string, parsed *abi.ABI, method string) (abi.Method, error) {
+	if m, ok := parsed.Methods[method]; ok {
+		return m, nil
+	}
+	for _, m := range parsed.Methods {
+		if m.Sig == method {
+			return m, nil
+		}
+	}
+	if contract != ProxyID {
+		_, proxy, err := embeddedABI(ProxyID)
+		if err != nil {
+			return abi.Method{}, err
+		}
+		if m, err := findMethod(ProxyID, proxy, method); err == nil {
+			return m, nil
+		}
+	}
+	return abi.Method{}, fmt.Errorf("%s has no method %s", contract, method)
+}
+
+// sortedMethods returns the methods of parsed by signature.
+func sortedMethods(parsed *abi.ABI) []abi.Method {
+	var methods []abi.Method
+	for _, m := range parsed.Methods {
+		methods = append(methods, m)
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].Sig < methods[j].Sig })
+	return methods
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestConstitution(t *testing.T) {
+	_, election, _ := embeddedABI(ElectionID)
+	var setElectable [4]byte
+	copy(setElectable[:], election.Methods["setElectableValidators"].ID)
+	node := governanceNode(t, map[string]contractMethod{
+		"getConstitution": func(args []interface{}) ([]interface{}, error) {
+			destination, functionID := args[0].(common.Address), args[1].([4]byte)
+			switch {
+			case destination == GenesisAddresses["ElectionProxy"] && functionID == setElectable:
+				return []interface{}{fixidity("0.7")}, nil
+			case destination == GenesisAddresses["ElectionProxy"]:
+				return []interface{}{fixidity("0.6")}, nil
+			}
+			return []interface{}{fixidity("0.5")}, nil
+		},
+	})
+	cli := newFakeClient(t, node)
+
+	tests := []struct {
+		spec, method, threshold string
+	}{
+		{"Election.setElectableValidators", "setElectableValidators(uint256,uint256)", "0.7"},
+		{"election.setElectableValidators(uint256,uint256)", "setElectableValidators(uint256,uint256)", "0.7"},
+		{"Election", "", "0.6"},
+		{"Election._setImplementation", "_setImplementation(address)", "0.6"},
+		{"EpochRewards.setTargetEpochPayment", "setTargetEpochPayment(uint256)", "0.5"},
+	}
+	for _, test := range tests {
+		entry, err := cli.Constitution(test.spec)
+		if err != nil {
+			t.Errorf("%s: %v", test.spec, err)
+			continue
+		}
+		if entry.Method != test.method || FormatFixidity(entry.Threshold) != test.threshold {
+			t.Errorf("%s: have method %q threshold %s, want %q %s", test.spec, entry.Method, FormatFixidity(entry.Threshold), test.method, test.threshold)
+		}
+	}
+	if entry, _ := cli.Constitution("Election"); entry.FunctionID != ([4]byte{}) || entry.Destination != GenesisAddresses["ElectionProxy"] {
+		t.Errorf("have default entry %+v", entry)
+	}
+	for _, spec := range []string{"Proxy._setImplementation", "Election.nope", "Nope.vote"} {
+		if _, err := cli.Constitution(spec); err == nil {
+			t.Errorf("expected %s to be refused", spec)
+		}
+	}
+
+	entries, err := cli.ConstitutionReport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := make(map[string]string)
+	for _, e := range entries {
+		found[e.Contract+"."+e.Method] = FormatFixidity(e.Threshold)
+	}
+	want := map[string]string{
+		"Election.": "0.6",
+		"Election.setElectableValidators(uint256,uint256)": "0.7",
+		"Election._setImplementation(address)":             "0.6",
+		"Governance._setImplementation(address)":           "0.5",
+		"Election.getElectableValidators()":                "0.6",
+	}
+	for key, threshold := range want {
+		if found[key] != threshold {
+			t.Errorf("%s: have threshold %q, want %s", key, found[key], threshold)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+// ProxyID names the Proxy ABI, which has no registry entry of its own.
+const ProxyID = "Proxy"
+
+// embeddedContracts lists the contracts whose ABIs ship with the tool,
+// named by their registry identifier.
+var embeddedContracts = []struct {
+	name string
+	meta *bind.MetaData
+}{
+	{EpochRewardsID, contracts.EpochRewardsMetaData},
+	{ElectionID, contracts.ElectionMetaData},
+	{ValidatorsID, contracts.ValidatorsMetaData},
+	{LockedGoldID, contracts.LockedGoldMetaData},
+	{GovernanceID, contracts.GovernanceMetaData},
+	{BlockchainParametersID, contracts.BlockchainParametersMetaData},
+	{RegistryID, contracts.RegistryMetaData},
+	{ProxyID, contracts.ProxyMetaData},
+}
@@ -0,0 +1,359 @@
+package handler
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+// ValidatorVotes is an eligible validator and the votes it holds.
+type ValidatorVotes struct {
+	Validator common.Address
+	Votes     *big.Int
+}
+
+// EligibleVotes are the eligible validators ordered by votes, most first,
+// as Election keeps them.
+type EligibleVotes []ValidatorVotes
+
+// ElectionVote is an account's votes for one validator.
+type ElectionVote struct {
+	Validator common.Address
+	Pending   *big.Int
+	Active    *big.Int
+	// PendingEpoch is the epoch the pending votes were last added in.
+	PendingEpoch *big.Int
+	// Activatable is set once the pending votes were cast in an earlier
+	// epoch and can be activated.
+	Activatable bool
+}
+
+// VoteSplit is how an account's locked gold is spread over validators.
+type VoteSplit struct {
+	Account   common.Address
+	Nonvoting *big.Int
+	Votes     []ElectionVote
+}
+
+// VotingPortfolio is everything an account has locked and voted.
+type VotingPortfolio struct {
+	VoteSplit
+	TotalLocked *big.Int
+	// TotalVotes is the sum of the pending and active votes as Election
+	// counts it.
+	TotalVotes *big.Int
+	Epoch      *big.Int
+}
+
+// Voting is the locked gold that is not nonvoting.
+func (p *VotingPortfolio) Voting() *big.Int {
+	return new(big.Int).Sub(p.TotalLocked, p.Nonvoting)
+}
+
+// Unaccounted is the voting locked gold missing from TotalVotes, which is
+// zero unless the two contracts disagree.
+func (p *VotingPortfolio) Unaccounted() *big.Int {
+	return new(big.Int).Sub(p.Voting(), p.TotalVotes)
+}
+
+// VotingPortfolio reads the locked gold of account, its votes per
+// validator and the current epoch.
+func (c *Client) VotingPortfolio(account common.Address) (*VotingPortfolio, error) {
+	election, _, err := c.electionAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	split, err := c.VoteSplit(account)
+	if err != nil {
+		return nil, err
+	}
+	p := &VotingPortfolio{VoteSplit: *split}
+	if p.TotalLocked, err = c.GetAccountTotalLockedGold(account, nil); err != nil {
+		return nil, fmt.Errorf("total locked gold of %s: %w", account.Hex(), err)
+	}
+	if p.TotalVotes, err = election.GetTotalVotesByAccount(nil, account); err != nil {
+		return nil, fmt.Errorf("total votes of %s: %w", account.Hex(), err)
+	}
+	if p.Epoch, err = election.GetEpochNumber(nil); err != nil {
+		return nil, fmt.Errorf("epoch number: %w", err)
+	}
+	return p, nil
+}
+
+// EligibleVotes reads the eligible validators and their votes.
+func (c *Client) EligibleVotes() (EligibleVotes, error) {
+	election, _, err := c.electionAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := election.GetTotalVotesForEligibleValidators(nil)
+	if err != nil {
+		return nil, fmt.Errorf("eligible validator votes: %w", err)
+	}
+	if len(res.Validators) != len(res.Values) {
+		return nil, fmt.Errorf("eligible validator votes: %d validators but %d values", len(res.Validators), len(res.Values))
+	}
+	eligible := make(EligibleVotes, len(res.Validators))
+	for i, v := range res.Validators {
+		eligible[i] = ValidatorVotes{Validator: v, Votes: res.Values[i]}
+	}
+	return eligible, nil
+}
+
+// neighbours returns the validators just below and above validator in the
+// vote ordered list once its votes change by delta, zero where it would be
+// the tail or the head. Both are zero for a validator that is not
+// eligible, which Election then leaves out of the list.
+func (e EligibleVotes) neighbours(validator common.Address, delta *big.Int) (lesser, greater common.Address) {
+	var entries []ValidatorVotes
+	found := false
+	for _, v := range e {
+		if v.Validator == validator {
+			v.Votes = new(big.Int).Add(v.Votes, delta)
+			found = true
+		}
+		entries = append(entries, v)
+	}
+	if !found {
+		return common.Address{}, common.Address{}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Votes.Cmp(entries[j].Votes) > 0 })
+	for i, v := range entries {
+		if v.Validator != validator {
+			continue
+		}
+		if i > 0 {
+			greater = entries[i-1].Validator
+		}
+		if i+1 < len(entries) {
+			lesser = entries[i+1].Validator
+		}
+	}
+	return lesser, greater
+}
+
+// VoteSplit reads the nonvoting locked gold of account and its pending and
+// active votes for every validator it votes for.
+func (c *Client) VoteSplit(account common.Address) (*VoteSplit, error) {
+	election, _, err := c.electionAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &VoteSplit{Account: account}
+	if s.Nonvoting, err = c.GetAccountNonvotingLockedGold(account, nil); err != nil {
+		return nil, fmt.Errorf("nonvoting locked gold of %s: %w", account.Hex(), err)
+	}
+	validators, err := election.GetValidatorsVotedForByAccount(nil, account)
+	if err != nil {
+		return nil, fmt.Errorf("validators voted for by %s: %w", account.Hex(), err)
+	}
+	for _, v := range validators {
+		vote := ElectionVote{Validator: v}
+		if vote.Pending, err = election.GetPendingVotesForValidatorByAccount(nil, v, account); err != nil {
+			return nil, fmt.Errorf("pending votes for %s: %w", v.Hex(), err)
+		}
+		if vote.Active, err = election.GetActiveVotesForValidatorByAccount(nil, v, account); err != nil {
+			return nil, fmt.Errorf("active votes for %s: %w", v.Hex(), err)
+		}
+		if _, vote.PendingEpoch, err = election.PendingInfo(nil, account, v); err != nil {
+			return nil, fmt.Errorf("pending info for %s: %w", v.Hex(), err)
+		}
+		if vote.Activatable, err = election.HasActivatablePendingVotes(nil, account, v); err != nil {
+			return nil, fmt.Errorf("activatable votes for %s: %w", v.Hex(), err)
+		}
+		s.Votes = append(s.Votes, vote)
+	}
+	return s, nil
+}
+
+// vote returns the votes of the split for validator and its index among
+// the validators voted for, or nil if the account does not vote for it.
+func (s *VoteSplit) vote(validator common.Address) (*ElectionVote, *big.Int) {
+	for i := range s.Votes {
+		if s.Votes[i].Validator == validator {
+			return &s.Votes[i], big.NewInt(int64(i))
+		}
+	}
+	return nil, nil
+}
+
+// VoteValidator casts value of the signer's nonvoting locked gold as
+// pending votes for validator. It refuses if the validator is not eligible
+// or cannot take the votes, the signer lacks the nonvoting gold, or it
+// already votes for as many validators as allowed.
+func (c *Client) VoteValidator(signer Signer, validator common.Address, value *big.Int) (common.Hash, error) {
+	election, _, err := c.electionAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if value.Sign() <= 0 {
+		return common.Hash{}, fmt.Errorf("vote value must be positive")
+	}
+	eligible, err := election.GetValidatorEligibility(nil, validator)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("eligibility of %s: %w", validator.Hex(), err)
+	}
+	if !eligible {
+		return common.Hash{}, fmt.Errorf("validator %s is not eligible", validator.Hex())
+	}
+	s, err := c.VoteSplit(signer.Address())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if s.Nonvoting.Cmp(value) < 0 {
+		return common.Hash{}, fmt.Errorf("vote of %v exceeds the nonvoting locked gold %v of %s", value, s.Nonvoting, signer.Address().Hex())
+	}
+	if vote, _ := s.vote(validator); vote == nil {
+		max, err := election.MaxNumValidatorsVotedFor(nil)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("max validators voted for: %w", err)
+		}
+		if big.NewInt(int64(len(s.Votes))).Cmp(max) >= 0 {
+			return common.Hash{}, fmt.Errorf("%s already votes for %d validators, the most allowed", signer.Address().Hex(), len(s.Votes))
+		}
+	}
+	ok, err := election.CanReceiveVotes(nil, validator, value)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("can %s receive votes: %w", validator.Hex(), err)
+	}
+	if !ok {
+		receivable, err := election.GetNumVotesReceivable(nil)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("votes receivable: %w", err)
+		}
+		total, err := election.GetTotalVotesForValidator(nil, validator)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("votes for %s: %w", validator.Hex(), err)
+		}
+		return common.Hash{}, fmt.Errorf("validator %s holds %v votes and cannot receive %v more, the limit is %v",
+			validator.Hex(), total, value, receivable)
+	}
+	eligibleVotes, err := c.EligibleVotes()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	lesser, greater := eligibleVotes.neighbours(validator, value)
+	return c.sendElection(signer, func(e *contracts.Election) (*types.Transaction, error) {
+		return e.Vote(packOpts, validator, value, lesser, greater)
+	})
+}
+
+// ActivateVotes activates the pending votes of account for validator. The
+// signer activates for itself when account is the zero address.
+func (c *Client) ActivateVotes(signer Signer, validator, account common.Address) (common.Hash, error) {
+	election, _, err := c.electionAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	owner := account
+	if owner == (common.Address{}) {
+		owner = signer.Address()
+	}
+	ok, err := election.HasActivatablePendingVotes(nil, owner, validator)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("activatable votes for %s: %w", validator.Hex(), err)
+	}
+	if !ok {
+		return common.Hash{}, fmt.Errorf("%s has no pending votes for %s that can be activated yet, votes cast this epoch activate in the next", owner.Hex(), validator.Hex())
+	}
+	return c.sendElection(signer, func(e *contracts.Election) (*types.Transaction, error) {
+		if account == (common.Address{}) {
+			return e.Activate(packOpts, validator)
+		}
+		return e.ActivateForAccount(packOpts, validator, account)
+	})
+}
+
+// ActivateAllPendingVotes activates the signer's pending votes for every
+// validator where they can be, and returns those validators.
+func (c *Client) ActivateAllPendingVotes(signer Signer) (common.Hash, []common.Address, error) {
+	s, err := c.VoteSplit(signer.Address())
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+	var validators []common.Address
+	for _, v := range s.Votes {
+		if v.Activatable {
+			validators = append(validators, v.Validator)
+		}
+	}
+	if len(validators) == 0 {
+		return common.Hash{}, nil, fmt.Errorf("%s has no pending votes that can be activated yet", signer.Address().Hex())
+	}
+	hash, err := c.sendElection(signer, func(e *contracts.Election) (*types.Transaction, error) {
+		return e.ActiveAllPending(packOpts, validators)
+	})
+	return hash, validators, err
+}
+
+// RevokePendingVotes revokes value of the signer's pending votes for
+// validator.
+func (c *Client) RevokePendingVotes(signer Signer, validator common.Address, value *big.Int) (common.Hash, error) {
+	return c.revoke(signer, validator, value, func(v *ElectionVote) *big.Int { return v.Pending }, "pending",
+		func(e *contracts.Election, lesser, greater common.Address, index *big.Int) (*types.Transaction, error) {
+			return e.RevokePending(packOpts, validator, value, lesser, greater, index)
+		})
+}
+
+// RevokeActiveVotes revokes value of the signer's active votes for
+// validator, or all of them when value is nil.
+func (c *Client) RevokeActiveVotes(signer Signer, validator common.Address, value *big.Int) (common.Hash, error) {
+	return c.revoke(signer, validator, value, func(v *ElectionVote) *big.Int { return v.Active }, "active",
+		func(e *contracts.Election, lesser, greater common.Address, index *big.Int) (*types.Transaction, error) {
+			if value == nil {
+				return e.RevokeAllActive(packOpts, validator, lesser, greater, index)
+			}
+			return e.RevokeActive(packOpts, validator, value, lesser, greater, index)
+		})
+}
+
+// revoke checks that the signer holds value votes of the kind held returns
+// for validator, all of them if value is nil, and sends the revocation tx
+// builds with the validator's neighbours and index.
+func (c *Client) revoke(signer Signer, validator common.Address, value *big.Int, held func(*ElectionVote) *big.Int, kind string,
+	tx func(e *contracts.Election, lesser, greater common.Address, index *big.Int) (*types.Transaction, error)) (common.Hash, error) {
+	s, err := c.VoteSplit(signer.Address())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	vote, index := s.vote(validator)
+	if vote == nil {
+		return common.Hash{}, fmt.Errorf("%s does not vote for %s", signer.Address().Hex(), validator.Hex())
+	}
+	have := held(vote)
+	amount := value
+	if amount == nil {
+		amount = have
+	}
+	switch {
+	case amount.Sign() <= 0:
+		return common.Hash{}, fmt.Errorf("%s has no %s votes for %s to revoke", signer.Address().Hex(), kind, validator.Hex())
+	case amount.Cmp(have) > 0:
+		return common.Hash{}, fmt.Errorf("cannot revoke %v, %s has %v %s votes for %s", amount, signer.Address().Hex(), have, kind, validator.Hex())
+	}
+	eligible, err := c.EligibleVotes()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	lesser, greater := eligible.neighbours(validator, new(big.Int).Neg(amount))
+	return c.sendElection(signer, func(e *contracts.Election) (*types.Transaction, error) {
+		return tx(e, lesser, greater, index)
+	})
+}
+
+// sendElection sends the Election call built by tx.
+func (c *Client) sendElection(signer Signer, tx func(*contracts.Election) (*types.Transaction, error)) (common.Hash, error) {
+	election, to, err := c.electionAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	input, err := pack(tx(election))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, to, nil, input, 0)
+}
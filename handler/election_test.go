@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+func TestElectionVoting(t *testing.T) {
+	a, b, v := common.HexToAddress("0xa"), common.HexToAddress("0xb"), common.HexToAddress("0xc")
+	ineligible := common.HexToAddress("0xd")
+	var (
+		votedFor    = []common.Address{a, v}
+		canReceive  = true
+		maxVotedFor = int64(3)
+	)
+	node := registryNode(t, map[string]common.Address{
+		ElectionID:   GenesisAddresses["ElectionProxy"],
+		LockedGoldID: GenesisAddresses["LockedGoldProxy"],
+	})
+	election, _ := contracts.ElectionMetaData.GetAbi()
+	node.serve(t, GenesisAddresses["ElectionProxy"], election, map[string]contractMethod{
+		"getTotalVotesForEligibleValidators": returns([]common.Address{a, b, v},
+			[]*big.Int{big.NewInt(300), big.NewInt(200), big.NewInt(100)}),
+		"getValidatorEligibility": func(args []interface{}) ([]interface{}, error) {
+			return []interface{}{args[0].(common.Address) != ineligible}, nil
+		},
+		"getValidatorsVotedForByAccount": func([]interface{}) ([]interface{}, error) {
+			return []interface{}{votedFor}, nil
+		},
+		"getPendingVotesForValidatorByAccount": func(args []interface{}) ([]interface{}, error) {
+			if args[0].(common.Address) == v {
+				return []interface{}{big.NewInt(20)}, nil
+			}
+			return []interface{}{new(big.Int)}, nil
+		},
+		"getActiveVotesForValidatorByAccount": func(args []interface{}) ([]interface{}, error) {
+			if args[0].(common.Address) == a {
+				return []interface{}{big.NewInt(50)}, nil
+			}
+			return []interface{}{new(big.Int)}, nil
+		},
+		"pendingInfo": func(args []interface{}) ([]interface{}, error) {
+			if args[1].(common.Address) == v {
+				return []interface{}{big.NewInt(20), big.NewInt(4)}, nil
+			}
+			return []interface{}{new(big.Int), new(big.Int)}, nil
+		},
+		"getTotalVotesByAccount": returns(big.NewInt(70)),
+		"getEpochNumber":         returns(big.NewInt(5)),
+		"hasActivatablePendingVotes": func(args []interface{}) ([]interface{}, error) {
+			return []interface{}{args[1].(common.Address) == v}, nil
+		},
+		"maxNumValidatorsVotedFor": func([]interface{}) ([]interface{}, error) {
+			return []interface{}{big.NewInt(maxVotedFor)}, nil
+		},
+		"canReceiveVotes": func([]interface{}) ([]interface{}, error) {
+			return []interface{}{canReceive}, nil
+		},
+		"getNumVotesReceivable":     returns(big.NewInt(400)),
+		"getTotalVotesForValidator": returns(big.NewInt(300)),
+	})
+	lockedGold, _ := contracts.LockedGoldMetaData.GetAbi()
+	node.serve(t, GenesisAddresses["LockedGoldProxy"], lockedGold, map[string]contractMethod{
+		"getAccountNonvotingLockedGold": returns(big.NewInt(200)),
+		"getAccountTotalLockedGold":     returns(big.NewInt(280)),
+	})
+	node.gasPrice = big.NewInt(1)
+	node.nonce = func(common.Address) uint64 { return 0 }
+	cli := newFakeClient(t, node)
+	signer := newTestSigner(t)
+	sentArgs := func(method string) []interface{} {
+		t.Helper()
+		data := node.sent[len(node.sent)-1].Data()
+		if string(data[:4]) != string(election.Methods[method].ID) {
+			t.Fatalf("have selector %x, want %s", data[:4], method)
+		}
+		args, err := election.Methods[method].Inputs.Unpack(data[4:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return args
+	}
+
+	split, err := cli.VoteSplit(signer.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if split.Nonvoting.Int64() != 200 || len(split.Votes) != 2 || split.Votes[0].Active.Int64() != 50 ||
+		split.Votes[1].Pending.Int64() != 20 || !split.Votes[1].Activatable {
+		t.Fatalf("have split %+v", split)
+	}
+
+	portfolio, err := cli.VotingPortfolio(signer.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if portfolio.Voting().Int64() != 80 || portfolio.Unaccounted().Int64() != 10 || portfolio.Epoch.Int64() != 5 ||
+		portfolio.Votes[1].PendingEpoch.Int64() != 4 {
+		t.Fatalf("have portfolio %+v", portfolio)
+	}
+
+	// v moves from 100 to 250, between a and b.
+	if _, err := cli.VoteValidator(signer, v, big.NewInt(150)); err != nil {
+		t.Fatal(err)
+	}
+	if args := sentArgs("vote"); args[0].(common.Address) != v || args[2].(common.Address) != b || args[3].(common.Address) != a {
+		t.Fatalf("have vote %v", args)
+	}
+	refused := []struct {
+		name      string
+		validator common.Address
+		value     int64
+	}{
+		{"more than the nonvoting gold", v, 201},
+		{"an ineligible validator", ineligible, 10},
+		{"a zero vote", v, 0},
+	}
+	for _, r := range refused {
+		if _, err := cli.VoteValidator(signer, r.validator, big.NewInt(r.value)); err == nil {
+			t.Errorf("expected a vote for %s to be refused", r.name)
+		}
+	}
+	canReceive = false
+	if _, err := cli.VoteValidator(signer, a, big.NewInt(150)); err == nil {
+		t.Error("expected a vote beyond the receivable votes to be refused")
+	}
+	canReceive, maxVotedFor = true, 2
+	if _, err := cli.VoteValidator(signer, b, big.NewInt(10)); err == nil {
+		t.Error("expected a vote for a third validator to be refused")
+	}
+	if _, err := cli.VoteValidator(signer, a, big.NewInt(10)); err != nil {
+		t.Errorf("a vote for a validator already voted for: %v", err)
+	}
+
+	// Revoking all 50 active votes leaves a at 250, still the head.
+	if _, err := cli.RevokeActiveVotes(signer, a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if args := sentArgs("revokeAllActive"); args[1].(common.Address) != b || args[2].(common.Address) != (common.Address{}) || args[3].(*big.Int).Int64() != 0 {
+		t.Fatalf("have revokeAllActive %v", args)
+	}
+	if _, err := cli.RevokePendingVotes(signer, v, big.NewInt(20)); err != nil {
+		t.Fatal(err)
+	}
+	if args := sentArgs("revokePending"); args[3].(common.Address) != b || args[4].(*big.Int).Int64() != 1 {
+		t.Fatalf("have revokePending %v", args)
+	}
+	if _, err := cli.RevokePendingVotes(signer, v, big.NewInt(21)); err == nil {
+		t.Error("expected revoking more than the pending votes to be refused")
+	}
+	if _, err := cli.RevokeActiveVotes(signer, b, big.NewInt(1)); err == nil {
+		t.Error("expected revoking votes for a validator not voted for to be refused")
+	}
+
+	if _, err := cli.ActivateVotes(signer, a, common.Address{}); err == nil {
+		t.Error("expected activating votes that are not activatable to be refused")
+	}
+	if _, validators, err := cli.ActivateAllPendingVotes(signer); err != nil || len(validators) != 1 || validators[0] != v {
+		t.Fatalf("have activated %v, err %v", validators, err)
+	}
+	if args := sentArgs("activeAllPending"); len(args[0].([]common.Address)) != 1 {
+		t.Fatalf("have activeAllPending %v", args)
+	}
+}
@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+// ElectionParams are the Election settings its owner can change.
+type ElectionParams struct {
+	Owner        common.Address
+	MinElectable *big.Int
+	MaxElectable *big.Int
+	// MaxNumValidatorsVotedFor is how many validators one account may
+	// vote for.
+	MaxNumValidatorsVotedFor *big.Int
+	// ElectabilityThreshold is the fixidity fraction of the total votes a
+	// validator needs to be elected.
+	ElectabilityThreshold *big.Int
+}
+
+// ElectionParams reads all Election settings.
+func (c *Client) ElectionParams() (*ElectionParams, error) {
+	election, _, err := c.electionAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	p := new(ElectionParams)
+	if p.Owner, err = election.Owner(nil); err != nil {
+		return nil, fmt.Errorf("owner: %w", err)
+	}
+	if p.MinElectable, p.MaxElectable, err = c.GetElectableValidators(); err != nil {
+		return nil, fmt.Errorf("electable validators: %w", err)
+	}
+	if p.MaxNumValidatorsVotedFor, err = election.MaxNumValidatorsVotedFor(nil); err != nil {
+		return nil, fmt.Errorf("max validators voted for: %w", err)
+	}
+	if p.ElectabilityThreshold, err = election.GetElectabilityThreshold(nil); err != nil {
+		return nil, fmt.Errorf("electability threshold: %w", err)
+	}
+	return p, nil
+}
+
+// SetMaxNumValidatorsVotedFor sets how many validators one account may
+// vote for.
+func (c *Client) SetMaxNumValidatorsVotedFor(signer Signer, n *big.Int) (common.Hash, error) {
+	election, _, err := c.electionAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := electionOwner(election, signer); err != nil {
+		return common.Hash{}, err
+	}
+	if n.Sign() <= 0 {
+		return common.Hash{}, fmt.Errorf("max validators voted for must be positive")
+	}
+	current, err := election.MaxNumValidatorsVotedFor(nil)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("max validators voted for: %w", err)
+	}
+	if current.Cmp(n) == 0 {
+		return common.Hash{}, fmt.Errorf("max validators voted for is already %v", n)
+	}
+	return c.sendElection(signer, func(e *contracts.Election) (*types.Transaction, error) {
+		return e.SetMaxNumValidatorsVotedFor(packOpts, n)
+	})
+}
+
+// SetElectabilityThreshold sets the fixidity fraction of the total votes
+// a validator needs to be elected, which must be below one.
+func (c *Client) SetElectabilityThreshold(signer Signer, threshold *big.Int) (common.Hash, error) {
+	election, _, err := c.electionAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := electionOwner(election, signer); err != nil {
+		return common.Hash{}, err
+	}
+	if threshold.Cmp(fixed1) >= 0 {
+		return common.Hash{}, fmt.Errorf("electability threshold %s must be below 100%%", FormatPercent(threshold))
+	}
+	return c.sendElection(signer, func(e *contracts.Election) (*types.Transaction, error) {
+		return e.SetElectabilityThreshold(packOpts, threshold)
+	})
+}
+
+// MarkValidatorEligible adds validator to the eligible validators, placed
+// among them by its current votes.
+func (c *Client) MarkValidatorEligible(signer Signer, validator common.Address) (common.Hash, error) {
+	election, _, err := c.electionAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := electionOwner(election, signer); err != nil {
+		return common.Hash{}, err
+	}
+	eligible, err := election.GetValidatorEligibility(nil, validator)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("eligibility of %s: %w", validator.Hex(), err)
+	}
+	if eligible {
+		return common.Hash{}, fmt.Errorf("validator %s is already eligible", validator.Hex())
+	}
+	votes, err := election.GetTotalVotesForValidator(nil, validator)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("votes for %s: %w", validator.Hex(), err)
+	}
+	eligibleVotes, err := c.EligibleVotes()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	lesser, greater := append(eligibleVotes, ValidatorVotes{validator, votes}).neighbours(validator, new(big.Int))
+	return c.sendElection(signer, func(e *contracts.Election) (*types.Transaction, error) {
+		return e.MarkValidatorEligible(packOpts, lesser, greater, validator)
+	})
+}
+
+// MarkValidatorIneligible removes validator from the eligible validators.
+func (c *Client) MarkValidatorIneligible(signer Signer, validator common.Address) (common.Hash, error) {
+	election, _, err := c.electionAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := electionOwner(election, signer); err != nil {
+		return common.Hash{}, err
+	}
+	eligible, err := election.GetValidatorEligibility(nil, validator)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("eligibility of %s: %w", validator.Hex(), err)
+	}
+	if !eligible {
+		return common.Hash{}, fmt.Errorf("validator %s is not eligible", validator.Hex())
+	}
+	return c.sendElection(signer, func(e *contracts.Election) (*types.Transaction, error) {
+		return e.MarkValidatorIneligible(packOpts, validator)
+	})
+}
+
+// electionOwner refuses a signer that is not the owner of Election.
+func electionOwner(election *contracts.Election, signer Signer) error {
+	owner, err := election.Owner(nil)
+	if err != nil {
+		return fmt.Errorf("election owner: %w", err)
+	}
+	if owner != signer.Address() {
+		return fmt.Errorf("only the Election owner %s can do this, not %s", owner.Hex(), signer.Address().Hex())
+	}
+	return nil
+}
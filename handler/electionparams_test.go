@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+func TestElectionParamsAndSetters(t *testing.T) {
+	a, b, v := common.HexToAddress("0xa"), common.HexToAddress("0xb"), common.HexToAddress("0xc")
+	owner := newTestSigner(t)
+	node := registryNode(t, map[string]common.Address{ElectionID: GenesisAddresses["ElectionProxy"]})
+	election, _ := contracts.ElectionMetaData.GetAbi()
+	node.serve(t, GenesisAddresses["ElectionProxy"], election, map[string]contractMethod{
+		"owner":                    returns(owner.Address()),
+		"electableValidators":      returns(big.NewInt(1), big.NewInt(100)),
+		"maxNumValidatorsVotedFor": returns(big.NewInt(10)),
+		"getElectabilityThreshold": returns(fixidity("0.001")),
+		"getValidatorEligibility": func(args []interface{}) ([]interface{}, error) {
+			return []interface{}{args[0].(common.Address) != v}, nil
+		},
+		"getTotalVotesForValidator": returns(big.NewInt(250)),
+		"getTotalVotesForEligibleValidators": returns([]common.Address{a, b},
+			[]*big.Int{big.NewInt(300), big.NewInt(200)}),
+	})
+	node.gasPrice = big.NewInt(1)
+	node.nonce = func(common.Address) uint64 { return 0 }
+	cli := newFakeClient(t, node)
+
+	p, err := cli.ElectionParams()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Owner != owner.Address() || p.MinElectable.Int64() != 1 || p.MaxElectable.Int64() != 100 ||
+		p.MaxNumValidatorsVotedFor.Int64() != 10 || FormatPercent(p.ElectabilityThreshold) != "0.1%" {
+		t.Fatalf("have params %+v", p)
+	}
+
+	if _, err := cli.MarkValidatorEligible(owner, v); err != nil {
+		t.Fatal(err)
+	}
+	want, _ := election.Pack("markValidatorEligible", b, a, v)
+	if data := node.sent[len(node.sent)-1].Data(); string(data) != string(want) {
+		t.Fatalf("have markValidatorEligible %x, want %x", data, want)
+	}
+	if _, err := cli.MarkValidatorIneligible(owner, a); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.SetElectabilityThreshold(owner, fixidity("0.05")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.SetMaxNumValidatorsVotedFor(owner, big.NewInt(20)); err != nil {
+		t.Fatal(err)
+	}
+
+	stranger := newTestSigner(t)
+	refused := map[string]func() error{
+		"set-electable by a stranger": func() error {
+			_, err := cli.SetElectableValidators(stranger, big.NewInt(1), big.NewInt(50))
+			return err
+		},
+		"max voted for by a stranger": func() error {
+			_, err := cli.SetMaxNumValidatorsVotedFor(stranger, big.NewInt(20))
+			return err
+		},
+		"unchanged max voted for": func() error {
+			_, err := cli.SetMaxNumValidatorsVotedFor(owner, big.NewInt(10))
+			return err
+		},
+		"threshold of 100%": func() error {
+			_, err := cli.SetElectabilityThreshold(owner, fixidity("1"))
+			return err
+		},
+		"eligible validator marked eligible": func() error {
+			_, err := cli.MarkValidatorEligible(owner, a)
+			return err
+		},
+		"ineligible validator marked ineligible": func() error {
+			_, err := cli.MarkValidatorIneligible(owner, v)
+			return err
+		},
+		"mark ineligible by a stranger": func() error {
+			_, err := cli.MarkValidatorIneligible(stranger, a)
+			return err
+		},
+	}
+	sent := len(node.sent)
+	for name, f := range refused {
+		if err := f(); err == nil {
+			t.Errorf("expected %s to be refused", name)
+		}
+	}
+	if len(node.sent) != sent {
+		t.Errorf("sent %d transactions that should have been refused", len(node.sent)-sent)
+	}
+}
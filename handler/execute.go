@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ProposalStatus is where a dequeued proposal stands on its way to
+// execution.
+type ProposalStatus struct {
+	ID       *big.Int
+	Index    *big.Int
+	Stage    ProposalStage
+	Approved bool
+	Passing  bool
+	Votes    *VoteTotals
+
+	// The approval stage starts when the proposal is dequeued; each later
+	// stage starts when the one before it ends.
+	ApprovalEnd   time.Time
+	ReferendumEnd time.Time
+	ExecutionEnd  time.Time
+	// Now is the timestamp of the latest block.
+	Now time.Time
+}
+
+// ProposalStatus reads the stage, approval and referendum outcome of
+// dequeued proposal id.
+func (c *Client) ProposalStatus(id *big.Int) (*ProposalStatus, error) {
+	governance, _, err := c.governanceAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	index, err := dequeueIndex(governance, id)
+	if err != nil {
+		return nil, err
+	}
+	s := &ProposalStatus{ID: id, Index: index}
+	if s.Stage, err = stageOf(governance, nil, id); err != nil {
+		return nil, err
+	}
+	if s.Approved, err = governance.IsApproved(nil, id); err != nil {
+		return nil, fmt.Errorf("proposal %v approval: %w", id, err)
+	}
+	if s.Passing, err = governance.IsProposalPassing(nil, id); err != nil {
+		return nil, fmt.Errorf("proposal %v passing: %w", id, err)
+	}
+	if s.Votes, err = c.VoteTotals(id); err != nil {
+		return nil, err
+	}
+	_, _, timestamp, _, _, err := governance.GetProposal(nil, id)
+	if err != nil {
+		return nil, fmt.Errorf("proposal %v: %w", id, err)
+	}
+	durations, err := governance.StageDurations(nil)
+	if err != nil {
+		return nil, fmt.Errorf("stage durations: %w", err)
+	}
+	if s.Now, err = c.headTime(context.Background()); err != nil {
+		return nil, err
+	}
+	s.ApprovalEnd = time.Unix(timestamp.Int64(), 0).Add(seconds(durations.Approval))
+	s.ReferendumEnd = s.ApprovalEnd.Add(seconds(durations.Referendum))
+	s.ExecutionEnd = s.ReferendumEnd.Add(seconds(durations.Execution))
+	return s, nil
+}
+
+// Approve approves dequeued proposal id. The signer must be the approver
+// and the proposal must be in its approval stage.
+func (c *Client) Approve(signer Signer, id *big.Int) (common.Hash, error) {
+	governance, address, err := c.governanceAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	approver, err := governance.Approver(nil)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("approver: %w", err)
+	}
+	if approver != signer.Address() {
+		return common.Hash{}, fmt.Errorf("only the approver %s can approve, not %s", approver.Hex(), signer.Address().Hex())
+	}
+	s, err := c.ProposalStatus(id)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	switch {
+	case s.Approved:
+		return common.Hash{}, fmt.Errorf("proposal %v is already approved", id)
+	case s.Stage != StageApproval:
+		return common.Hash{}, fmt.Errorf("proposal %v is in stage %v, approval ended at %v", id, s.Stage, s.ApprovalEnd.UTC())
+	}
+	input, err := pack(governance.Approve(packOpts, id, s.Index))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, address, nil, input, 0)
+}
+
+// Execute executes dequeued proposal id. It refuses unless the proposal is
+// in its execution window, approved and passing.
+func (c *Client) Execute(signer Signer, id *big.Int) (common.Hash, error) {
+	governance, address, err := c.governanceAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	s, err := c.ProposalStatus(id)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := s.executable(); err != nil {
+		return common.Hash{}, err
+	}
+	input, err := pack(governance.Execute(packOpts, id, s.Index))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, address, nil, input, 0)
+}
+
+// executable explains why the proposal cannot be executed, nil if it can.
+func (s *ProposalStatus) executable() error {
+	switch s.Stage {
+	case StageApproval:
+		return fmt.Errorf("proposal %v is awaiting approval until %v, its referendum ends at %v", s.ID, s.ApprovalEnd.UTC(), s.ReferendumEnd.UTC())
+	case StageReferendum:
+		return fmt.Errorf("proposal %v is in referendum until %v (%v left)", s.ID, s.ReferendumEnd.UTC(), s.ReferendumEnd.Sub(s.Now))
+	case StageExpiration:
+		return fmt.Errorf("proposal %v expired, its execution window closed at %v", s.ID, s.ExecutionEnd.UTC())
+	case StageExecution:
+	default:
+		return fmt.Errorf("proposal %v is in stage %v", s.ID, s.Stage)
+	}
+	if !s.Approved {
+		return fmt.Errorf("proposal %v was not approved", s.ID)
+	}
+	if !s.Passing {
+		return fmt.Errorf("proposal %v did not pass: yes %v, no %v, abstain %v", s.ID, s.Votes.Yes, s.Votes.No, s.Votes.Abstain)
+	}
+	if !s.Now.Before(s.ExecutionEnd) {
+		return fmt.Errorf("proposal %v execution window closed at %v", s.ID, s.ExecutionEnd.UTC())
+	}
+	return nil
+}
+
+func seconds(n *big.Int) time.Duration {
+	return time.Duration(n.Int64()) * time.Second
+}
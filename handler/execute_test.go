@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestApproveAndExecute(t *testing.T) {
+	signer := newTestSigner(t)
+	var (
+		stage    = StageApproval
+		approved = false
+		passing  = false
+	)
+	node := governanceNode(t, map[string]contractMethod{
+		"approver":   returns(signer.Address()),
+		"getDequeue": returns([]*big.Int{big.NewInt(3), big.NewInt(5)}),
+		"getProposal": returns(common.HexToAddress("0x01"), big.NewInt(100), big.NewInt(1000),
+			big.NewInt(1), "https://example.org/5"),
+		"stageDurations": returns(big.NewInt(100), big.NewInt(200), big.NewInt(300)),
+		"getProposalStage": func([]interface{}) ([]interface{}, error) {
+			return []interface{}{uint8(stage)}, nil
+		},
+		"isApproved": func([]interface{}) ([]interface{}, error) {
+			return []interface{}{approved}, nil
+		},
+		"isProposalPassing": func([]interface{}) ([]interface{}, error) {
+			return []interface{}{passing}, nil
+		},
+		"getVoteTotals": returns(big.NewInt(10), big.NewInt(20), big.NewInt(0)),
+	})
+	node.time = 1050
+	node.gasPrice = big.NewInt(1)
+	node.nonce = func(common.Address) uint64 { return 0 }
+	cli := newFakeClient(t, node)
+
+	s, err := cli.ProposalStatus(big.NewInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Index.Int64() != 1 || s.ApprovalEnd != time.Unix(1100, 0) || s.ReferendumEnd != time.Unix(1300, 0) ||
+		s.ExecutionEnd != time.Unix(1600, 0) || s.Votes.No.Int64() != 20 {
+		t.Fatalf("have status %+v", s)
+	}
+
+	if _, err := cli.Approve(newTestSigner(t), big.NewInt(5)); err == nil || !strings.Contains(err.Error(), "approver") {
+		t.Fatalf("expected only the approver to approve, have %v", err)
+	}
+	if _, err := cli.Approve(signer, big.NewInt(5)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.Approve(signer, big.NewInt(4)); err == nil {
+		t.Fatal("expected approving a proposal that is not dequeued to fail")
+	}
+
+	approved = true
+	for _, tt := range []struct {
+		stage   ProposalStage
+		passing bool
+		time    uint64
+		reason  string
+	}{
+		{StageApproval, true, 1050, "awaiting approval"},
+		{StageReferendum, true, 1200, "in referendum"},
+		{StageExecution, false, 1400, "did not pass"},
+		{StageExpiration, true, 1700, "expired"},
+	} {
+		stage, passing, node.time = tt.stage, tt.passing, tt.time
+		if _, err := cli.Execute(signer, big.NewInt(5)); err == nil || !strings.Contains(err.Error(), tt.reason) {
+			t.Errorf("stage %v: have %v, want %q", tt.stage, err, tt.reason)
+		}
+	}
+	approved, stage, passing, node.time = false, StageExecution, true, 1400
+	if _, err := cli.Execute(signer, big.NewInt(5)); err == nil || !strings.Contains(err.Error(), "not approved") {
+		t.Fatalf("have %v, want a missing approval", err)
+	}
+
+	approved = true
+	if _, err := cli.Execute(signer, big.NewInt(5)); err != nil {
+		t.Fatal(err)
+	}
+	if len(node.sent) != 2 {
+		t.Fatalf("have %d transactions, want approve and execute", len(node.sent))
+	}
+}
@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FeeMode selects the transaction type used for outgoing transactions.
+type FeeMode int
+
+const (
+	// FeeModeAuto sends dynamic fee transactions when the latest block
+	// carries a base fee and legacy transactions otherwise.
+	FeeModeAuto FeeMode = iota
+	// FeeModeLegacy always sends gas price transactions.
+	FeeModeLegacy
+	// FeeModeDynamic always sends EIP-1559 transactions.
+	FeeModeDynamic
+)
+
+var feeModeNames = []string{"auto", "legacy", "dynamic"}
+
+func (m FeeMode) String() string {
+	if int(m) < len(feeModeNames) {
+		return feeModeNames[m]
+	}
+	return fmt.Sprintf("FeeMode(%d)", int(m))
+}
+
+// ParseFeeMode parses auto, legacy or dynamic.
+func ParseFeeMode(s string) (FeeMode, error) {
+	for i, name := range feeModeNames {
+		if strings.EqualFold(s, name) {
+			return FeeMode(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown fee mode %q, want one of %s", s, strings.Join(feeModeNames, ", "))
+}
+
+// DefaultGasMultiplier is the safety margin applied to gas estimates.
+const DefaultGasMultiplier = 1.2
+
+// FeePolicy decides the gas limit and fee fields of every transaction a
+// Client sends. Nil prices are taken from the node's suggestions.
+type FeePolicy struct {
+	Mode FeeMode
+
+	// GasLimit fixes the gas limit; zero means estimate it and apply
+	// GasMultiplier to the estimate.
+	GasLimit      uint64
+	GasMultiplier float64
+
+	// GasPrice is the price of legacy transactions.
+	GasPrice *big.Int
+	// GasTipCap is the priority fee of dynamic fee transactions.
+	GasTipCap *big.Int
+	// MaxFee caps the legacy gas price and the dynamic fee cap. Suggested
+	// prices above it are lowered to it, explicit ones are rejected.
+	MaxFee *big.Int
+}
+
+// DefaultFeePolicy estimates gas with DefaultGasMultiplier and uses the
+// node's suggested prices without a cap.
+func DefaultFeePolicy() FeePolicy {
+	return FeePolicy{Mode: FeeModeAuto, GasMultiplier: DefaultGasMultiplier}
+}
+
+// txFees are the resolved gas and fee fields of a transaction.
+type txFees struct {
+	gasLimit  uint64
+	gasPrice  *big.Int // legacy
+	gasTipCap *big.Int // dynamic
+	gasFeeCap *big.Int // dynamic
+}
+
+func (f txFees) dynamic() bool {
+	return f.gasFeeCap != nil
+}
+
+// newTx builds the transaction f describes.
+func (f txFees) newTx(chainID *big.Int, nonce uint64, to common.Address, value *big.Int, input []byte) *types.Transaction {
+	if f.dynamic() {
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     nonce,
+			GasTipCap: f.gasTipCap,
+			GasFeeCap: f.gasFeeCap,
+			Gas:       f.gasLimit,
+			To:        &to,
+			Value:     value,
+			Data:      input,
+		})
+	}
+	return types.NewTransaction(nonce, to, value, f.gasLimit, f.gasPrice, input)
+}
+
+// fees resolves the policy for msg. A non-zero gasLimitSetting overrides
+// the policy's gas limit. A call that fails to simulate is refused unless
+// the client is forced.
+func (c *Client) fees(msg ethereum.CallMsg, gasLimitSetting uint64) (txFees, error) {
+	ctx := context.Background()
+	p := c.FeePolicy()
+
+	var f txFees
+	dynamic := p.Mode == FeeModeDynamic
+	var baseFee *big.Int
+	if p.Mode != FeeModeLegacy {
+		var err error
+		if baseFee, err = c.baseFee(ctx, nil); err != nil {
+			return f, err
+		}
+		if p.Mode == FeeModeDynamic && baseFee == nil {
+			return f, fmt.Errorf("dynamic fee transactions need a base fee, the chain has none")
+		}
+		dynamic = baseFee != nil
+	}
+
+	if dynamic {
+		tip := p.GasTipCap
+		if tip == nil {
+			var err error
+			if tip, err = c.conn.SuggestGasTipCap(ctx); err != nil {
+				return f, fmt.Errorf("suggest gas tip cap: %w", err)
+			}
+		}
+		feeCap := new(big.Int).Add(tip, new(big.Int).Mul(baseFee, big.NewInt(2)))
+		if p.MaxFee != nil && feeCap.Cmp(p.MaxFee) > 0 {
+			feeCap = new(big.Int).Set(p.MaxFee)
+		}
+		if tip.Cmp(feeCap) > 0 {
+			return f, fmt.Errorf("gas tip cap %v exceeds max fee %v", tip, feeCap)
+		}
+		f.gasTipCap, f.gasFeeCap = tip, feeCap
+		msg.GasTipCap, msg.GasFeeCap = tip, feeCap
+	} else {
+		price := p.GasPrice
+		switch {
+		case price != nil && p.MaxFee != nil && price.Cmp(p.MaxFee) > 0:
+			return f, fmt.Errorf("gas price %v exceeds max fee %v", price, p.MaxFee)
+		case price == nil:
+			var err error
+			if price, err = c.conn.SuggestGasPrice(ctx); err != nil {
+				return f, fmt.Errorf("suggest gas price: %w", err)
+			}
+			if p.MaxFee != nil && price.Cmp(p.MaxFee) > 0 {
+				c.log().Warn("Suggested gas price above max fee, capping", "suggested", price, "max", p.MaxFee)
+				price = new(big.Int).Set(p.MaxFee)
+			}
+		}
+		f.gasPrice = price
+		msg.GasPrice = price
+	}
+
+	// The call is simulated even with a fixed gas limit, which then only
+	// decides the gas of the transaction.
+	fixed := gasLimitSetting
+	if fixed == 0 {
+		fixed = p.GasLimit
+	}
+	msg.Gas = fixed
+	estimate, err := c.backend.EstimateGas(ctx, msg)
+	if err != nil && !c.forced() {
+		return f, fmt.Errorf("simulation failed, not sending: %w", err)
+	}
+	switch {
+	case fixed != 0:
+		if err != nil {
+			c.log().Warn("Simulation failed, sending anyway", "gasLimit", fixed, "err", err)
+		}
+		f.gasLimit = fixed
+	case err != nil:
+		c.log().Warn("Simulation failed, sending anyway", "gasLimit", DefaultGasLimit, "err", err)
+		f.gasLimit = DefaultGasLimit
+	default:
+		multiplier := p.GasMultiplier
+		if multiplier < 1 {
+			multiplier = 1
+		}
+		f.gasLimit = uint64(float64(estimate) * multiplier)
+	}
+	return f, nil
+}
+
+// baseFee returns the base fee of block number, nil meaning the latest
+// block, or nil if the chain has none. Only that field is decoded since the
+// chain's headers do not match the go-ethereum header layout.
+func (c *Client) baseFee(ctx context.Context, number *big.Int) (*big.Int, error) {
+	block := "latest"
+	if number != nil {
+		block = hexutil.EncodeBig(number)
+	}
+	var head struct {
+		BaseFee *hexutil.Big `json:"baseFeePerGas"`
+	}
+	if err := c.rpc.CallContext(ctx, &head, "eth_getBlockByNumber", block, false); err != nil {
+		return nil, fmt.Errorf("block %s: %w", block, err)
+	}
+	return (*big.Int)(head.BaseFee), nil
+}
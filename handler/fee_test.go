@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestFeesLegacy(t *testing.T) {
+	node := &fakeNode{
+		gasPrice: big.NewInt(100),
+		estimate: func(callArgs) (uint64, error) { return 50000, nil },
+	}
+	cli := newFakeClient(t, node)
+	to := common.HexToAddress("0xd013")
+	msg := ethereum.CallMsg{To: &to}
+
+	f, err := cli.fees(msg, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.dynamic() || f.gasPrice.Int64() != 100 || f.gasLimit != 60000 {
+		t.Fatalf("have %+v, want legacy price 100 gas 60000", f)
+	}
+	if f, _ := cli.fees(msg, 70000); f.gasLimit != 70000 {
+		t.Fatalf("gas limit setting ignored: have %d", f.gasLimit)
+	}
+
+	p := DefaultFeePolicy()
+	p.GasLimit, p.MaxFee = 30000, big.NewInt(80)
+	cli.SetFeePolicy(p)
+	if f, err = cli.fees(msg, 0); err != nil {
+		t.Fatal(err)
+	}
+	if f.gasPrice.Int64() != 80 || f.gasLimit != 30000 {
+		t.Fatalf("have %+v, want capped price 80 gas 30000", f)
+	}
+
+	p.GasPrice = big.NewInt(90)
+	cli.SetFeePolicy(p)
+	if _, err := cli.fees(msg, 0); err == nil {
+		t.Fatal("expected an explicit gas price above max fee to be rejected")
+	}
+
+	p = DefaultFeePolicy()
+	p.Mode = FeeModeDynamic
+	cli.SetFeePolicy(p)
+	if _, err := cli.fees(msg, 0); err == nil {
+		t.Fatal("expected dynamic mode to fail without a base fee")
+	}
+}
+
+func TestFeesDynamic(t *testing.T) {
+	signer := newTestSigner(t)
+	node := &fakeNode{
+		nonce:     func(common.Address) uint64 { return 0 },
+		gasTipCap: big.NewInt(2),
+		baseFee:   big.NewInt(10),
+	}
+	cli := newFakeClient(t, node)
+
+	if _, err := cli.SendTransaction(signer, common.HexToAddress("0x02"), big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	tx := node.sent[0]
+	if tx.Type() != types.DynamicFeeTxType || tx.GasTipCap().Int64() != 2 || tx.GasFeeCap().Int64() != 22 {
+		t.Fatalf("have type %d tip %v cap %v, want dynamic tip 2 cap 22", tx.Type(), tx.GasTipCap(), tx.GasFeeCap())
+	}
+	if tx.Gas() != 25200 {
+		t.Fatalf("have gas %d, want 25200", tx.Gas())
+	}
+
+	p := DefaultFeePolicy()
+	p.Mode, p.MaxFee = FeeModeLegacy, big.NewInt(5)
+	node.gasPrice = big.NewInt(50)
+	cli.SetFeePolicy(p)
+	if _, err := cli.SendTransaction(signer, common.HexToAddress("0x02"), big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	if tx := node.sent[1]; tx.Type() != types.LegacyTxType || tx.GasPrice().Int64() != 5 {
+		t.Fatalf("have type %d price %v, want legacy price 5", tx.Type(), tx.GasPrice())
+	}
+}
+
+func TestParseFeeMode(t *testing.T) {
+	for _, mode := range []FeeMode{FeeModeAuto, FeeModeLegacy, FeeModeDynamic} {
+		parsed, err := ParseFeeMode(mode.String())
+		if err != nil || parsed != mode {
+			t.Fatalf("%v: have %v, %v", mode, parsed, err)
+		}
+	}
+	if _, err := ParseFeeMode("eip1559"); err == nil {
+		t.Fatal("expected an unknown mode to be rejected")
+	}
+}
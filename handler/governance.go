@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+// fixed1 is 1 in the 24 decimal fixed point format of FixidityLib, which
+// the participation parameters and constitution thresholds are given in.
+var fixed1 = new(big.Int).Exp(big.NewInt(10), big.NewInt(fixidityDecimals), nil)
+
+// GovernanceConfig is what the Governance contract is initialized with.
+// Durations are in seconds; the participation parameters are fixidity
+// fractions.
+type GovernanceConfig struct {
+	Registry                common.Address
+	Approver                common.Address
+	ConcurrentProposals     *big.Int
+	MinDeposit              *big.Int
+	QueueExpiry             *big.Int
+	DequeueFrequency        *big.Int
+	ReferendumStageDuration *big.Int
+	ExecutionStageDuration  *big.Int
+	ParticipationBaseline   *big.Int
+	ParticipationFloor      *big.Int
+	BaselineUpdateFactor    *big.Int
+	BaselineQuorumFactor    *big.Int
+}
+
+// GovernanceParams are the Governance settings its owner can change.
+// Durations are in seconds; the participation parameters are fixidity
+// fractions.
+type GovernanceParams struct {
+	Approver                common.Address
+	ConcurrentProposals     *big.Int
+	MinDeposit              *big.Int
+	QueueExpiry             *big.Int
+	DequeueFrequency        *big.Int
+	ApprovalStageDuration   *big.Int
+	ReferendumStageDuration *big.Int
+	ExecutionStageDuration  *big.Int
+	ParticipationBaseline   *big.Int
+	ParticipationFloor      *big.Int
+	BaselineUpdateFactor    *big.Int
+	BaselineQuorumFactor    *big.Int
+}
+
+// InitializeGovernance initializes the freshly deployed Governance contract
+// at governance. It is addressed directly since the Registry does not know
+// it yet.
+func (c *Client) InitializeGovernance(signer Signer, governance common.Address, cfg *GovernanceConfig) (common.Hash, error) {
+	g, err := contracts.NewGovernance(governance, c.backend)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	input, err := pack(g.Initialize(packOpts, cfg.Registry, cfg.Approver, cfg.ConcurrentProposals, cfg.MinDeposit,
+		cfg.QueueExpiry, cfg.DequeueFrequency, cfg.ReferendumStageDuration, cfg.ExecutionStageDuration,
+		cfg.ParticipationBaseline, cfg.ParticipationFloor, cfg.BaselineUpdateFactor, cfg.BaselineQuorumFactor))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, governance, nil, input, 0)
+}
+
+// GovernanceParams reads all Governance settings.
+func (c *Client) GovernanceParams() (*GovernanceParams, error) {
+	governance, _, err := c.governanceAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	p := new(GovernanceParams)
+	if p.Approver, err = governance.Approver(nil); err != nil {
+		return nil, fmt.Errorf("approver: %w", err)
+	}
+	if p.ConcurrentProposals, err = governance.ConcurrentProposals(nil); err != nil {
+		return nil, fmt.Errorf("concurrent proposals: %w", err)
+	}
+	if p.MinDeposit, err = governance.MinDeposit(nil); err != nil {
+		return nil, fmt.Errorf("min deposit: %w", err)
+	}
+	if p.QueueExpiry, err = governance.QueueExpiry(nil); err != nil {
+		return nil, fmt.Errorf("queue expiry: %w", err)
+	}
+	if p.DequeueFrequency, err = governance.DequeueFrequency(nil); err != nil {
+		return nil, fmt.Errorf("dequeue frequency: %w", err)
+	}
+	durations, err := governance.StageDurations(nil)
+	if err != nil {
+		return nil, fmt.Errorf("stage durations: %w", err)
+	}
+	p.ApprovalStageDuration = durations.Approval
+	p.ReferendumStageDuration = durations.Referendum
+	p.ExecutionStageDuration = durations.Execution
+	if p.ParticipationBaseline, p.ParticipationFloor, p.BaselineUpdateFactor, p.BaselineQuorumFactor, err = governance.GetParticipationParameters(nil); err != nil {
+		return nil, fmt.Errorf("participation parameters: %w", err)
+	}
+	return p, nil
+}
+
+// GetConstitution returns the fraction of yes votes a proposal calling
+// functionID on destination needs to pass.
+func (c *Client) GetConstitution(destination common.Address, functionID [4]byte) (*big.Int, error) {
+	governance, _, err := c.governanceAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	return governance.GetConstitution(nil, destination, functionID)
+}
+
+func (c *Client) SetApprover(signer Signer, approver common.Address) (common.Hash, error) {
+	return c.sendGovernance(signer, func(g *contracts.Governance) (*types.Transaction, error) {
+		return g.SetApprover(packOpts, approver)
+	})
+}
+
+func (c *Client) SetConcurrentProposals(signer Signer, n *big.Int) (common.Hash, error) {
+	return c.sendGovernance(signer, func(g *contracts.Governance) (*types.Transaction, error) {
+		return g.SetConcurrentProposals(packOpts, n)
+	})
+}
+
+func (c *Client) SetMinDeposit(signer Signer, deposit *big.Int) (common.Hash, error) {
+	return c.sendGovernance(signer, func(g *contracts.Governance) (*types.Transaction, error) {
+		return g.SetMinDeposit(packOpts, deposit)
+	})
+}
+
+func (c *Client) SetQueueExpiry(signer Signer, seconds *big.Int) (common.Hash, error) {
+	return c.sendGovernance(signer, func(g *contracts.Governance) (*types.Transaction, error) {
+		return g.SetQueueExpiry(packOpts, seconds)
+	})
+}
+
+func (c *Client) SetDequeueFrequency(signer Signer, seconds *big.Int) (common.Hash, error) {
+	return c.sendGovernance(signer, func(g *contracts.Governance) (*types.Transaction, error) {
+		return g.SetDequeueFrequency(packOpts, seconds)
+	})
+}
+
+func (c *Client) SetReferendumStageDuration(signer Signer, seconds *big.Int) (common.Hash, error) {
+	return c.sendGovernance(signer, func(g *contracts.Governance) (*types.Transaction, error) {
+		return g.SetReferendumStageDuration(packOpts, seconds)
+	})
+}
+
+func (c *Client) SetExecutionStageDuration(signer Signer, seconds *big.Int) (common.Hash, error) {
+	return c.sendGovernance(signer, func(g *contracts.Governance) (*types.Transaction, error) {
+		return g.SetExecutionStageDuration(packOpts, seconds)
+	})
+}
+
+func (c *Client) SetParticipationBaseline(signer Signer, fraction *big.Int) (common.Hash, error) {
+	return c.sendGovernance(signer, func(g *contracts.Governance) (*types.Transaction, error) {
+		return g.SetParticipationBaseline(packOpts, fraction)
+	})
+}
+
+func (c *Client) SetParticipationFloor(signer Signer, fraction *big.Int) (common.Hash, error) {
+	return c.sendGovernance(signer, func(g *contracts.Governance) (*types.Transaction, error) {
+		return g.SetParticipationFloor(packOpts, fraction)
+	})
+}
+
+func (c *Client) SetBaselineUpdateFactor(signer Signer, fraction *big.Int) (common.Hash, error) {
+	return c.sendGovernance(signer, func(g *contracts.Governance) (*types.Transaction, error) {
+		return g.SetBaselineUpdateFactor(packOpts, fraction)
+	})
+}
+
+func (c *Client) SetBaselineQuorumFactor(signer Signer, fraction *big.Int) (common.Hash, error) {
+	return c.sendGovernance(signer, func(g *contracts.Governance) (*types.Transaction, error) {
+		return g.SetBaselineQuorumFactor(packOpts, fraction)
+	})
+}
+
+// SetConstitution sets the fraction of yes votes, above one half, that a
+// proposal calling functionID on destination needs to pass. A zero
+// functionID sets the default for destination.
+func (c *Client) SetConstitution(signer Signer, destination common.Address, functionID [4]byte, threshold *big.Int) (common.Hash, error) {
+	half := new(big.Int).Rsh(fixed1, 1)
+	if threshold.Cmp(half) <= 0 || threshold.Cmp(fixed1) > 0 {
+		return common.Hash{}, fmt.Errorf("constitution threshold %s must be above 0.5 and at most 1", FormatFixidity(threshold))
+	}
+	return c.sendGovernance(signer, func(g *contracts.Governance) (*types.Transaction, error) {
+		return g.SetConstitution(packOpts, destination, functionID, threshold)
+	})
+}
+
+// sendGovernance sends the Governance call built by tx.
+func (c *Client) sendGovernance(signer Signer, tx func(*contracts.Governance) (*types.Transaction, error)) (common.Hash, error) {
+	governance, to, err := c.governanceAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	input, err := pack(tx(governance))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, to, nil, input, 0)
+}
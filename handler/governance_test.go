@@ -1,2558 +1,138 @@
 package handler
 
 import (
-	"crypto/ecdsa"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/log"
+	"bytes"
 	"math/big"
-	"strings"
 	"testing"
 
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/mapprotocol/marker_tool01/contracts"
 )
 
-const (
-	Second = 1
-	Minute = 60 * Second
-	Hour   = 60 * Minute
-	Day    = 24 * Hour
-	Week   = 7 * Day
-	Year   = 365 * Day
-)
-
-const GovernanceABIJSON = `[
-    {
-      "inputs": [
-        {
-          "internalType": "bool",
-          "name": "test",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "constructor"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "address",
-          "name": "approver",
-          "type": "address"
-        }
-      ],
-      "name": "ApproverSet",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "concurrentProposals",
-          "type": "uint256"
-        }
-      ],
-      "name": "ConcurrentProposalsSet",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "address",
-          "name": "destination",
-          "type": "address"
-        },
-        {
-          "indexed": true,
-          "internalType": "bytes4",
-          "name": "functionId",
-          "type": "bytes4"
-        },
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "threshold",
-          "type": "uint256"
-        }
-      ],
-      "name": "ConstitutionSet",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "dequeueFrequency",
-          "type": "uint256"
-        }
-      ],
-      "name": "DequeueFrequencySet",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "executionStageDuration",
-          "type": "uint256"
-        }
-      ],
-      "name": "ExecutionStageDurationSet",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "bytes32",
-          "name": "hash",
-          "type": "bytes32"
-        }
-      ],
-      "name": "HotfixApproved",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "bytes32",
-          "name": "hash",
-          "type": "bytes32"
-        }
-      ],
-      "name": "HotfixExecuted",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "bytes32",
-          "name": "hash",
-          "type": "bytes32"
-        },
-        {
-          "indexed": true,
-          "internalType": "uint256",
-          "name": "epoch",
-          "type": "uint256"
-        }
-      ],
-      "name": "HotfixPrepared",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "bytes32",
-          "name": "hash",
-          "type": "bytes32"
-        },
-        {
-          "indexed": false,
-          "internalType": "address",
-          "name": "whitelister",
-          "type": "address"
-        }
-      ],
-      "name": "HotfixWhitelisted",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "minDeposit",
-          "type": "uint256"
-        }
-      ],
-      "name": "MinDepositSet",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "address",
-          "name": "previousOwner",
-          "type": "address"
-        },
-        {
-          "indexed": true,
-          "internalType": "address",
-          "name": "newOwner",
-          "type": "address"
-        }
-      ],
-      "name": "OwnershipTransferred",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "baselineQuorumFactor",
-          "type": "uint256"
-        }
-      ],
-      "name": "ParticipationBaselineQuorumFactorSet",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "baselineUpdateFactor",
-          "type": "uint256"
-        }
-      ],
-      "name": "ParticipationBaselineUpdateFactorSet",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "participationBaseline",
-          "type": "uint256"
-        }
-      ],
-      "name": "ParticipationBaselineUpdated",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "participationFloor",
-          "type": "uint256"
-        }
-      ],
-      "name": "ParticipationFloorSet",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        }
-      ],
-      "name": "ProposalApproved",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        },
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "timestamp",
-          "type": "uint256"
-        }
-      ],
-      "name": "ProposalDequeued",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        }
-      ],
-      "name": "ProposalExecuted",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        }
-      ],
-      "name": "ProposalExpired",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        },
-        {
-          "indexed": true,
-          "internalType": "address",
-          "name": "proposer",
-          "type": "address"
-        },
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "transactionCount",
-          "type": "uint256"
-        },
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "deposit",
-          "type": "uint256"
-        },
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "timestamp",
-          "type": "uint256"
-        }
-      ],
-      "name": "ProposalQueued",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        },
-        {
-          "indexed": true,
-          "internalType": "address",
-          "name": "account",
-          "type": "address"
-        },
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "revokedUpvotes",
-          "type": "uint256"
-        }
-      ],
-      "name": "ProposalUpvoteRevoked",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        },
-        {
-          "indexed": true,
-          "internalType": "address",
-          "name": "account",
-          "type": "address"
-        },
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "upvotes",
-          "type": "uint256"
-        }
-      ],
-      "name": "ProposalUpvoted",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        },
-        {
-          "indexed": true,
-          "internalType": "address",
-          "name": "account",
-          "type": "address"
-        },
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "value",
-          "type": "uint256"
-        },
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "weight",
-          "type": "uint256"
-        }
-      ],
-      "name": "ProposalVoteRevoked",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        },
-        {
-          "indexed": true,
-          "internalType": "address",
-          "name": "account",
-          "type": "address"
-        },
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "value",
-          "type": "uint256"
-        },
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "weight",
-          "type": "uint256"
-        }
-      ],
-      "name": "ProposalVoted",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "queueExpiry",
-          "type": "uint256"
-        }
-      ],
-      "name": "QueueExpirySet",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": false,
-          "internalType": "uint256",
-          "name": "referendumStageDuration",
-          "type": "uint256"
-        }
-      ],
-      "name": "ReferendumStageDurationSet",
-      "type": "event"
-    },
-    {
-      "anonymous": false,
-      "inputs": [
-        {
-          "indexed": true,
-          "internalType": "address",
-          "name": "registryAddress",
-          "type": "address"
-        }
-      ],
-      "name": "RegistrySet",
-      "type": "event"
-    },
-    {
-      "payable": true,
-      "stateMutability": "payable",
-      "type": "fallback"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "index",
-          "type": "uint256"
-        }
-      ],
-      "name": "approve",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "bytes32",
-          "name": "hash",
-          "type": "bytes32"
-        }
-      ],
-      "name": "approveHotfix",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "approver",
-      "outputs": [
-        {
-          "internalType": "address",
-          "name": "",
-          "type": "address"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "address",
-          "name": "sender",
-          "type": "address"
-        },
-        {
-          "internalType": "bytes",
-          "name": "blsKey",
-          "type": "bytes"
-        },
-        {
-          "internalType": "bytes",
-          "name": "blsG1Key",
-          "type": "bytes"
-        },
-        {
-          "internalType": "bytes",
-          "name": "blsPop",
-          "type": "bytes"
-        }
-      ],
-      "name": "checkProofOfPossession",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "concurrentProposals",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "dequeueFrequency",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [],
-      "name": "dequeueProposalsIfReady",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "name": "dequeued",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "name": "emptyIndices",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "index",
-          "type": "uint256"
-        }
-      ],
-      "name": "execute",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256[]",
-          "name": "values",
-          "type": "uint256[]"
-        },
-        {
-          "internalType": "address[]",
-          "name": "destinations",
-          "type": "address[]"
-        },
-        {
-          "internalType": "bytes",
-          "name": "data",
-          "type": "bytes"
-        },
-        {
-          "internalType": "uint256[]",
-          "name": "dataLengths",
-          "type": "uint256[]"
-        },
-        {
-          "internalType": "bytes32",
-          "name": "salt",
-          "type": "bytes32"
-        }
-      ],
-      "name": "executeHotfix",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "aNumerator",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "aDenominator",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "bNumerator",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "bDenominator",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "exponent",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "_decimals",
-          "type": "uint256"
-        }
-      ],
-      "name": "fractionMulExp",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "bytes",
-          "name": "header",
-          "type": "bytes"
-        }
-      ],
-      "name": "getBlockNumberFromHeader",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "address",
-          "name": "destination",
-          "type": "address"
-        },
-        {
-          "internalType": "bytes4",
-          "name": "functionId",
-          "type": "bytes4"
-        }
-      ],
-      "name": "getConstitution",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "getDequeue",
-      "outputs": [
-        {
-          "internalType": "uint256[]",
-          "name": "",
-          "type": "uint256[]"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "getEpochNumber",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "blockNumber",
-          "type": "uint256"
-        }
-      ],
-      "name": "getEpochNumberOfBlock",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "getEpochSize",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "getExecutionStageDuration",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "bytes32",
-          "name": "hash",
-          "type": "bytes32"
-        }
-      ],
-      "name": "getHotfixRecord",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        },
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "address",
-          "name": "account",
-          "type": "address"
-        }
-      ],
-      "name": "getMostRecentReferendumProposal",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "blockNumber",
-          "type": "uint256"
-        }
-      ],
-      "name": "getParentSealBitmap",
-      "outputs": [
-        {
-          "internalType": "bytes32",
-          "name": "",
-          "type": "bytes32"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "getParticipationParameters",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        }
-      ],
-      "name": "getProposal",
-      "outputs": [
-        {
-          "internalType": "address",
-          "name": "",
-          "type": "address"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "string",
-          "name": "",
-          "type": "string"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        }
-      ],
-      "name": "getProposalStage",
-      "outputs": [
-        {
-          "internalType": "enum Proposals.Stage",
-          "name": "",
-          "type": "uint8"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "index",
-          "type": "uint256"
-        }
-      ],
-      "name": "getProposalTransaction",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "address",
-          "name": "",
-          "type": "address"
-        },
-        {
-          "internalType": "bytes",
-          "name": "",
-          "type": "bytes"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "getQueue",
-      "outputs": [
-        {
-          "internalType": "uint256[]",
-          "name": "",
-          "type": "uint256[]"
-        },
-        {
-          "internalType": "uint256[]",
-          "name": "",
-          "type": "uint256[]"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "getQueueLength",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "getReferendumStageDuration",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "address",
-          "name": "account",
-          "type": "address"
-        }
-      ],
-      "name": "getUpvoteRecord",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        }
-      ],
-      "name": "getUpvotes",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "bytes",
-          "name": "header",
-          "type": "bytes"
-        }
-      ],
-      "name": "getVerifiedSealBitmapFromHeader",
-      "outputs": [
-        {
-          "internalType": "bytes32",
-          "name": "",
-          "type": "bytes32"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "getVersionNumber",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "pure",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "address",
-          "name": "account",
-          "type": "address"
-        },
-        {
-          "internalType": "uint256",
-          "name": "index",
-          "type": "uint256"
-        }
-      ],
-      "name": "getVoteRecord",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        }
-      ],
-      "name": "getVoteTotals",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "bytes",
-          "name": "header",
-          "type": "bytes"
-        }
-      ],
-      "name": "hashHeader",
-      "outputs": [
-        {
-          "internalType": "bytes32",
-          "name": "",
-          "type": "bytes32"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "bytes32",
-          "name": "hash",
-          "type": "bytes32"
-        }
-      ],
-      "name": "hotfixWhitelistValidatorTally",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "bytes32",
-          "name": "",
-          "type": "bytes32"
-        }
-      ],
-      "name": "hotfixes",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "executed",
-          "type": "bool"
-        },
-        {
-          "internalType": "bool",
-          "name": "approved",
-          "type": "bool"
-        },
-        {
-          "internalType": "uint256",
-          "name": "preparedEpoch",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "address",
-          "name": "registryAddress",
-          "type": "address"
-        },
-        {
-          "internalType": "address",
-          "name": "_approver",
-          "type": "address"
-        },
-        {
-          "internalType": "uint256",
-          "name": "_concurrentProposals",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "_minDeposit",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "_queueExpiry",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "_dequeueFrequency",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "referendumStageDuration",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "executionStageDuration",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "participationBaseline",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "participationFloor",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "baselineUpdateFactor",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "baselineQuorumFactor",
-          "type": "uint256"
-        }
-      ],
-      "name": "initialize",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "initialized",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        }
-      ],
-      "name": "isApproved",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "index",
-          "type": "uint256"
-        }
-      ],
-      "name": "isDequeuedProposal",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        }
-      ],
-      "name": "isDequeuedProposalExpired",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "bytes32",
-          "name": "hash",
-          "type": "bytes32"
-        }
-      ],
-      "name": "isHotfixPassing",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "bytes32",
-          "name": "hash",
-          "type": "bytes32"
-        },
-        {
-          "internalType": "address",
-          "name": "whitelister",
-          "type": "address"
-        }
-      ],
-      "name": "isHotfixWhitelistedBy",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "isOwner",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        }
-      ],
-      "name": "isProposalPassing",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        }
-      ],
-      "name": "isQueued",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        }
-      ],
-      "name": "isQueuedProposalExpired",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "address",
-          "name": "account",
-          "type": "address"
-        }
-      ],
-      "name": "isVoting",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "lastDequeue",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "minDeposit",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "blockNumber",
-          "type": "uint256"
-        }
-      ],
-      "name": "minQuorumSize",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "minQuorumSizeInCurrentSet",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "numberValidatorsInCurrentSet",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "blockNumber",
-          "type": "uint256"
-        }
-      ],
-      "name": "numberValidatorsInSet",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "owner",
-      "outputs": [
-        {
-          "internalType": "address",
-          "name": "",
-          "type": "address"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "bytes32",
-          "name": "hash",
-          "type": "bytes32"
-        }
-      ],
-      "name": "prepareHotfix",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "proposalCount",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        }
-      ],
-      "name": "proposalExists",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256[]",
-          "name": "values",
-          "type": "uint256[]"
-        },
-        {
-          "internalType": "address[]",
-          "name": "destinations",
-          "type": "address[]"
-        },
-        {
-          "internalType": "bytes",
-          "name": "data",
-          "type": "bytes"
-        },
-        {
-          "internalType": "uint256[]",
-          "name": "dataLengths",
-          "type": "uint256[]"
-        },
-        {
-          "internalType": "string",
-          "name": "descriptionUrl",
-          "type": "string"
-        }
-      ],
-      "name": "propose",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": true,
-      "stateMutability": "payable",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "queueExpiry",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "address",
-          "name": "",
-          "type": "address"
-        }
-      ],
-      "name": "refundedDeposits",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "registry",
-      "outputs": [
-        {
-          "internalType": "contract IRegistry",
-          "name": "",
-          "type": "address"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [],
-      "name": "renounceOwnership",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "lesser",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "greater",
-          "type": "uint256"
-        }
-      ],
-      "name": "revokeUpvote",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [],
-      "name": "revokeVotes",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "address",
-          "name": "_approver",
-          "type": "address"
-        }
-      ],
-      "name": "setApprover",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "baselineQuorumFactor",
-          "type": "uint256"
-        }
-      ],
-      "name": "setBaselineQuorumFactor",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "baselineUpdateFactor",
-          "type": "uint256"
-        }
-      ],
-      "name": "setBaselineUpdateFactor",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "_concurrentProposals",
-          "type": "uint256"
-        }
-      ],
-      "name": "setConcurrentProposals",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "address",
-          "name": "destination",
-          "type": "address"
-        },
-        {
-          "internalType": "bytes4",
-          "name": "functionId",
-          "type": "bytes4"
-        },
-        {
-          "internalType": "uint256",
-          "name": "threshold",
-          "type": "uint256"
-        }
-      ],
-      "name": "setConstitution",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "_dequeueFrequency",
-          "type": "uint256"
-        }
-      ],
-      "name": "setDequeueFrequency",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "executionStageDuration",
-          "type": "uint256"
-        }
-      ],
-      "name": "setExecutionStageDuration",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "_minDeposit",
-          "type": "uint256"
-        }
-      ],
-      "name": "setMinDeposit",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "participationBaseline",
-          "type": "uint256"
-        }
-      ],
-      "name": "setParticipationBaseline",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "participationFloor",
-          "type": "uint256"
-        }
-      ],
-      "name": "setParticipationFloor",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "_queueExpiry",
-          "type": "uint256"
-        }
-      ],
-      "name": "setQueueExpiry",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "referendumStageDuration",
-          "type": "uint256"
-        }
-      ],
-      "name": "setReferendumStageDuration",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "address",
-          "name": "registryAddress",
-          "type": "address"
-        }
-      ],
-      "name": "setRegistry",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [],
-      "name": "stageDurations",
-      "outputs": [
-        {
-          "internalType": "uint256",
-          "name": "approval",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "referendum",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "execution",
-          "type": "uint256"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "address",
-          "name": "newOwner",
-          "type": "address"
-        }
-      ],
-      "name": "transferOwnership",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "lesser",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "greater",
-          "type": "uint256"
-        }
-      ],
-      "name": "upvote",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "index",
-          "type": "uint256"
-        }
-      ],
-      "name": "validatorSignerAddressFromCurrentSet",
-      "outputs": [
-        {
-          "internalType": "address",
-          "name": "",
-          "type": "address"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": true,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "index",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "blockNumber",
-          "type": "uint256"
-        }
-      ],
-      "name": "validatorSignerAddressFromSet",
-      "outputs": [
-        {
-          "internalType": "address",
-          "name": "",
-          "type": "address"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "view",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "uint256",
-          "name": "proposalId",
-          "type": "uint256"
-        },
-        {
-          "internalType": "uint256",
-          "name": "index",
-          "type": "uint256"
-        },
-        {
-          "internalType": "enum Proposals.VoteValue",
-          "name": "value",
-          "type": "uint8"
-        }
-      ],
-      "name": "vote",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [
-        {
-          "internalType": "bytes32",
-          "name": "hash",
-          "type": "bytes32"
-        }
-      ],
-      "name": "whitelistHotfix",
-      "outputs": [],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    },
-    {
-      "constant": false,
-      "inputs": [],
-      "name": "withdraw",
-      "outputs": [
-        {
-          "internalType": "bool",
-          "name": "",
-          "type": "bool"
-        }
-      ],
-      "payable": false,
-      "stateMutability": "nonpayable",
-      "type": "function"
-    }
-  ]`
-
-var (
-	ABIGovernance, _ = abi.JSON(strings.NewReader(GovernanceABIJSON))
-)
-
-var (
-	governanceAddr = common.HexToAddress("0xcdB66B1e6A07279df98f804d0aCAC86695F4b99e")
-)
-
-func PackInput(abi abi.ABI, abiMethod string, params ...interface{}) []byte {
-	input, err := abi.Pack(abiMethod, params...)
+func duration(s string) *big.Int {
+	d, err := ParseDuration(s)
 	if err != nil {
 		panic(err)
 	}
-	return input
+	return d
 }
 
-func Initialize(conn *ethclient.Client, from common.Address, priKey *ecdsa.PrivateKey) error {
-	minDeposit, b := new(big.Int).SetString("10000000000000000000000", 10) // 10000 map
-	if !b {
-		panic("n1")
-	}
-	participationBaseline, b := new(big.Int).SetString("5000000000000000000000", 10) // 0.005
-	if !b {
-		panic("n1")
-	}
-	participationFloor, b := new(big.Int).SetString("10000000000000000000000", 10) // 0.01
-	if !b {
-		panic("n1")
-	}
-	baselineUpdateFactor, b := new(big.Int).SetString("200000000000000000000000", 10) // 0.2
-	if !b {
-		panic("n1")
-	}
-	baselineQuorumFactor, b := new(big.Int).SetString("1000000000000000000000000", 10) // 1
-	if !b {
-		panic("n1")
-	}
-
-	input := PackInput(
-		ABIGovernance,
-		"initialize",
-		common.HexToAddress("0xce10"),
-		common.HexToAddress("0x1Eb2162cFF732df3FF3D421f69cd1d07a2b5c169"),
-		big.NewInt(3),
-		minDeposit,
-		big.NewInt(4*Week),    // 队列过期时间 4 周
-		big.NewInt(30*Minute), // 出队频率 30 分钟
-		big.NewInt(30*Minute), // 公投阶段持续时间 30 分钟
-		big.NewInt(24*Hour),   // 执行阶段持续时间 24 小时
-		participationBaseline,
-		participationFloor,
-		baselineUpdateFactor,
-		baselineQuorumFactor,
-	)
-
-	hash := sendContractTransaction(conn, from, governanceAddr, nil, priKey, input, 0)
-	log.Info("", "hash", hash)
-	return nil
-}
-func setReferendumStageDuration(conn *ethclient.Client, from common.Address, privateKey *ecdsa.PrivateKey, duration *big.Int) {
-	input := packInput(&ABIGovernance, "setReferendumStageDuration", duration)
-	txHash := sendContractTransaction(conn, from, governanceAddr, nil, privateKey, input, 0)
-	getResult(conn, txHash)
-	log.Info("setReferendumStageDuration", "from", from, "duration", duration)
-}
-
-func setExecutionStageDuration(conn *ethclient.Client, from common.Address, privateKey *ecdsa.PrivateKey, duration *big.Int) {
-	input := packInput(&ABIGovernance, "setExecutionStageDuration", duration)
-	txHash := sendContractTransaction(conn, from, governanceAddr, nil, privateKey, input, 0)
-	getResult(conn, txHash)
-	log.Info("setExecutionStageDuration", "from", from, "duration", duration)
-}
-func setDequeueFrequency(conn *ethclient.Client, from common.Address, privateKey *ecdsa.PrivateKey, duration *big.Int) {
-	input := packInput(&ABIGovernance, "setDequeueFrequency", duration)
-	txHash := sendContractTransaction(conn, from, governanceAddr, nil, privateKey, input, 0)
-	getResult(conn, txHash)
-	log.Info("setDequeueFrequency", "from", from, "duration", duration)
-}
-func getDequeueFrequency(conn *ethclient.Client) {
-	input := packInput(&ABIGovernance, "dequeueFrequency")
-	output := CallContract(conn, governanceAddr, input)
-
-	var outvalue *big.Int
-	if err := ABIGovernance.UnpackIntoInterface(&outvalue, "dequeueFrequency", output); err != nil {
-		log.Crit("unpack failed", "err", err.Error())
-	}
-	log.Info("getDequeueFrequency", "dequeueFrequency", outvalue)
-}
-func getExecutionStageDuration(conn *ethclient.Client) {
-	input := packInput(&ABIGovernance, "getExecutionStageDuration")
-	output := CallContract(conn, governanceAddr, input)
-
-	var outvalue *big.Int
-	if err := ABIGovernance.UnpackIntoInterface(&outvalue, "getExecutionStageDuration", output); err != nil {
-		log.Crit("unpack failed", "err", err.Error())
+func fixidity(s string) *big.Int {
+	f, err := ParseFixidity(s)
+	if err != nil {
+		panic(err)
 	}
-	log.Info("getExecutionStageDuration", "executionStageDuration", outvalue)
+	return f
 }
 
 func TestInitialize(t *testing.T) {
-	cli := dial(endpoint)
-	from := common.HexToAddress("0xB3396Fef0cfC3A5d68b3Ef17e5016815af63102B")
-	privateKey, err := crypto.ToECDSA(common.FromHex(""))
+	cli := newClient(t)
+	signer := testSigner(t)
+	governance := common.HexToAddress("0xcdB66B1e6A07279df98f804d0aCAC86695F4b99e")
+	hash, err := cli.InitializeGovernance(signer, governance, &GovernanceConfig{
+		Registry:                common.HexToAddress("0xce10"),
+		Approver:                common.HexToAddress("0x1Eb2162cFF732df3FF3D421f69cd1d07a2b5c169"),
+		ConcurrentProposals:     big.NewInt(3),
+		MinDeposit:              new(big.Int).Mul(big.NewInt(10000), big.NewInt(1e18)),
+		QueueExpiry:             duration("4w"),
+		DequeueFrequency:        duration("30m"),
+		ReferendumStageDuration: duration("30m"),
+		ExecutionStageDuration:  duration("24h"),
+		ParticipationBaseline:   fixidity("0.005"),
+		ParticipationFloor:      fixidity("0.01"),
+		BaselineUpdateFactor:    fixidity("0.2"),
+		BaselineQuorumFactor:    fixidity("1"),
+	})
 	if err != nil {
 		t.Fatal(err)
 	}
-	if err := Initialize(cli, from, privateKey); err != nil {
-		t.Fatal("initialize failed", "err", err.Error())
+	if _, err := cli.WaitTx(hash); err != nil {
+		t.Fatal(err)
 	}
 }
+
 func TestSetReferendumStageDuration(t *testing.T) {
-	cli := dial(endpoint)
-	from := common.HexToAddress("0xB3396Fef0cfC3A5d68b3Ef17e5016815af63102B")
-	privateKey, err := crypto.ToECDSA(common.FromHex(""))
+	cli := newClient(t)
+	hash, err := cli.SetReferendumStageDuration(testSigner(t), duration("5d"))
 	if err != nil {
 		t.Fatal(err)
 	}
-	d := big.NewInt(5 * Day)
-	setReferendumStageDuration(cli, from, privateKey, d)
+	if _, err := cli.WaitTx(hash); err != nil {
+		t.Fatal(err)
+	}
 }
+
 func TestSetExecutionStageDuration(t *testing.T) {
-	cli := dial(endpoint)
-	from := common.HexToAddress("0xB3396Fef0cfC3A5d68b3Ef17e5016815af63102B")
-	privateKey, err := crypto.ToECDSA(common.FromHex(""))
+	cli := newClient(t)
+	hash, err := cli.SetExecutionStageDuration(testSigner(t), duration("3d"))
 	if err != nil {
 		t.Fatal(err)
 	}
-	d := big.NewInt(3 * Day)
-	setExecutionStageDuration(cli, from, privateKey, d)
+	if _, err := cli.WaitTx(hash); err != nil {
+		t.Fatal(err)
+	}
 }
+
 func TestSetDequeueFrequency(t *testing.T) {
-	cli := dial(endpoint)
-	from := common.HexToAddress("0xB3396Fef0cfC3A5d68b3Ef17e5016815af63102B")
-	privateKey, err := crypto.ToECDSA(common.FromHex(""))
+	cli := newClient(t)
+	hash, err := cli.SetDequeueFrequency(testSigner(t), duration("1d"))
 	if err != nil {
 		t.Fatal(err)
 	}
-	d := big.NewInt(1 * Day)
-	setDequeueFrequency(cli, from, privateKey, d)
+	if _, err := cli.WaitTx(hash); err != nil {
+		t.Fatal(err)
+	}
 }
-func TestGetDequeueFrequency(t *testing.T) {
-	cli := dial(endpoint)
-	getDequeueFrequency(cli)
+
+func TestGetGovernanceParams(t *testing.T) {
+	cli := newClient(t)
+	p, err := cli.GovernanceParams()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("dequeueFrequency", p.DequeueFrequency, "executionStageDuration", p.ExecutionStageDuration)
 }
-func TestGetexecutionStageDuration(t *testing.T) {
-	cli := dial(endpoint)
-	getExecutionStageDuration(cli)
+
+func TestGovernanceParamsAndSetters(t *testing.T) {
+	approver := common.HexToAddress("0xa99")
+	node := governanceNode(t, map[string]contractMethod{
+		"approver":            returns(approver),
+		"concurrentProposals": returns(big.NewInt(3)),
+		"minDeposit":          returns(big.NewInt(100)),
+		"queueExpiry":         returns(duration("4w")),
+		"dequeueFrequency":    returns(duration("30m")),
+		"stageDurations":      returns(duration("1h"), duration("1d"), duration("3d")),
+		"getParticipationParameters": returns(fixidity("0.005"), fixidity("0.01"),
+			fixidity("0.2"), fixidity("1")),
+	})
+	node.gasPrice = big.NewInt(1)
+	node.nonce = func(common.Address) uint64 { return 0 }
+	cli := newFakeClient(t, node)
+
+	p, err := cli.GovernanceParams()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Approver != approver || p.ConcurrentProposals.Int64() != 3 || FormatDuration(p.ReferendumStageDuration) != "1d" ||
+		FormatDuration(p.ExecutionStageDuration) != "3d" || FormatFixidity(p.ParticipationFloor) != "0.01" {
+		t.Fatalf("have params %+v", p)
+	}
+
+	parsed, _ := contracts.GovernanceMetaData.GetAbi()
+	if _, err := cli.SetMinDeposit(newTestSigner(t), big.NewInt(5000)); err != nil {
+		t.Fatal(err)
+	}
+	want, _ := parsed.Pack("setMinDeposit", big.NewInt(5000))
+	if tx := node.sent[0]; *tx.To() != testGovernance || !bytes.Equal(tx.Data(), want) {
+		t.Fatalf("have to %s data %x, want %x", tx.To().Hex(), tx.Data(), want)
+	}
+
+	for _, threshold := range []string{"0.5", "1.000000000000000000000001", "0"} {
+		if _, err := cli.SetConstitution(newTestSigner(t), GenesisAddresses["ElectionProxy"], [4]byte{1, 2, 3, 4}, fixidity(threshold)); err == nil {
+			t.Errorf("expected threshold %s to be refused", threshold)
+		}
+	}
+	if _, err := cli.SetConstitution(newTestSigner(t), GenesisAddresses["ElectionProxy"], [4]byte{1, 2, 3, 4}, fixidity("0.7")); err != nil {
+		t.Fatal(err)
+	}
 }
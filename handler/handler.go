@@ -2,220 +2,197 @@ package handler
 
 import (
 	"context"
-	"crypto/ecdsa"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/log"
 	"math/big"
-	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mapprotocol/marker_tool01/contracts"
 )
 
-func init() {
-	startLogger()
+func (c *Client) GetMgrMaintainerAddress() (common.Address, error) {
+	epochRewards, _, err := c.epochRewardsAt(nil)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return epochRewards.GetMgrMaintainerAddress(nil)
 }
 
-func startLogger() {
-	var lvl = log.LvlInfo
-	logger := log.NewGlogHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(true)))
-	logger.Verbosity(lvl)
-	log.Root().SetHandler(logger)
+func (c *Client) SetMgrMaintainerAddress(signer Signer, target common.Address) (common.Hash, error) {
+	epochRewards, to, err := c.epochRewardsAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	input, err := pack(epochRewards.SetMgrMaintainerAddress(packOpts, target))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, to, nil, input, 0)
 }
 
-func getMgrMaintainerAddress(endpoint string) {
-	cli := dial(endpoint)
-	parsed := parseABI(EpochRewardsABI)
-	input := packInput(parsed, "getMgrMaintainerAddress")
-	output := CallContract(cli, GenesisAddresses["EpochRewardsProxy"], input)
-	var addr common.Address
-	if err := parsed.UnpackIntoInterface(&addr, "getMgrMaintainerAddress", output); err != nil {
-		log.Crit("unpack failed", "err", err.Error())
+func (c *Client) GetTargetEpochPayment() (*big.Int, error) {
+	epochRewards, _, err := c.epochRewardsAt(nil)
+	if err != nil {
+		return nil, err
 	}
-	log.Info("getMgrMaintainerAddress", "address", addr)
+	return epochRewards.EpochPayment(nil)
 }
 
-func setMgrMaintainerAddress(endpoint string, from, target common.Address, privateKey *ecdsa.PrivateKey) {
-	cli := dial(endpoint)
-	input := packInput(parseABI(EpochRewardsABI), "setMgrMaintainerAddress", target)
-	txHash := sendContractTransaction(cli, from, GenesisAddresses["EpochRewardsProxy"], nil, privateKey, input, 0)
-	getResult(cli, txHash)
-	log.Info("setMgrMaintainerAddress", "address", target)
+func (c *Client) SetTargetEpochPayment(signer Signer, target *big.Int) (common.Hash, error) {
+	epochRewards, to, err := c.epochRewardsAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	input, err := pack(epochRewards.SetTargetEpochPayment(packOpts, target))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, to, nil, input, 0)
 }
 
-func getTargetEpochPayment(endpoint string) {
-	cli := dial(endpoint)
-	parsed := parseABI(EpochRewardsABI)
-	input := packInput(parsed, "epochPayment")
-	output := CallContract(cli, GenesisAddresses["EpochRewardsProxy"], input)
-	var value *big.Int
-	if err := parsed.UnpackIntoInterface(&value, "epochPayment", output); err != nil {
-		log.Crit("unpack failed", "err", err.Error())
+func (c *Client) GetElectableValidators() (min, max *big.Int, err error) {
+	election, _, err := c.electionAt(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := election.ElectableValidators(nil)
+	if err != nil {
+		return nil, nil, err
 	}
-	log.Info("getTargetEpochPayment", "value", value)
+	return resp.Min, resp.Max, nil
 }
 
-func setTargetEpochPayment(endpoint string, from common.Address, target *big.Int, privateKey *ecdsa.PrivateKey) {
-	cli := dial(endpoint)
-	input := packInput(parseABI(EpochRewardsABI), "setTargetEpochPayment", target)
-	txHash := sendContractTransaction(cli, from, GenesisAddresses["EpochRewardsProxy"], nil, privateKey, input, 0)
-	getResult(cli, txHash)
-	log.Info("setTargetEpochPayment", "value", target)
+func (c *Client) SetElectableValidators(signer Signer, minElectableValidators, maxElectableValidators *big.Int) (common.Hash, error) {
+	election, to, err := c.electionAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := electionOwner(election, signer); err != nil {
+		return common.Hash{}, err
+	}
+	input, err := pack(election.SetElectableValidators(packOpts, minElectableValidators, maxElectableValidators))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, to, nil, input, 0)
 }
 
-func getElectableValidators(endpoint string) {
-	cli := dial(endpoint)
-	parsed := parseABI(ElectionABI)
-	input := packInput(parsed, "electableValidators")
-	output := CallContract(cli, GenesisAddresses["ElectionProxy"], input)
-
-	var min *big.Int
-	var max *big.Int
-	resp := []*big.Int{min, max}
-	if err := parsed.UnpackIntoInterface(&resp, "electableValidators", output); err != nil {
-		log.Crit("unpack failed", "err", err.Error())
-	}
-	log.Info("getElectableValidators", "minElectableValidators", resp[0], "maxElectableValidators", resp[1])
+func (c *Client) GetCommissionUpdateDelay() (*big.Int, error) {
+	validators, _, err := c.validatorsAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	return validators.CommissionUpdateDelay(nil)
 }
 
-func setElectableValidators(endpoint string, from common.Address, privateKey *ecdsa.PrivateKey, minElectableValidators, maxElectableValidators *big.Int) {
-	cli := dial(endpoint)
-	input := packInput(parseABI(ElectionABI), "setElectableValidators", minElectableValidators, maxElectableValidators)
-	txHash := sendContractTransaction(cli, from, GenesisAddresses["ElectionProxy"], nil, privateKey, input, 0)
-	getResult(cli, txHash)
-	log.Info("setElectableValidators", "minElectableValidators", minElectableValidators, "maxElectableValidators", maxElectableValidators)
+func (c *Client) SetCommissionUpdateDelay(signer Signer, delayBlock *big.Int) (common.Hash, error) {
+	validators, to, err := c.validatorsAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	input, err := pack(validators.SetCommissionUpdateDelay(packOpts, delayBlock))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, to, nil, input, 0)
 }
 
-func getCommissionUpdateDelay(endpoint string) {
-	cli := dial(endpoint)
-	parsed := parseABI(ValidatorsABI)
-	input := packInput(parsed, "commissionUpdateDelay")
-	output := CallContract(cli, GenesisAddresses["ValidatorsProxy"], input)
-	var value *big.Int
-	if err := parsed.UnpackIntoInterface(&value, "commissionUpdateDelay", output); err != nil {
-		log.Crit("unpack failed", "err", err.Error())
+func (c *Client) GetUnlockingPeriod() (*big.Int, error) {
+	lockedGold, _, err := c.lockedGoldAt(nil)
+	if err != nil {
+		return nil, err
 	}
-	log.Info("getCommissionUpdateDelay", "delayBlock", value)
+	return lockedGold.UnlockingPeriod(nil)
 }
 
-func setCommissionUpdateDelay(endpoint string, from common.Address, privateKey *ecdsa.PrivateKey, delayBlock *big.Int) {
-	cli := dial(endpoint)
-	input := packInput(parseABI(ValidatorsABI), "setCommissionUpdateDelay", delayBlock)
-	txHash := sendContractTransaction(cli, from, GenesisAddresses["ValidatorsProxy"], nil, privateKey, input, 0)
-	getResult(cli, txHash)
-	log.Info("setCommissionUpdateDelay", "address", from, "delayBlock", delayBlock)
+func (c *Client) SetUnlockingPeriod(signer Signer, period *big.Int) (common.Hash, error) {
+	lockedGold, to, err := c.lockedGoldAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	input, err := pack(lockedGold.SetUnlockingPeriod(packOpts, period))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, to, nil, input, 0)
 }
 
-func getUnlockingPeriod(endpoint string) {
-	cli := dial(endpoint)
-	parsed := parseABI(LockedGoldABI)
-	input := packInput(parsed, "unlockingPeriod")
-	output := CallContract(cli, GenesisAddresses["LockedGoldProxy"], input)
-	var period *big.Int
-	if err := parsed.UnpackIntoInterface(&period, "unlockingPeriod", output); err != nil {
-		log.Crit("unpack failed", "err", err.Error())
-	}
-	log.Info("getUnlockingPeriod", "period", period)
-}
-
-func setUnlockingPeriod(endpoint string, from common.Address, privateKey *ecdsa.PrivateKey, period *big.Int) {
-	cli := dial(endpoint)
-	input := packInput(parseABI(LockedGoldABI), "setUnlockingPeriod", period)
-	txHash := sendContractTransaction(cli, from, GenesisAddresses["LockedGoldProxy"], nil, privateKey, input, 0)
-	getResult(cli, txHash)
-	log.Info("setCommissionUpdateDelay", "from", from, "period", period)
-}
-
-func getImplAddress(endpoint string, proxyAddress common.Address) {
-	cli := dial(endpoint)
-	parsed := parseABI(ProxyABI)
-	input := packInput(parsed, "_getImplementation")
-	output := CallContract(cli, proxyAddress, input)
-	var implAddress common.Address
-	if err := parsed.UnpackIntoInterface(&implAddress, "_getImplementation", output); err != nil {
-		log.Crit("unpack failed", "err", err.Error())
-	}
-	log.Info("getImplAddress", "proxy", proxyAddress, "impl", implAddress)
-}
-
-func setImplAddress(endpoint string, from common.Address, privateKey *ecdsa.PrivateKey, proxyAddress, implAddress common.Address) {
-	cli := dial(endpoint)
-	input := packInput(parseABI(ProxyABI), "_setImplementation", implAddress)
-	txHash := sendContractTransaction(cli, from, proxyAddress, nil, privateKey, input, 0)
-	getResult(cli, txHash)
-	log.Info("setImplAddress", "from", from, "proxy", proxyAddress, "impl", implAddress)
-}
-func isPendingDeRegisterValidator(endpoint string, sender common.Address) {
-	cli := dial(endpoint)
-	parsed := parseABI(ValidatorsABI)
-	input := packInput(parsed, "isPendingDeRegisterValidator")
-	output := CallContract2(cli, sender, GenesisAddresses["ValidatorsProxy"], input)
-	var result bool
-	if err := parsed.UnpackIntoInterface(&result, "isPendingDeRegisterValidator", output); err != nil {
-		log.Crit("unpack failed", "err", err.Error())
-	}
-	log.Info("getUnlockingPeriod", "result", result)
-}
-func getActiveVotesForValidator(endpoint string, addr common.Address, height *big.Int) {
-	cli := dial(endpoint)
-	parsed := parseABI(ElectionABI)
-	input := packInput(parsed, "getActiveVotesForValidator", addr)
-	output := CallContract3(cli, GenesisAddresses["ElectionProxy"], input, height)
-	var res *big.Int
-	if err := parsed.UnpackIntoInterface(&res, "getActiveVotesForValidator", output); err != nil {
-		log.Crit("unpack failed", "err", err.Error())
-	}
-	log.Info("getActiveVotesForValidator", "validator", addr, "height", height.String(), "val", toCoin(res))
-}
-func sendTransaction(endpoint string, from, to common.Address, privateKey *ecdsa.PrivateKey, value *big.Int) {
-	cli := dial(endpoint)
-	txHash := sendTransaction0(cli, from, to, value, privateKey)
-	getResult(cli, txHash)
-}
-func balanceOf(endpoint string, to common.Address) {
-	cli := dial(endpoint)
-	b, e := cli.BalanceAt(context.Background(), to, nil)
-	log.Info("balanceOf", "to", to, "balance", b.String(), "coin", toCoin(b), "error", e)
-}
-func toCoin(val *big.Int) *big.Float {
+func (c *Client) GetImplAddress(proxyAddress common.Address) (common.Address, error) {
+	proxy, err := contracts.NewProxyCaller(proxyAddress, c.backend)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return proxy.GetImplementation(nil)
+}
+
+func (c *Client) SetImplAddress(signer Signer, proxyAddress, implAddress common.Address) (common.Hash, error) {
+	proxy, err := contracts.NewProxyTransactor(proxyAddress, c.backend)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	input, err := pack(proxy.SetImplementation(packOpts, implAddress))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, proxyAddress, nil, input, 0)
+}
+
+// IsPendingDeRegisterValidator reports whether sender is a validator pending
+// deregistration; the contract reads the caller so sender is used as msg.sender.
+func (c *Client) IsPendingDeRegisterValidator(sender common.Address) (bool, error) {
+	validators, _, err := c.validatorsAt(nil)
+	if err != nil {
+		return false, err
+	}
+	return validators.IsPendingDeRegisterValidator(&bind.CallOpts{From: sender})
+}
+
+func (c *Client) GetActiveVotesForValidator(addr common.Address, height *big.Int) (*big.Int, error) {
+	election, _, err := c.electionAt(height)
+	if err != nil {
+		return nil, err
+	}
+	return election.GetActiveVotesForValidator(callOpts(height), addr)
+}
+
+func (c *Client) SendTransaction(signer Signer, to common.Address, value *big.Int) (common.Hash, error) {
+	return c.sendContractTransaction(signer, to, value, nil, 0)
+}
+
+func (c *Client) BalanceOf(to common.Address) (*big.Int, error) {
+	return c.conn.BalanceAt(context.Background(), to, nil)
+}
+
+func ToCoin(val *big.Int) *big.Float {
 	BaseBig := big.NewInt(1e18)
 	return new(big.Float).Quo(new(big.Float).SetInt(val), new(big.Float).SetInt(BaseBig))
 }
-func toWei(value *big.Float) *big.Int {
+func ToWei(value *big.Float) *big.Int {
 	BaseBig := big.NewInt(1e18)
 	base := new(big.Float).SetInt(BaseBig)
 	val, _ := new(big.Float).Mul(value, base).Int(big.NewInt(0))
 	return val
 }
-func getAccountTotalLockedGold(endpoint string, addr common.Address, height *big.Int) {
-	cli := dial(endpoint)
-	parsed := parseABI(LockedGoldABI)
-	input := packInput(parsed, "getAccountTotalLockedGold", addr)
-	output := CallContract3(cli, GenesisAddresses["LockedGoldProxy"], input, height)
-	var res *big.Int
-	if err := parsed.UnpackIntoInterface(&res, "getAccountTotalLockedGold", output); err != nil {
-		log.Crit("unpack failed", "err", err.Error())
+
+func (c *Client) GetAccountTotalLockedGold(addr common.Address, height *big.Int) (*big.Int, error) {
+	lockedGold, _, err := c.lockedGoldAt(height)
+	if err != nil {
+		return nil, err
 	}
-	log.Info("getAccountTotalLockedGold", "addr", addr, "height", height.String(), "val", toCoin(res))
+	return lockedGold.GetAccountTotalLockedGold(callOpts(height), addr)
 }
 
-func getAccountNonvotingLockedGold(endpoint string, addr common.Address, height *big.Int) {
-	cli := dial(endpoint)
-	parsed := parseABI(LockedGoldABI)
-	input := packInput(parsed, "getAccountNonvotingLockedGold", addr)
-	output := CallContract3(cli, GenesisAddresses["LockedGoldProxy"], input, height)
-	var res *big.Int
-	if err := parsed.UnpackIntoInterface(&res, "getAccountNonvotingLockedGold", output); err != nil {
-		log.Crit("unpack failed", "err", err.Error())
+func (c *Client) GetAccountNonvotingLockedGold(addr common.Address, height *big.Int) (*big.Int, error) {
+	lockedGold, _, err := c.lockedGoldAt(height)
+	if err != nil {
+		return nil, err
 	}
-	log.Info("getAccountNonvotingLockedGold", "addr", addr, "height", height.String(), "val", toCoin(res))
+	return lockedGold.GetAccountNonvotingLockedGold(callOpts(height), addr)
 }
 
-func getBlockGasLimit(endpoint string) {
-	cli := dial(endpoint)
-	parsed := parseABI(BlockchainParametersABI)
-	input := packInput(parsed, "blockGasLimit")
-	output := CallContract(cli, GenesisAddresses["BlockchainParametersProxy"], input)
-	var res *big.Int
-	if err := parsed.UnpackIntoInterface(&res, "blockGasLimit", output); err != nil {
-		log.Crit("unpack failed", "err", err.Error())
+func (c *Client) GetBlockGasLimit() (*big.Int, error) {
+	blockchainParameters, _, err := c.blockchainParametersAt(nil)
+	if err != nil {
+		return nil, err
 	}
-	log.Info("getBlockGasLimit", "res", toCoin(res), "res0", res)
+	return blockchainParameters.BlockGasLimit(nil)
 }
@@ -1,118 +1,182 @@
 package handler
 
 import (
+	"bytes"
 	"encoding/csv"
 	"encoding/hex"
 	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mapprotocol/marker_tool01/contracts"
 	"github.com/shopspring/decimal"
 	"io"
 	"math/big"
 	"os"
 	"strings"
 	"testing"
-	"time"
 )
 
 var endpoint = "https://rpc.maplabs.io"
 
-func Test_getMgrMaintainerAddress(t *testing.T) {
-	getMgrMaintainerAddress(endpoint)
+func newClient(t *testing.T) *Client {
+	cli, err := Dial(endpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cli.Close)
+	return cli
 }
 
-func Test_setMgrMaintainerAddress(t *testing.T) {
-	from := common.HexToAddress("")
-	target := common.HexToAddress("")
-	privateKey, err := crypto.ToECDSA(common.FromHex(""))
+// testSigner loads the sender of the network tests from the keystore file
+// in MARKER_TEST_KEYSTORE, unlocked with MARKER_TEST_PASSWORD, or from the
+// hex key in MARKER_TEST_KEY, so that no key has to live in the source.
+func testSigner(t *testing.T) Signer {
+	var (
+		signer Signer
+		err    error
+	)
+	switch {
+	case os.Getenv("MARKER_TEST_KEYSTORE") != "":
+		signer, err = KeystoreSigner(os.Getenv("MARKER_TEST_KEYSTORE"), os.Getenv("MARKER_TEST_PASSWORD"))
+	case os.Getenv("MARKER_TEST_KEY") != "":
+		signer, err = HexKeySigner(os.Getenv("MARKER_TEST_KEY"))
+	default:
+		err = fmt.Errorf("set MARKER_TEST_KEYSTORE or MARKER_TEST_KEY")
+	}
 	if err != nil {
 		t.Fatal(err)
 	}
+	return signer
+}
 
-	setMgrMaintainerAddress(endpoint, from, target, privateKey)
+func waitTx(t *testing.T, cli *Client, txHash common.Hash, err error) {
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt, err := cli.WaitTx(txHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("tx", txHash.Hex(), "block", receipt.BlockNumber)
 }
 
-func Test_getTargetEpochPayment(t *testing.T) {
-	getTargetEpochPayment(endpoint)
+func Test_getMgrMaintainerAddress(t *testing.T) {
+	addr, err := newClient(t).GetMgrMaintainerAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("getMgrMaintainerAddress", addr)
 }
 
-func Test_setTargetEpochPayment(t *testing.T) {
-	from := common.HexToAddress("")
-	target := new(big.Int).Mul(big.NewInt(50000), big.NewInt(1e18))
-	privateKey, err := crypto.ToECDSA(common.FromHex(""))
+func Test_setMgrMaintainerAddress(t *testing.T) {
+	target := common.HexToAddress("")
+	signer := testSigner(t)
+
+	cli := newClient(t)
+	txHash, err := cli.SetMgrMaintainerAddress(signer, target)
+	waitTx(t, cli, txHash, err)
+}
+
+func Test_getTargetEpochPayment(t *testing.T) {
+	value, err := newClient(t).GetTargetEpochPayment()
 	if err != nil {
 		t.Fatal(err)
 	}
+	t.Log("getTargetEpochPayment", value)
+}
 
-	setTargetEpochPayment(endpoint, from, target, privateKey)
+func Test_setTargetEpochPayment(t *testing.T) {
+	target := new(big.Int).Mul(big.NewInt(50000), big.NewInt(1e18))
+	signer := testSigner(t)
+
+	cli := newClient(t)
+	txHash, err := cli.SetTargetEpochPayment(signer, target)
+	waitTx(t, cli, txHash, err)
 }
 
 func Test_getElectableValidators(t *testing.T) {
-	getElectableValidators(endpoint)
+	min, max, err := newClient(t).GetElectableValidators()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("minElectableValidators", min, "maxElectableValidators", max)
 }
 
 // INFO [08-26|16:55:35.641] getElectableValidators                   minElectableValidators=1 maxElectableValidators=100
 // INFO [08-26|17:00:42.247] getElectableValidators                   minElectableValidators=1 maxElectableValidators=50
 
 func Test_setElectableValidators(t *testing.T) {
-	from := common.HexToAddress("")
-	privateKey, err := crypto.ToECDSA(common.FromHex(""))
-	if err != nil {
-		t.Fatal(err)
-	}
+	signer := testSigner(t)
 	min := big.NewInt(1)
 	max := big.NewInt(100)
-	setElectableValidators(endpoint, from, privateKey, min, max)
+	cli := newClient(t)
+	txHash, err := cli.SetElectableValidators(signer, min, max)
+	waitTx(t, cli, txHash, err)
 }
 
 func Test_getCommissionUpdateDelay(t *testing.T) {
-	getCommissionUpdateDelay(endpoint)
+	value, err := newClient(t).GetCommissionUpdateDelay()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("delayBlock", value)
 }
 func Test_getBlockGasLimit(t *testing.T) {
-	getBlockGasLimit(endpoint)
-}
-func Test_setCommissionUpdateDelay(t *testing.T) {
-	from := common.HexToAddress("")
-	privateKey, err := crypto.ToECDSA(common.FromHex(""))
+	res, err := newClient(t).GetBlockGasLimit()
 	if err != nil {
 		t.Fatal(err)
 	}
+	t.Log("blockGasLimit", res)
+}
+func Test_setCommissionUpdateDelay(t *testing.T) {
+	signer := testSigner(t)
 	delayBlock := big.NewInt(10)
-	setCommissionUpdateDelay(endpoint, from, privateKey, delayBlock)
+	cli := newClient(t)
+	txHash, err := cli.SetCommissionUpdateDelay(signer, delayBlock)
+	waitTx(t, cli, txHash, err)
 }
 
 func Test_getUnlockingPeriod(t *testing.T) {
-	getUnlockingPeriod(endpoint)
-}
-
-func Test_setUnlockingPeriod(t *testing.T) {
-	from := common.HexToAddress("")
-	privateKey, err := crypto.ToECDSA(common.FromHex(""))
+	period, err := newClient(t).GetUnlockingPeriod()
 	if err != nil {
 		t.Fatal(err)
 	}
+	t.Log("period", period)
+}
+
+func Test_setUnlockingPeriod(t *testing.T) {
+	signer := testSigner(t)
 	period := big.NewInt(900)
-	setUnlockingPeriod(endpoint, from, privateKey, period)
+	cli := newClient(t)
+	txHash, err := cli.SetUnlockingPeriod(signer, period)
+	waitTx(t, cli, txHash, err)
 }
 
 func Test_getImplAddress(t *testing.T) {
 	proxyAddress := common.HexToAddress("")
-	getImplAddress(endpoint, proxyAddress)
+	impl, err := newClient(t).GetImplAddress(proxyAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("proxy", proxyAddress, "impl", impl)
 }
 func Test_setImplAddress(t *testing.T) {
 	proxyAddress := common.HexToAddress("0xcdB66B1e6A07279df98f804d0aCAC86695F4b99e")
 	implAddress := common.HexToAddress("0x40d1215e14A94be82902C5f1CC2a5d438641E4Ff")
-	from := common.HexToAddress("0xB3396Fef0cfC3A5d68b3Ef17e5016815af63102B")
-	privateKey, err := crypto.ToECDSA(common.FromHex(""))
-	if err != nil {
-		t.Fatal(err)
-	}
-	setImplAddress(endpoint, from, privateKey, proxyAddress, implAddress)
+	signer := testSigner(t)
+	cli := newClient(t)
+	txHash, err := cli.SetImplAddress(signer, proxyAddress, implAddress)
+	waitTx(t, cli, txHash, err)
 }
 
 func Test_isPendingDeRegisterValidator(t *testing.T) {
 	addr := "0x5d643dfb9ae372ce4fdbc80890156e2cd8290846"
-	isPendingDeRegisterValidator(endpoint, common.HexToAddress(addr))
+	result, err := newClient(t).IsPendingDeRegisterValidator(common.HexToAddress(addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("isPendingDeRegisterValidator", result)
 	//addrs := []string{"0x75f5a34cEB6CaB0f8e3A8fF9038ba972932F816A",
 	//	"0xa4a674C82E65ed0629C9532afD0bfdE9e6ddf6f3",
 	//	"0x8D3397d2Bd0496ef5F098d5dFaE858128fA7fB56",
@@ -127,17 +191,23 @@ func Test_isPendingDeRegisterValidator(t *testing.T) {
 	//	"0x85b629CA2794aB562c562fb4D51E8db98f6BE5b9"}
 	//
 	//for _, a := range addrs {
-	//	isPendingDeRegisterValidator(endpoint, common.HexToAddress(a))
+	//	cli.IsPendingDeRegisterValidator(common.HexToAddress(a))
 	//}
 
 }
 func Test_getActiveVotesForValidator(t *testing.T) {
 	height1, height2 := big.NewInt(2900000), big.NewInt(2950000)
 	addr1, addr2 := common.HexToAddress("0x44b39830a0215a0904137c4474927dcfd049acbb"), common.HexToAddress("0xdc9e2ea9c16c75e22b1aa904d6c94ca70d0c57f3")
-	getActiveVotesForValidator(endpoint, addr1, height1)
-	getActiveVotesForValidator(endpoint, addr1, height2)
-	getActiveVotesForValidator(endpoint, addr2, height1)
-	getActiveVotesForValidator(endpoint, addr2, height2)
+	cli := newClient(t)
+	for _, addr := range []common.Address{addr1, addr2} {
+		for _, height := range []*big.Int{height1, height2} {
+			res, err := cli.GetActiveVotesForValidator(addr, height)
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Log("validator", addr, "height", height, "val", ToCoin(res))
+		}
+	}
 }
 func TestBatchTransaction(t *testing.T) {
 	validatorFile, voterFile := "validator.csv", "voter.csv"
@@ -154,39 +224,32 @@ func TestBatchTransaction(t *testing.T) {
 			sum2 = sum2.Add(sum2, voter_value[i])
 		}
 	}
-	fmt.Println("sum0", sum0.String(), "sum1", sum1.String(), "sum2", sum2, toCoin(sum2).String())
+	fmt.Println("sum0", sum0.String(), "sum1", sum1.String(), "sum2", sum2, ToCoin(sum2).String())
 	fmt.Println("sum", sum0.Add(sum0, sum1).String())
 	fmt.Println("sum3", sum1.Sub(sum1, sum2).String())
 }
 func TestBatchValidators(t *testing.T) {
-	from := common.HexToAddress("0xe05665E26eb7da077B2AAeD5cDe1DB47dE6B4544")
-	privateKey, err := crypto.ToECDSA(common.FromHex(""))
-	if err != nil {
-		t.Fatal(err)
-	}
+	signer := testSigner(t)
 	validatorFile := "validator.csv"
 	validators := loadFilesForValidator2(validatorFile)
 	sum := big.NewInt(0)
+	transfers := newBatch(t)
 	fmt.Println(len(validators))
 	for to, balance := range validators {
 		fmt.Println(to, balance)
 		sum = sum.Add(sum, balance)
-		sendTransaction(endpoint, from, to, privateKey, balance)
-		balanceOf(endpoint, to)
-		time.Sleep(5 * time.Second)
+		transfers.send(signer, to, balance)
 	}
-	fmt.Println(sum.String(), toCoin(sum))
+	transfers.wait()
+	fmt.Println(sum.String(), ToCoin(sum))
 }
 func TestBatchVoters(t *testing.T) {
-	from := common.HexToAddress("0xe05665E26eb7da077B2AAeD5cDe1DB47dE6B4544")
-	privateKey, err := crypto.ToECDSA(common.FromHex(""))
-	if err != nil {
-		t.Fatal(err)
-	}
+	signer := testSigner(t)
 	voterFile := "voter.csv"
 	voters, voter_value := loadFilesForVoter2(voterFile)
 	addr0 := common.HexToAddress("0xc052261da7602245558b297c587a8545e67d1109")
 	sum, count, count2 := big.NewInt(0), 0, 0
+	transfers := newBatch(t)
 	fmt.Println(len(voters))
 	for i, to := range voters {
 		balance := voter_value[i]
@@ -197,13 +260,12 @@ func TestBatchVoters(t *testing.T) {
 			sum = sum.Add(sum, balance)
 			if balance.Sign() > 0 {
 				count2++
-				sendTransaction(endpoint, from, to, privateKey, balance)
-				balanceOf(endpoint, to)
-				time.Sleep(500 * time.Millisecond)
+				transfers.send(signer, to, balance)
 			}
 		}
 	}
-	fmt.Println("sum", sum.String(), toCoin(sum), "count", count, count2)
+	transfers.wait()
+	fmt.Println("sum", sum.String(), ToCoin(sum), "count", count, count2)
 }
 func loadFilesForValidator2(fileName string) map[common.Address]*big.Int {
 	fmt.Println("准备读取文件.....文件名:", fileName)
@@ -290,7 +352,7 @@ func handleRow1(record []string) (common.Address, *big.Int) {
 		panic(fmt.Errorf("NewFromString %v", e))
 	}
 	fmt.Println(v_addr, value, d.String())
-	return v_addr, toWei(d.BigFloat())
+	return v_addr, ToWei(d.BigFloat())
 }
 func handleRow1_0(record []string) (common.Address, *big.Int) {
 	v_addr := common.HexToAddress(record[2])
@@ -455,11 +517,7 @@ func TestMakeAddress(t *testing.T) {
 	fmt.Println("finish")
 }
 func Test03(t *testing.T) {
-	from := common.HexToAddress("0xd34D198B85B491F8b0E3b5Dac5a8c29D49b227E5")
-	privateKey, err := crypto.ToECDSA(common.FromHex(""))
-	if err != nil {
-		t.Fatal(err)
-	}
+	signer := testSigner(t)
 	addrs := []string{"0x2a0fc7506b248fEA4775004B6c33a348e9AEec69",
 		"0xFD7ff0b5f4446ae35468d1e599CaaebcbEfe88B0",
 		"0x7979b84dF6aA903e37B994d9198662eFFe38C68b",
@@ -469,21 +527,104 @@ func Test03(t *testing.T) {
 		tos = append(tos, common.HexToAddress(a))
 	}
 	balance := big.NewInt(20 * 1e9)
+	transfers := newBatch(t)
 	for _, to := range tos {
-		sendTransaction(endpoint, from, to, privateKey, balance)
-		balanceOf(endpoint, to)
-		time.Sleep(5 * time.Second)
+		transfers.send(signer, to, balance)
 	}
+	transfers.wait()
 }
 func Test04(t *testing.T) {
 	to := common.HexToAddress("0xe05665E26eb7da077B2AAeD5cDe1DB47dE6B4544")
-	balanceOf(endpoint, to)
+	b, err := newClient(t).BalanceOf(to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("balanceOf", to, "balance", b, "coin", ToCoin(b))
+}
+
+// batch pipelines transfers through one client, relying on its nonce
+// manager, and waits for all of them at the end.
+type batch struct {
+	t      *testing.T
+	cli    *Client
+	hashes []common.Hash
+	tos    []common.Address
+}
+
+func newBatch(t *testing.T) *batch {
+	return &batch{t: t, cli: newClient(t)}
+}
+
+func (b *batch) send(signer Signer, to common.Address, value *big.Int) {
+	txHash, err := b.cli.SendTransaction(signer, to, value)
+	if err != nil {
+		b.t.Fatal(err)
+	}
+	b.hashes = append(b.hashes, txHash)
+	b.tos = append(b.tos, to)
+}
+
+// wait waits for every sent transfer and logs the new balances.
+func (b *batch) wait() {
+	for i, txHash := range b.hashes {
+		waitTx(b.t, b.cli, txHash, nil)
+		balance, err := b.cli.BalanceOf(b.tos[i])
+		if err != nil {
+			b.t.Fatal(err)
+		}
+		b.t.Log("balanceOf", b.tos[i], "balance", balance, "coin", ToCoin(balance))
+	}
 }
 func Test_getAccountTotalLockedGold(t *testing.T) {
 	addr1 := common.HexToAddress("0x979b8ba0A9ddD4Bf4b71A555A6109ef770F778cB")
-	getAccountTotalLockedGold(endpoint, addr1, nil)
+	res, err := newClient(t).GetAccountTotalLockedGold(addr1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("getAccountTotalLockedGold", addr1, "val", ToCoin(res))
 }
 func Test_getAccountNonvotingLockedGold(t *testing.T) {
 	addr1 := common.HexToAddress("0x979b8ba0A9ddD4Bf4b71A555A6109ef770F778cB")
-	getAccountNonvotingLockedGold(endpoint, addr1, nil)
+	res, err := newClient(t).GetAccountNonvotingLockedGold(addr1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log("getAccountNonvotingLockedGold", addr1, "val", ToCoin(res))
+}
+
+func TestUnreachableEndpointReturnsError(t *testing.T) {
+	cli, err := Dial("http://127.0.0.1:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	if _, err := cli.GetMgrMaintainerAddress(); err == nil {
+		t.Fatal("expected an error from an unreachable endpoint")
+	}
+	if _, _, err := cli.GetElectableValidators(); err == nil {
+		t.Fatal("expected an error from an unreachable endpoint")
+	}
+}
+
+func TestPackMatchesEmbeddedABI(t *testing.T) {
+	election, err := contracts.NewElectionTransactor(GenesisAddresses["ElectionProxy"], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	min, max := big.NewInt(1), big.NewInt(100)
+	input, err := pack(election.SetElectableValidators(packOpts, min, max))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := abi.JSON(strings.NewReader(ElectionABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := parsed.Pack("setElectableValidators", min, max)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(input, want) {
+		t.Fatalf("calldata mismatch: have %x, want %x", input, want)
+	}
 }
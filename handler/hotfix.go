@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+// Hotfix is a list of actions the Governance contract executes without a
+// referendum once a quorum of validators whitelisted it and the approver
+// approved it. The salt tells apart hotfixes with the same actions.
+type Hotfix struct {
+	Actions []ProposalAction
+	Salt    [32]byte
+}
+
+// Pack returns the executeHotfix arguments other than the salt, laid out
+// like those of a proposal.
+func (h *Hotfix) Pack() (values []*big.Int, destinations []common.Address, data []byte, dataLengths []*big.Int) {
+	return (&Proposal{Actions: h.Actions}).Pack()
+}
+
+// Hash is the identifier executeHotfix derives from its arguments:
+// keccak256(abi.encode(values, destinations, data, dataLengths, salt)),
+// which is the hash of the executeHotfix call data after the selector.
+func (h *Hotfix) Hash() (common.Hash, error) {
+	if len(h.Actions) == 0 {
+		return common.Hash{}, fmt.Errorf("hotfix has no actions")
+	}
+	parsed, err := contracts.GovernanceMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	values, destinations, data, dataLengths := h.Pack()
+	encoded, err := parsed.Methods["executeHotfix"].Inputs.Pack(values, destinations, data, dataLengths, h.Salt)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// HotfixStatus is the progress of a hotfix towards execution.
+type HotfixStatus struct {
+	Hash          common.Hash
+	Approved      bool
+	Executed      bool
+	PreparedEpoch *big.Int
+	// Epoch is the current epoch; a hotfix executes in the epoch it was
+	// prepared in.
+	Epoch *big.Int
+	// Tally counts the validators of the current set that whitelisted the
+	// hotfix; it passes at Quorum.
+	Tally   *big.Int
+	Quorum  *big.Int
+	Passing bool
+	// Validators are the signers of the current validator set.
+	Validators []HotfixWhitelister
+}
+
+// HotfixWhitelister is a validator signer and whether it whitelisted the
+// hotfix. A validator may also whitelist from its account, which counts
+// towards Tally but is not reflected here.
+type HotfixWhitelister struct {
+	Signer      common.Address
+	Whitelisted bool
+}
+
+// Missing returns the validator signers that have not whitelisted the hotfix.
+func (s *HotfixStatus) Missing() []common.Address {
+	var missing []common.Address
+	for _, v := range s.Validators {
+		if !v.Whitelisted {
+			missing = append(missing, v.Signer)
+		}
+	}
+	return missing
+}
+
+// HotfixStatus reads the record, whitelist tally and validator whitelist of
+// hotfix hash.
+func (c *Client) HotfixStatus(hash common.Hash) (*HotfixStatus, error) {
+	governance, _, err := c.governanceAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &HotfixStatus{Hash: hash}
+	if s.Approved, s.Executed, s.PreparedEpoch, err = governance.GetHotfixRecord(nil, hash); err != nil {
+		return nil, fmt.Errorf("hotfix %s: %w", hash.Hex(), err)
+	}
+	if s.Epoch, err = governance.GetEpochNumber(nil); err != nil {
+		return nil, fmt.Errorf("epoch number: %w", err)
+	}
+	if s.Tally, err = governance.HotfixWhitelistValidatorTally(nil, hash); err != nil {
+		return nil, fmt.Errorf("hotfix %s tally: %w", hash.Hex(), err)
+	}
+	if s.Quorum, err = governance.MinQuorumSizeInCurrentSet(nil); err != nil {
+		return nil, fmt.Errorf("quorum: %w", err)
+	}
+	if s.Passing, err = governance.IsHotfixPassing(nil, hash); err != nil {
+		return nil, fmt.Errorf("hotfix %s passing: %w", hash.Hex(), err)
+	}
+	n, err := governance.NumberValidatorsInCurrentSet(nil)
+	if err != nil {
+		return nil, fmt.Errorf("validator set size: %w", err)
+	}
+	for i := int64(0); i < n.Int64(); i++ {
+		signer, err := governance.ValidatorSignerAddressFromCurrentSet(nil, big.NewInt(i))
+		if err != nil {
+			return nil, fmt.Errorf("validator %d of the current set: %w", i, err)
+		}
+		whitelisted, err := governance.IsHotfixWhitelistedBy(nil, hash, signer)
+		if err != nil {
+			return nil, fmt.Errorf("hotfix %s whitelist of %s: %w", hash.Hex(), signer.Hex(), err)
+		}
+		s.Validators = append(s.Validators, HotfixWhitelister{Signer: signer, Whitelisted: whitelisted})
+	}
+	return s, nil
+}
+
+// WhitelistHotfix whitelists hotfix hash from the signer, which should be a
+// validator account or signer of the current set to count towards the tally.
+func (c *Client) WhitelistHotfix(signer Signer, hash common.Hash) (common.Hash, error) {
+	governance, address, err := c.governanceAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	_, executed, _, err := governance.GetHotfixRecord(nil, hash)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("hotfix %s: %w", hash.Hex(), err)
+	}
+	if executed {
+		return common.Hash{}, fmt.Errorf("hotfix %s is already executed", hash.Hex())
+	}
+	whitelisted, err := governance.IsHotfixWhitelistedBy(nil, hash, signer.Address())
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("hotfix %s whitelist of %s: %w", hash.Hex(), signer.Address().Hex(), err)
+	}
+	if whitelisted {
+		return common.Hash{}, fmt.Errorf("%s already whitelisted hotfix %s", signer.Address().Hex(), hash.Hex())
+	}
+	input, err := pack(governance.WhitelistHotfix(packOpts, hash))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, address, nil, input, 0)
+}
+
+// ApproveHotfix approves hotfix hash. The signer must be the approver.
+func (c *Client) ApproveHotfix(signer Signer, hash common.Hash) (common.Hash, error) {
+	governance, address, err := c.governanceAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	approver, err := governance.Approver(nil)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("approver: %w", err)
+	}
+	if approver != signer.Address() {
+		return common.Hash{}, fmt.Errorf("only the approver %s can approve, not %s", approver.Hex(), signer.Address().Hex())
+	}
+	approved, executed, _, err := governance.GetHotfixRecord(nil, hash)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("hotfix %s: %w", hash.Hex(), err)
+	}
+	if executed {
+		return common.Hash{}, fmt.Errorf("hotfix %s is already executed", hash.Hex())
+	}
+	if approved {
+		return common.Hash{}, fmt.Errorf("hotfix %s is already approved", hash.Hex())
+	}
+	input, err := pack(governance.ApproveHotfix(packOpts, hash))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, address, nil, input, 0)
+}
+
+// PrepareHotfix prepares hotfix hash for execution in the current epoch. It
+// must be passing and not yet prepared in this epoch.
+func (c *Client) PrepareHotfix(signer Signer, hash common.Hash) (common.Hash, error) {
+	governance, address, err := c.governanceAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	s, err := c.HotfixStatus(hash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	switch {
+	case s.Executed:
+		return common.Hash{}, fmt.Errorf("hotfix %s is already executed", hash.Hex())
+	case !s.Passing:
+		return common.Hash{}, fmt.Errorf("hotfix %s is whitelisted by %v validators, %v are needed", hash.Hex(), s.Tally, s.Quorum)
+	case s.PreparedEpoch.Cmp(s.Epoch) >= 0:
+		return common.Hash{}, fmt.Errorf("hotfix %s is already prepared in epoch %v", hash.Hex(), s.PreparedEpoch)
+	}
+	input, err := pack(governance.PrepareHotfix(packOpts, hash))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, address, nil, input, 0)
+}
+
+// ExecuteHotfix executes h. It must be approved and prepared in the current
+// epoch.
+func (c *Client) ExecuteHotfix(signer Signer, h *Hotfix) (common.Hash, error) {
+	hash, err := h.Hash()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	governance, address, err := c.governanceAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	approved, executed, prepared, err := governance.GetHotfixRecord(nil, hash)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("hotfix %s: %w", hash.Hex(), err)
+	}
+	epoch, err := governance.GetEpochNumber(nil)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("epoch number: %w", err)
+	}
+	switch {
+	case executed:
+		return common.Hash{}, fmt.Errorf("hotfix %s is already executed", hash.Hex())
+	case !approved:
+		return common.Hash{}, fmt.Errorf("hotfix %s is not approved", hash.Hex())
+	case prepared.Cmp(epoch) != 0:
+		return common.Hash{}, fmt.Errorf("hotfix %s was prepared in epoch %v, not in the current epoch %v", hash.Hex(), prepared, epoch)
+	}
+	values, destinations, data, dataLengths := h.Pack()
+	input, err := pack(governance.ExecuteHotfix(packOpts, values, destinations, data, dataLengths, h.Salt))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, address, nil, input, 0)
+}
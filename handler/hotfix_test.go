@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestHotfixLifecycle(t *testing.T) {
+	signer := newTestSigner(t)
+	validators := []common.Address{common.HexToAddress("0xa1"), signer.Address(), common.HexToAddress("0xa3")}
+	whitelisted := map[common.Address]bool{validators[0]: true}
+	var (
+		approved, passing bool
+		prepared          int64
+	)
+	node := governanceNode(t, map[string]contractMethod{
+		"approver": returns(signer.Address()),
+		"getHotfixRecord": func([]interface{}) ([]interface{}, error) {
+			return []interface{}{approved, false, big.NewInt(prepared)}, nil
+		},
+		"getEpochNumber":                returns(big.NewInt(7)),
+		"hotfixWhitelistValidatorTally": returns(big.NewInt(1)),
+		"minQuorumSizeInCurrentSet":     returns(big.NewInt(2)),
+		"isHotfixPassing": func([]interface{}) ([]interface{}, error) {
+			return []interface{}{passing}, nil
+		},
+		"numberValidatorsInCurrentSet": returns(big.NewInt(int64(len(validators)))),
+		"validatorSignerAddressFromCurrentSet": func(args []interface{}) ([]interface{}, error) {
+			return []interface{}{validators[args[0].(*big.Int).Int64()]}, nil
+		},
+		"isHotfixWhitelistedBy": func(args []interface{}) ([]interface{}, error) {
+			return []interface{}{whitelisted[args[1].(common.Address)]}, nil
+		},
+	})
+	node.gasPrice = big.NewInt(1)
+	node.nonce = func(common.Address) uint64 { return 0 }
+	cli := newFakeClient(t, node)
+
+	action, err := cli.ParseAction("EpochRewards.setTargetEpochPayment 1000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Hotfix{Actions: []ProposalAction{action}, Salt: common.HexToHash("0x5a17")}
+	hash, err := h.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := cli.HotfixStatus(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if missing := s.Missing(); len(missing) != 2 || missing[0] != validators[1] || missing[1] != validators[2] {
+		t.Fatalf("have missing whitelisters %v", missing)
+	}
+	if _, err := cli.WhitelistHotfix(signer, hash); err != nil {
+		t.Fatal(err)
+	}
+	whitelisted[signer.Address()] = true
+	if _, err := cli.WhitelistHotfix(signer, hash); err == nil {
+		t.Fatal("expected a second whitelisting to be refused")
+	}
+
+	if _, err := cli.PrepareHotfix(signer, hash); err == nil {
+		t.Fatal("expected preparing a hotfix below quorum to be refused")
+	}
+	passing = true
+	if _, err := cli.PrepareHotfix(signer, hash); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.ApproveHotfix(signer, hash); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.ExecuteHotfix(signer, h); err == nil {
+		t.Fatal("expected executing an unapproved hotfix to be refused")
+	}
+	approved = true
+	if _, err := cli.ExecuteHotfix(signer, h); err == nil {
+		t.Fatal("expected executing an unprepared hotfix to be refused")
+	}
+	prepared = 7
+	if _, err := cli.PrepareHotfix(signer, hash); err == nil {
+		t.Fatal("expected preparing twice in an epoch to be refused")
+	}
+	if _, err := cli.ExecuteHotfix(signer, h); err != nil {
+		t.Fatal(err)
+	}
+	if have := crypto.Keccak256Hash(node.sent[len(node.sent)-1].Data()[4:]); have != hash {
+		t.Fatalf("executeHotfix arguments hash to %s, want %s", have.Hex(), hash.Hex())
+	}
+	if len(node.sent) != 4 {
+		t.Fatalf("have %d transactions, want whitelist, prepare, approve and execute", len(node.sent))
+	}
+}
@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ProposalStage mirrors the Proposals.Stage enum of the Governance contract.
+type ProposalStage uint8
+
+const (
+	StageNone ProposalStage = iota
+	StageQueued
+	StageApproval
+	StageReferendum
+	StageExecution
+	StageExpiration
+)
+
+var stageNames = []string{"None", "Queued", "Approval", "Referendum", "Execution", "Expiration"}
+
+func (s ProposalStage) String() string {
+	if int(s) < len(stageNames) {
+		return stageNames[s]
+	}
+	return fmt.Sprintf("Stage(%d)", uint8(s))
+}
+
+// ProposalInfo is a proposal as stored by the Governance contract.
+type ProposalInfo struct {
+	ID             *big.Int
+	Proposer       common.Address
+	Deposit        *big.Int
+	Timestamp      *big.Int
+	DescriptionURL string
+	Stage          ProposalStage
+	Transactions   []ProposalTx
+}
+
+// ProposalTx is one transaction of a proposal, decoded where possible.
+type ProposalTx struct {
+	Value       *big.Int
+	Destination common.Address
+	Data        []byte
+
+	// Contract names the destination, empty if it is not a known contract.
+	// A name guessed from the call data alone ends in "?".
+	Contract string
+	// Method and Args are set when Data decodes against an embedded ABI.
+	Method string
+	Args   []ProposalArg
+	// DecodeErr says why Data could not be decoded. It is nil for a plain
+	// transfer without data.
+	DecodeErr error
+}
+
+// ProposalArg is a decoded call argument.
+type ProposalArg struct {
+	Name  string
+	Type  string
+	Value interface{}
+}
+
+// Proposal reads proposal id and decodes its transactions.
+func (c *Client) Proposal(id *big.Int) (*ProposalInfo, error) {
+	governance, _, err := c.governanceAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	proposer, deposit, timestamp, count, url, err := governance.GetProposal(nil, id)
+	if err != nil {
+		return nil, fmt.Errorf("proposal %v: %w", id, err)
+	}
+	if proposer == (common.Address{}) {
+		return nil, fmt.Errorf("proposal %v does not exist", id)
+	}
+	stage, err := stageOf(governance, nil, id)
+	if err != nil {
+		return nil, err
+	}
+	info := &ProposalInfo{
+		ID:             id,
+		Proposer:       proposer,
+		Deposit:        deposit,
+		Timestamp:      timestamp,
+		DescriptionURL: url,
+		Stage:          stage,
+	}
+
+	names := c.contractNames()
+	for i := int64(0); i < count.Int64(); i++ {
+		value, destination, data, err := governance.GetProposalTransaction(nil, id, big.NewInt(i))
+		if err != nil {
+			return nil, fmt.Errorf("proposal %v transaction %d: %w", id, i, err)
+		}
+		tx := ProposalTx{Value: value, Destination: destination, Data: data, Contract: names[destination]}
+		tx.decode()
+		info.Transactions = append(info.Transactions, tx)
+	}
+	return info, nil
+}
+
+// contractNames maps the addresses of the system contracts to their names:
+// registry identifiers for the contracts with an embedded ABI, and the
+// GenesisAddresses names for the others.
+func (c *Client) contractNames() map[common.Address]string {
+	names := make(map[common.Address]string)
+	for name, a := range GenesisAddresses {
+		names[a] = strings.TrimSuffix(name, ProxyID)
+	}
+	for _, contract := range embeddedContracts {
+		if contract.name == ProxyID {
+			continue
+		}
+		if a, err := c.resolver.Resolve(contract.name, nil); err == nil {
+			names[a] = contract.name
+		}
+	}
+	return names
+}
+
+// decode fills in Method and Args from Data. The destination's own ABI is
+// tried first, then the Proxy ABI since every system contract sits behind
+// a proxy; a call to an unknown destination is matched against all
+// embedded ABIs.
+func (tx *ProposalTx) decode() {
+	if len(tx.Data) == 0 {
+		return
+	}
+	var candidates []string
+	if tx.Contract != "" {
+		candidates = append(candidates, tx.Contract, ProxyID)
+	} else {
+		for _, contract := range embeddedContracts {
+			candidates = append(candidates, contract.name)
+		}
+	}
+	for _, name := range candidates {
+		_, parsed, err := embeddedABI(name)
+		if err != nil {
+			continue
+		}
+		method, args, err := decodeCall(parsed, tx.Data)
+		if err != nil {
+			if tx.DecodeErr == nil {
+				tx.DecodeErr = err
+			}
+			continue
+		}
+		tx.Method, tx.DecodeErr = method.Name, nil
+		if tx.Contract == "" {
+			tx.Contract = name + "?"
+		}
+		for i, input := range method.Inputs {
+			tx.Args = append(tx.Args, ProposalArg{Name: input.Name, Type: input.Type.String(), Value: args[i]})
+		}
+		return
+	}
+}
+
+// decodeCall decodes calldata against parsed. The arguments must re-encode
+// to exactly the same bytes so that trailing or malformed data is caught.
+func decodeCall(parsed *abi.ABI, data []byte) (*abi.Method, []interface{}, error) {
+	if len(data) < 4 {
+		return nil, nil, fmt.Errorf("call data shorter than a selector")
+	}
+	method, err := parsed.MethodById(data[:4])
+	if err != nil {
+		return nil, nil, fmt.Errorf("unknown selector 0x%x", data[:4])
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", method.Sig, err)
+	}
+	repacked, err := method.Inputs.Pack(args...)
+	if err != nil || !bytes.Equal(repacked, data[4:]) {
+		return nil, nil, fmt.Errorf("%s: arguments do not match the call data", method.Sig)
+	}
+	return method, args, nil
+}
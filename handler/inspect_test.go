@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestProposalDecodesTransactions(t *testing.T) {
+	impl := common.HexToAddress("0x40d1215e14A94be82902C5f1CC2a5d438641E4Ff")
+	_, epochRewards, _ := embeddedABI(EpochRewardsID)
+	_, proxy, _ := embeddedABI(ProxyID)
+	setPayment, _ := epochRewards.Pack("setTargetEpochPayment", big.NewInt(1000))
+	setImpl, _ := proxy.Pack("_setImplementation", impl)
+
+	type proposalTx struct {
+		destination common.Address
+		data        []byte
+	}
+	txs := []proposalTx{
+		{GenesisAddresses["EpochRewardsProxy"], setPayment},
+		{GenesisAddresses["ElectionProxy"], setImpl},
+		{GenesisAddresses["GoldTokenProxy"], []byte{0xde, 0xad, 0xbe, 0xef}},
+		{GenesisAddresses["EpochRewardsProxy"], setPayment[:20]},
+		{common.HexToAddress("0x99"), setPayment},
+	}
+	proposer := common.HexToAddress("0x01")
+	node := governanceNode(t, map[string]contractMethod{
+		"getProposal": func(args []interface{}) ([]interface{}, error) {
+			if args[0].(*big.Int).Int64() != 7 {
+				return []interface{}{common.Address{}, new(big.Int), new(big.Int), new(big.Int), ""}, nil
+			}
+			return []interface{}{proposer, big.NewInt(100), big.NewInt(1600000000), big.NewInt(int64(len(txs))), "https://example.org/7"}, nil
+		},
+		"getProposalStage": returns(uint8(StageReferendum)),
+		"getProposalTransaction": func(args []interface{}) ([]interface{}, error) {
+			tx := txs[args[1].(*big.Int).Int64()]
+			return []interface{}{new(big.Int), tx.destination, tx.data}, nil
+		},
+	})
+	cli := newFakeClient(t, node)
+
+	info, err := cli.Proposal(big.NewInt(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Proposer != proposer || info.Stage != StageReferendum || info.DescriptionURL != "https://example.org/7" || len(info.Transactions) != len(txs) {
+		t.Fatalf("have %+v", info)
+	}
+
+	want := []struct {
+		contract, method string
+		decoded          bool
+	}{
+		{EpochRewardsID, "setTargetEpochPayment", true},
+		{ElectionID, "_setImplementation", true},
+		{"GoldToken", "", false},
+		{EpochRewardsID, "", false},
+		{EpochRewardsID + "?", "setTargetEpochPayment", true},
+	}
+	for i, w := range want {
+		tx := info.Transactions[i]
+		if tx.Contract != w.contract || tx.Method != w.method || (tx.DecodeErr == nil) != w.decoded {
+			t.Errorf("transaction %d: have %s.%s err %v, want %s.%s", i, tx.Contract, tx.Method, tx.DecodeErr, w.contract, w.method)
+		}
+	}
+	if arg := info.Transactions[1].Args[0]; arg.Value.(common.Address) != impl {
+		t.Fatalf("have argument %+v, want %s", arg, impl.Hex())
+	}
+
+	if _, err := cli.Proposal(big.NewInt(8)); err == nil {
+		t.Fatal("expected a missing proposal to be reported")
+	}
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// LeaderboardEntry is a validator ranked by the votes it holds.
+type LeaderboardEntry struct {
+	Rank      int
+	Validator common.Address
+	Votes     *big.Int
+	Eligible  bool
+	// Elected is set when the validator would be elected in the next epoch.
+	Elected bool
+	// Marginal marks the last validator elected and the first eligible one
+	// left out. Margin is then how many votes the first holds above the
+	// cut, or how many the second lacks to reach it.
+	Marginal bool
+	Margin   *big.Int
+}
+
+// Leaderboard ranks the registered and eligible validators by votes and
+// simulates the next election.
+type Leaderboard struct {
+	Entries      []LeaderboardEntry
+	TotalVotes   *big.Int
+	MinElectable *big.Int
+	MaxElectable *big.Int
+	// ElectabilityThreshold is the fixidity fraction of TotalVotes an
+	// eligible validator needs, RequiredVotes the votes that takes.
+	ElectabilityThreshold *big.Int
+	RequiredVotes         *big.Int
+	// Elected is the number of validators the simulation elects. The
+	// election fails when it is below MinElectable.
+	Elected int
+	// Signers are the signers electValidatorSigners returns, and ElectErr
+	// the revert if the call reverted.
+	Signers  []common.Address
+	ElectErr error
+}
+
+// Leaderboard reads the votes of every eligible and registered validator
+// and elects, as Election does, the eligible validators with at least the
+// required votes, most votes first, up to the maximum electable.
+func (c *Client) Leaderboard() (*Leaderboard, error) {
+	election, _, err := c.electionAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	validators, _, err := c.validatorsAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	eligible, err := c.EligibleVotes()
+	if err != nil {
+		return nil, err
+	}
+	registered, err := validators.GetRegisteredValidators(nil)
+	if err != nil {
+		return nil, fmt.Errorf("registered validators: %w", err)
+	}
+	b := new(Leaderboard)
+	if b.MinElectable, b.MaxElectable, err = c.GetElectableValidators(); err != nil {
+		return nil, fmt.Errorf("electable validators: %w", err)
+	}
+	if b.ElectabilityThreshold, err = election.GetElectabilityThreshold(nil); err != nil {
+		return nil, fmt.Errorf("electability threshold: %w", err)
+	}
+	if b.TotalVotes, err = election.GetTotalVotes(nil); err != nil {
+		return nil, fmt.Errorf("total votes: %w", err)
+	}
+
+	seen := make(map[common.Address]bool)
+	for _, v := range eligible {
+		seen[v.Validator] = true
+		b.Entries = append(b.Entries, LeaderboardEntry{Validator: v.Validator, Votes: v.Votes, Eligible: true})
+	}
+	for _, v := range registered {
+		if seen[v] {
+			continue
+		}
+		votes, err := election.GetTotalVotesForValidator(nil, v)
+		if err != nil {
+			return nil, fmt.Errorf("votes for %s: %w", v.Hex(), err)
+		}
+		b.Entries = append(b.Entries, LeaderboardEntry{Validator: v, Votes: votes})
+	}
+	b.elect()
+
+	if b.Signers, err = election.ElectValidatorSigners(nil); err != nil {
+		var revert *RevertError
+		if !errors.As(err, &revert) {
+			return nil, fmt.Errorf("elect validator signers: %w", err)
+		}
+		b.Signers, b.ElectErr = nil, err
+	}
+	return b, nil
+}
+
+// elect ranks the entries and marks the elected and marginal validators.
+func (b *Leaderboard) elect() {
+	sort.SliceStable(b.Entries, func(i, j int) bool { return b.Entries[i].Votes.Cmp(b.Entries[j].Votes) > 0 })
+	b.RequiredVotes = fixedMul(b.ElectabilityThreshold, b.TotalVotes)
+
+	var last, next *LeaderboardEntry
+	for i := range b.Entries {
+		e := &b.Entries[i]
+		e.Rank = i + 1
+		if !e.Eligible {
+			continue
+		}
+		if int64(b.Elected) < b.MaxElectable.Int64() && e.Votes.Cmp(b.RequiredVotes) >= 0 {
+			e.Elected = true
+			b.Elected++
+			last = e
+		} else if next == nil {
+			next = e
+		}
+	}
+
+	// The cut is what the runner up has to beat: the last seat if all are
+	// taken, the required votes otherwise.
+	cut := b.RequiredVotes
+	if last != nil && int64(b.Elected) == b.MaxElectable.Int64() && last.Votes.Cmp(cut) > 0 {
+		cut = last.Votes
+	}
+	if next != nil {
+		next.Marginal = true
+		next.Margin = new(big.Int).Sub(cut, next.Votes)
+	}
+	if last != nil {
+		floor := b.RequiredVotes
+		if next != nil && next.Votes.Cmp(floor) > 0 {
+			floor = next.Votes
+		}
+		last.Marginal = true
+		last.Margin = new(big.Int).Sub(last.Votes, floor)
+	}
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+func TestLeaderboard(t *testing.T) {
+	a, b, c, d, e := common.HexToAddress("0xa"), common.HexToAddress("0xb"), common.HexToAddress("0xc"),
+		common.HexToAddress("0xd"), common.HexToAddress("0xe")
+	var electErr error
+	node := registryNode(t, map[string]common.Address{
+		ElectionID:   GenesisAddresses["ElectionProxy"],
+		ValidatorsID: GenesisAddresses["ValidatorsProxy"],
+	})
+	election, _ := contracts.ElectionMetaData.GetAbi()
+	node.serve(t, GenesisAddresses["ElectionProxy"], election, map[string]contractMethod{
+		"getTotalVotesForEligibleValidators": returns([]common.Address{a, b, c, d},
+			[]*big.Int{big.NewInt(500), big.NewInt(300), big.NewInt(200), big.NewInt(40)}),
+		"getTotalVotesForValidator": returns(big.NewInt(250)),
+		"electableValidators":       returns(big.NewInt(1), big.NewInt(2)),
+		"getElectabilityThreshold":  returns(fixidity("0.05")),
+		"getTotalVotes":             returns(big.NewInt(1290)),
+		"electValidatorSigners": func([]interface{}) ([]interface{}, error) {
+			if electErr != nil {
+				return nil, electErr
+			}
+			return []interface{}{[]common.Address{common.HexToAddress("0x5a"), common.HexToAddress("0x5b")}}, nil
+		},
+	})
+	validators, _ := contracts.ValidatorsMetaData.GetAbi()
+	node.serve(t, GenesisAddresses["ValidatorsProxy"], validators, map[string]contractMethod{
+		"getRegisteredValidators": returns([]common.Address{a, b, c, d, e}),
+	})
+	cli := newFakeClient(t, node)
+
+	board, err := cli.Leaderboard()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		validator                   common.Address
+		eligible, elected, marginal bool
+		margin                      int64
+	}{
+		{a, true, true, false, 0},
+		{b, true, true, true, 100},
+		{e, false, false, false, 0},
+		{c, true, false, true, 100},
+		{d, true, false, false, 0},
+	}
+	if len(board.Entries) != len(want) || board.Elected != 2 || board.RequiredVotes.Int64() != 64 || len(board.Signers) != 2 {
+		t.Fatalf("have leaderboard %+v", board)
+	}
+	for i, w := range want {
+		have := board.Entries[i]
+		if have.Rank != i+1 || have.Validator != w.validator || have.Eligible != w.eligible || have.Elected != w.elected ||
+			have.Marginal != w.marginal || (w.marginal && have.Margin.Int64() != w.margin) {
+			t.Errorf("rank %d: have %+v, want %+v", i+1, have, w)
+		}
+	}
+
+	electErr = revertingNode{revertData(t, "Error(string)", "string", "Not enough elected validators")}
+	if board, err = cli.Leaderboard(); err != nil {
+		t.Fatal(err)
+	}
+	if board.ElectErr == nil || board.Signers != nil {
+		t.Fatalf("have signers %v, err %v", board.Signers, board.ElectErr)
+	}
+
+	// With seats to spare the runner up only has to reach the required votes.
+	board.MaxElectable, board.ElectabilityThreshold, board.Elected = big.NewInt(5), fixidity("0.1"), 0
+	for i := range board.Entries {
+		board.Entries[i].Elected, board.Entries[i].Marginal = false, false
+	}
+	board.elect()
+	if board.Elected != 3 || !board.Entries[3].Marginal || board.Entries[3].Margin.Int64() != 71 ||
+		!board.Entries[4].Marginal || board.Entries[4].Margin.Int64() != 89 {
+		t.Fatalf("have %d elected, entries %+v", board.Elected, board.Entries)
+	}
+}
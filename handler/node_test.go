@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// fakeNode is an in-process stand-in for the eth JSON-RPC namespace so the
+// handler can be tested without a live endpoint.
+type fakeNode struct {
+	mu    sync.Mutex
+	calls int
+	sent  []*types.Transaction
+
+	// call answers eth_call; block is "latest" or a hex number.
+	call func(to common.Address, data []byte, block string) ([]byte, error)
+	// nonce answers eth_getTransactionCount for the pending block.
+	nonce func(account common.Address) uint64
+	// send may reject a raw transaction before it is recorded in sent.
+	send func(tx *types.Transaction) error
+	// estimate answers eth_estimateGas, 21000 when nil.
+	estimate func(args callArgs) (uint64, error)
+
+	gasPrice  *big.Int
+	gasTipCap *big.Int
+	baseFee   *big.Int // nil for a chain without EIP-1559
+	// time is the timestamp of the latest block.
+	time uint64
+
+	// head answers eth_blockNumber; txs are the transactions the node
+	// knows about and receipts those of the mined ones.
+	head     uint64
+	txs      map[common.Hash]*types.Transaction
+	receipts map[common.Hash]*types.Receipt
+}
+
+type callArgs struct {
+	From      *common.Address `json:"from"`
+	To        *common.Address `json:"to"`
+	Data      hexutil.Bytes   `json:"data"`
+	Gas       *hexutil.Uint64 `json:"gas"`
+	Value     *hexutil.Big    `json:"value"`
+	GasPrice  *hexutil.Big    `json:"gasPrice"`
+	GasTipCap *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	GasFeeCap *hexutil.Big    `json:"maxFeePerGas"`
+}
+
+func (n *fakeNode) Call(args callArgs, block string) (hexutil.Bytes, error) {
+	n.mu.Lock()
+	n.calls++
+	n.mu.Unlock()
+	return n.call(*args.To, args.Data, block)
+}
+
+// newFakeClient returns a Client served by node.
+func newFakeClient(t *testing.T, node *fakeNode) *Client {
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", node); err != nil {
+		t.Fatal(err)
+	}
+	cli, err := NewClient(rpc.DialInProc(server))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		cli.Close()
+		server.Stop()
+	})
+	return cli
+}
+
+func (n *fakeNode) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(fakeChainID))
+}
+
+func (n *fakeNode) GetTransactionCount(account common.Address, block string) hexutil.Uint64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return hexutil.Uint64(n.nonce(account))
+}
+
+func (n *fakeNode) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return common.Hash{}, err
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.send != nil {
+		if err := n.send(tx); err != nil {
+			return common.Hash{}, err
+		}
+	}
+	n.sent = append(n.sent, tx)
+	return tx.Hash(), nil
+}
+
+func (n *fakeNode) GasPrice() *hexutil.Big {
+	return (*hexutil.Big)(n.gasPrice)
+}
+
+func (n *fakeNode) MaxPriorityFeePerGas() *hexutil.Big {
+	return (*hexutil.Big)(n.gasTipCap)
+}
+
+func (n *fakeNode) EstimateGas(args callArgs) (hexutil.Uint64, error) {
+	if n.estimate == nil {
+		return 21000, nil
+	}
+	gas, err := n.estimate(args)
+	return hexutil.Uint64(gas), err
+}
+
+func (n *fakeNode) GetBlockByNumber(block string, full bool) map[string]interface{} {
+	head := map[string]interface{}{"number": "0x1", "timestamp": hexutil.Uint64(n.time)}
+	if n.baseFee != nil {
+		head["baseFeePerGas"] = (*hexutil.Big)(n.baseFee)
+	}
+	return head
+}
+
+func (n *fakeNode) BlockNumber() hexutil.Uint64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return hexutil.Uint64(n.head)
+}
+
+func (n *fakeNode) GetTransactionByHash(hash common.Hash) (map[string]interface{}, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	tx, ok := n.txs[hash]
+	if !ok {
+		return nil, nil
+	}
+	enc, err := tx.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(enc, &fields); err != nil {
+		return nil, err
+	}
+	if receipt, ok := n.receipts[hash]; ok {
+		fields["blockNumber"] = (*hexutil.Big)(receipt.BlockNumber)
+		fields["blockHash"] = receipt.BlockHash
+	}
+	return fields, nil
+}
+
+func (n *fakeNode) GetTransactionReceipt(hash common.Hash) *types.Receipt {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.receipts[hash]
+}
+
+// pend records tx as waiting in the pool.
+func (n *fakeNode) pend(tx *types.Transaction) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.txs == nil {
+		n.txs = make(map[common.Hash]*types.Transaction)
+		n.receipts = make(map[common.Hash]*types.Receipt)
+	}
+	n.txs[tx.Hash()] = tx
+}
+
+// mine records tx as included in block with status.
+func (n *fakeNode) mine(tx *types.Transaction, block uint64, status uint64) {
+	n.pend(tx)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.receipts[tx.Hash()] = &types.Receipt{
+		Status:      status,
+		Logs:        []*types.Log{},
+		TxHash:      tx.Hash(),
+		GasUsed:     21000,
+		BlockHash:   common.BigToHash(new(big.Int).SetUint64(block)),
+		BlockNumber: new(big.Int).SetUint64(block),
+	}
+}
+
+// contractMethod answers an eth_call of one contract method with the
+// unpacked arguments.
+type contractMethod func(args []interface{}) ([]interface{}, error)
+
+// serve routes eth_calls to a, decoded against parsed, to methods and
+// passes calls to other addresses on to the previous call hook.
+func (n *fakeNode) serve(t *testing.T, a common.Address, parsed *abi.ABI, methods map[string]contractMethod) {
+	next := n.call
+	n.call = func(to common.Address, data []byte, block string) ([]byte, error) {
+		if to != a {
+			if next == nil {
+				t.Fatalf("unexpected call to %s", to.Hex())
+			}
+			return next(to, data, block)
+		}
+		method, err := parsed.MethodById(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		answer, ok := methods[method.Name]
+		if !ok {
+			t.Fatalf("unexpected call of %s on %s", method.Name, to.Hex())
+		}
+		args, err := method.Inputs.Unpack(data[4:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := answer(args)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(out...)
+	}
+}
+
+// returns answers every call with outs.
+func returns(outs ...interface{}) contractMethod {
+	return func([]interface{}) ([]interface{}, error) { return outs, nil }
+}
+
+// newTestSigner returns a signer for a fresh key.
+func newTestSigner(t *testing.T) Signer {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewKeySigner(key)
+}
+
+const fakeChainID = 22776
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NonceSource returns the next nonce of an account as seen by the node.
+// *ethclient.Client satisfies it.
+type NonceSource interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// NonceManager hands out account nonces locally so that many transactions
+// can be sent back to back, or from several goroutines, without waiting for
+// each one to reach the pool. An account is synced from the node on first
+// use and again after Resync or after an unused nonce left a gap.
+type NonceManager struct {
+	source NonceSource
+
+	mu   sync.Mutex
+	next map[common.Address]uint64
+}
+
+// NewNonceManager creates a manager syncing from source.
+func NewNonceManager(source NonceSource) *NonceManager {
+	return &NonceManager{source: source, next: make(map[common.Address]uint64)}
+}
+
+// Next allocates the next nonce of account.
+func (m *NonceManager) Next(account common.Address) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	nonce, ok := m.next[account]
+	if !ok {
+		var err error
+		if nonce, err = m.source.PendingNonceAt(context.Background(), account); err != nil {
+			return 0, err
+		}
+	}
+	m.next[account] = nonce + 1
+	return nonce, nil
+}
+
+// Release returns a nonce that was allocated but never reached the node.
+// If it was the latest allocation it is simply handed out again, otherwise
+// later nonces are already in flight and the account is resynced so the
+// node's pending nonce fills the gap.
+func (m *NonceManager) Release(account common.Address, nonce uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if next, ok := m.next[account]; ok && next == nonce+1 {
+		m.next[account] = nonce
+		return
+	}
+	delete(m.next, account)
+}
+
+// Resync drops the local state of account so the next allocation asks the
+// node again.
+func (m *NonceManager) Resync(account common.Address) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.next, account)
+}
+
+// isNonceError reports whether the node rejected a transaction because its
+// nonce is already used, meaning the local nonce state is stale.
+func isNonceError(err error) bool {
+	return strings.Contains(err.Error(), "nonce too low")
+}
+
+// isKnownTxError reports whether the node rejected a transaction because
+// this very transaction is already in its pool, which counts as sent.
+func isKnownTxError(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "already known") || strings.Contains(msg, "known transaction")
+}
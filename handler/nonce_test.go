@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type stubNonceSource struct {
+	nonce uint64
+	calls int
+}
+
+func (s *stubNonceSource) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	s.calls++
+	return s.nonce, nil
+}
+
+func TestNonceManagerAllocatesLocally(t *testing.T) {
+	source := &stubNonceSource{nonce: 7}
+	m := NewNonceManager(source)
+	account := common.HexToAddress("0x01")
+
+	var wg sync.WaitGroup
+	seen := make(chan uint64, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce, err := m.Next(account)
+			if err != nil {
+				t.Error(err)
+			}
+			seen <- nonce
+		}()
+	}
+	wg.Wait()
+	close(seen)
+
+	used := make(map[uint64]bool)
+	for nonce := range seen {
+		if used[nonce] || nonce < 7 || nonce >= 27 {
+			t.Fatalf("unexpected nonce %d", nonce)
+		}
+		used[nonce] = true
+	}
+	if source.calls != 1 {
+		t.Fatalf("have %d syncs, want 1", source.calls)
+	}
+}
+
+func TestNonceManagerRelease(t *testing.T) {
+	source := &stubNonceSource{nonce: 3}
+	m := NewNonceManager(source)
+	account := common.HexToAddress("0x01")
+
+	n3, _ := m.Next(account)
+	m.Release(account, n3)
+	if again, _ := m.Next(account); again != n3 {
+		t.Fatalf("released latest nonce not reused: have %d, want %d", again, n3)
+	}
+
+	// Releasing a nonce with later ones in flight leaves a gap the node
+	// has to fill, so the next allocation syncs again.
+	m.Next(account)
+	m.Release(account, n3)
+	source.nonce = 3
+	if nonce, _ := m.Next(account); nonce != 3 || source.calls != 2 {
+		t.Fatalf("have nonce %d after %d syncs, want 3 after 2", nonce, source.calls)
+	}
+}
+
+func TestSendResyncsOnStaleNonce(t *testing.T) {
+	signer := newTestSigner(t)
+	to := common.HexToAddress("0x02")
+
+	node := &fakeNode{nonce: func(common.Address) uint64 { return 0 }, gasPrice: big.NewInt(1e9)}
+	node.send = func(tx *types.Transaction) error {
+		if tx.Nonce() < uint64(len(node.sent)) {
+			return errors.New("nonce too low")
+		}
+		return nil
+	}
+	cli := newFakeClient(t, node)
+
+	// Pipeline three transfers without waiting in between.
+	for i := 0; i < 3; i++ {
+		if _, err := cli.SendTransaction(signer, to, big.NewInt(1)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Another sender used nonce 3 behind our back.
+	node.sent = append(node.sent, nil)
+	node.nonce = func(common.Address) uint64 { return 4 }
+	if _, err := cli.SendTransaction(signer, to, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []uint64{0, 1, 2, 4}
+	var have []uint64
+	for _, tx := range node.sent {
+		if tx != nil {
+			have = append(have, tx.Nonce())
+		}
+	}
+	if len(have) != len(want) {
+		t.Fatalf("have nonces %v, want %v", have, want)
+	}
+	for i := range want {
+		if have[i] != want[i] {
+			t.Fatalf("have nonces %v, want %v", have, want)
+		}
+	}
+}
+
+func TestSendAlreadyKnown(t *testing.T) {
+	signer := newTestSigner(t)
+	node := &fakeNode{nonce: func(common.Address) uint64 { return 0 }, gasPrice: big.NewInt(1e9)}
+	var attempts []*types.Transaction
+	node.send = func(tx *types.Transaction) error {
+		attempts = append(attempts, tx)
+		return errors.New("already known")
+	}
+	cli := newFakeClient(t, node)
+
+	hash, err := cli.SendTransaction(signer, common.HexToAddress("0x02"), big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attempts) != 1 || hash != attempts[0].Hash() {
+		t.Fatalf("have %d sends and hash %s, want the one transaction already in the pool", len(attempts), hash.Hex())
+	}
+	node.send = nil
+	if _, err := cli.SendTransaction(signer, common.HexToAddress("0x02"), big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	if nonce := node.sent[len(node.sent)-1].Nonce(); nonce != 1 {
+		t.Fatalf("have nonce %d after a known transaction, want 1", nonce)
+	}
+}
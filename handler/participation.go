@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Participation is the turnout of a referendum measured the way Governance
+// measures it. Fractions are fixidity values.
+type Participation struct {
+	ID    *big.Int
+	Votes *VoteTotals
+	// TotalLocked is the total locked gold. Governance weighs a proposal
+	// against the total when it was dequeued, so the figures below drift
+	// as gold is locked and unlocked during the referendum.
+	TotalLocked *big.Int
+	// Participation is the fraction of TotalLocked that has voted.
+	Participation *big.Int
+
+	Baseline     *big.Int
+	Floor        *big.Int
+	UpdateFactor *big.Int
+	QuorumFactor *big.Int
+	// Quorum is the participation needed, Baseline times QuorumFactor, and
+	// QuorumVotes the votes it takes. Votes missing to reach it count as no.
+	Quorum      *big.Int
+	QuorumVotes *big.Int
+
+	// Support is the fraction of yes votes, with the votes missing to reach
+	// the quorum counted as no. The proposal passes when Support is above
+	// Threshold, the strictest constitution threshold of its transactions.
+	Support   *big.Int
+	Threshold *big.Int
+	// Passing is the outcome reported by Governance.
+	Passing bool
+
+	// NextBaseline is the participation baseline once the proposal is
+	// executed.
+	NextBaseline *big.Int
+}
+
+// QuorumReached tells whether enough votes were cast to meet the quorum.
+func (p *Participation) QuorumReached() bool {
+	return p.Votes.total().Cmp(p.QuorumVotes) >= 0
+}
+
+// WouldPass tells whether Support is above Threshold, which is what
+// Governance checks when the proposal is executed.
+func (p *Participation) WouldPass() bool {
+	return p.Support.Cmp(p.Threshold) > 0
+}
+
+func (v *VoteTotals) total() *big.Int {
+	total := new(big.Int).Add(v.Yes, v.No)
+	return total.Add(total, v.Abstain)
+}
+
+// Participation combines the vote totals of proposal id with the total
+// locked gold and the participation parameters.
+func (c *Client) Participation(id *big.Int) (*Participation, error) {
+	governance, _, err := c.governanceAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	lockedGold, _, err := c.lockedGoldAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	info, err := c.Proposal(id)
+	if err != nil {
+		return nil, err
+	}
+	p := &Participation{ID: id, Threshold: new(big.Int).Rsh(fixed1, 1)}
+	if p.Votes, err = c.VoteTotals(id); err != nil {
+		return nil, err
+	}
+	if p.TotalLocked, err = lockedGold.GetTotalLockedGold(nil); err != nil {
+		return nil, fmt.Errorf("total locked gold: %w", err)
+	}
+	if p.Baseline, p.Floor, p.UpdateFactor, p.QuorumFactor, err = governance.GetParticipationParameters(nil); err != nil {
+		return nil, fmt.Errorf("participation parameters: %w", err)
+	}
+	if p.Passing, err = governance.IsProposalPassing(nil, id); err != nil {
+		return nil, fmt.Errorf("proposal %v passing: %w", id, err)
+	}
+	for _, tx := range info.Transactions {
+		var functionID [4]byte
+		copy(functionID[:], tx.Data)
+		threshold, err := c.GetConstitution(tx.Destination, functionID)
+		if err != nil {
+			return nil, fmt.Errorf("constitution of %s %x: %w", tx.Destination.Hex(), functionID, err)
+		}
+		if threshold.Cmp(p.Threshold) > 0 {
+			p.Threshold = threshold
+		}
+	}
+	p.compute()
+	return p, nil
+}
+
+// compute derives the participation, quorum, support and next baseline as
+// Governance does.
+func (p *Participation) compute() {
+	total := p.Votes.total()
+	p.Participation = new(big.Int)
+	if p.TotalLocked.Sign() > 0 {
+		p.Participation = fixedFraction(total, p.TotalLocked)
+	}
+	p.Quorum = fixedMul(p.Baseline, p.QuorumFactor)
+	p.QuorumVotes = fixedMul(p.Quorum, p.TotalLocked)
+
+	p.Support = new(big.Int)
+	if p.Votes.Yes.Sign() > 0 {
+		no := new(big.Int).Set(p.Votes.No)
+		if p.QuorumVotes.Cmp(total) > 0 {
+			no.Add(no, new(big.Int).Sub(p.QuorumVotes, total))
+		}
+		p.Support = fixedFraction(p.Votes.Yes, new(big.Int).Add(p.Votes.Yes, no))
+	}
+
+	p.NextBaseline = fixedMul(p.Participation, p.UpdateFactor)
+	p.NextBaseline.Add(p.NextBaseline, fixedMul(p.Baseline, new(big.Int).Sub(fixed1, p.UpdateFactor)))
+	if p.NextBaseline.Cmp(p.Floor) < 0 {
+		p.NextBaseline = new(big.Int).Set(p.Floor)
+	}
+}
+
+// fixedMul multiplies two fixidity values.
+func fixedMul(a, b *big.Int) *big.Int {
+	r := new(big.Int).Mul(a, b)
+	return r.Quo(r, fixed1)
+}
+
+// fixedFraction returns n/d as a fixidity value.
+func fixedFraction(n, d *big.Int) *big.Int {
+	r := new(big.Int).Mul(n, fixed1)
+	return r.Quo(r, d)
+}
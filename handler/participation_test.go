@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+func TestParticipation(t *testing.T) {
+	_, epochRewards, _ := embeddedABI(EpochRewardsID)
+	setPayment, _ := epochRewards.Pack("setTargetEpochPayment", big.NewInt(1000))
+	node := governanceNode(t, map[string]contractMethod{
+		"getProposal":            returns(common.HexToAddress("0x01"), big.NewInt(100), big.NewInt(1600000000), big.NewInt(2), ""),
+		"getProposalStage":       returns(uint8(StageReferendum)),
+		"getProposalTransaction": returns(new(big.Int), GenesisAddresses["EpochRewardsProxy"], setPayment),
+		"getVoteTotals":          returns(big.NewInt(250), big.NewInt(50), big.NewInt(100)),
+		"getParticipationParameters": returns(fixidity("0.2"), fixidity("0.05"),
+			fixidity("0.2"), fixidity("1")),
+		"isProposalPassing": returns(true),
+		"getConstitution": func(args []interface{}) ([]interface{}, error) {
+			if functionID := args[1].([4]byte); string(functionID[:]) == string(setPayment[:4]) {
+				return []interface{}{fixidity("0.8")}, nil
+			}
+			return []interface{}{fixidity("0.5")}, nil
+		},
+	})
+	lockedGold, _ := contracts.LockedGoldMetaData.GetAbi()
+	node.serve(t, GenesisAddresses["LockedGoldProxy"], lockedGold, map[string]contractMethod{
+		"getTotalLockedGold": returns(big.NewInt(1000)),
+	})
+	cli := newFakeClient(t, node)
+
+	p, err := cli.Participation(big.NewInt(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 400 of 1000 voted against a quorum of 200; 250 of 300 is above 0.8.
+	if have := FormatFixidity(p.Participation); have != "0.4" {
+		t.Errorf("have participation %s, want 0.4", have)
+	}
+	if p.QuorumVotes.Int64() != 200 || !p.QuorumReached() {
+		t.Errorf("have quorum votes %v reached %v, want 200 reached", p.QuorumVotes, p.QuorumReached())
+	}
+	if FormatFixidity(p.Threshold) != "0.8" || !p.WouldPass() || !p.Passing {
+		t.Errorf("have support %s threshold %s, want passing", FormatFixidity(p.Support), FormatFixidity(p.Threshold))
+	}
+	if have := FormatFixidity(p.NextBaseline); have != "0.24" {
+		t.Errorf("have next baseline %s, want 0.24", have)
+	}
+}
+
+func TestParticipationQuorumPadding(t *testing.T) {
+	tests := []struct {
+		yes, no, abstain int64
+		floor            string
+		support          string
+		reached, passes  bool
+		next             string
+	}{
+		// 100 votes are missing to reach the quorum of 200 and count as no.
+		{90, 10, 0, "0.05", "0.45", false, false, "0.18"},
+		{120, 10, 70, "0.05", "0.923076923076923076923076", true, true, "0.2"},
+		{0, 0, 0, "0.17", "0", false, false, "0.17"},
+	}
+	for _, test := range tests {
+		p := &Participation{
+			Votes:        &VoteTotals{Yes: big.NewInt(test.yes), No: big.NewInt(test.no), Abstain: big.NewInt(test.abstain)},
+			TotalLocked:  big.NewInt(1000),
+			Baseline:     fixidity("0.2"),
+			Floor:        fixidity(test.floor),
+			UpdateFactor: fixidity("0.2"),
+			QuorumFactor: fixidity("1"),
+			Threshold:    fixidity("0.5"),
+		}
+		p.compute()
+		if have := FormatFixidity(p.Support); have != test.support || p.QuorumReached() != test.reached || p.WouldPass() != test.passes {
+			t.Errorf("%d/%d/%d: have support %s reached %v passes %v", test.yes, test.no, test.abstain, have, p.QuorumReached(), p.WouldPass())
+		}
+		if have := FormatFixidity(p.NextBaseline); have != test.next {
+			t.Errorf("%d/%d/%d: have next baseline %s, want %s", test.yes, test.no, test.abstain, have, test.next)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+)
+
+// PendingVoter is an account's pending votes for a validator.
+type PendingVoter struct {
+	Validator common.Address
+	Voter     common.Address
+	Value     *big.Int
+}
+
+// PendingVoters lists the pending voters of validators at height, nil
+// meaning latest. With no validators it covers every registered and
+// eligible validator. The voters of each validator must add up to its
+// pending votes.
+func (c *Client) PendingVoters(validators []common.Address, height *big.Int) ([]PendingVoter, error) {
+	election, _, err := c.electionAt(height)
+	if err != nil {
+		return nil, err
+	}
+	opts := callOpts(height)
+	if len(validators) == 0 {
+		if validators, err = c.allValidators(height); err != nil {
+			return nil, err
+		}
+	}
+	var voters []PendingVoter
+	for _, v := range validators {
+		accounts, err := election.GetPendingVotersForValidator(opts, v)
+		if err != nil {
+			return nil, fmt.Errorf("pending voters of %s: %w", v.Hex(), err)
+		}
+		total, err := election.GetPendingVotesForValidator(opts, v)
+		if err != nil {
+			return nil, fmt.Errorf("pending votes for %s: %w", v.Hex(), err)
+		}
+		sum := new(big.Int)
+		for _, account := range accounts {
+			value, err := election.GetPendingVotesForValidatorByAccount(opts, v, account)
+			if err != nil {
+				return nil, fmt.Errorf("pending votes of %s for %s: %w", account.Hex(), v.Hex(), err)
+			}
+			if value.Sign() == 0 {
+				continue
+			}
+			sum.Add(sum, value)
+			voters = append(voters, PendingVoter{Validator: v, Voter: account, Value: value})
+		}
+		if sum.Cmp(total) != 0 {
+			return nil, fmt.Errorf("pending voters of %s add up to %v, not the %v pending votes", v.Hex(), sum, total)
+		}
+	}
+	return voters, nil
+}
+
+// allValidators returns the registered validators followed by the eligible
+// ones that are no longer registered.
+func (c *Client) allValidators(height *big.Int) ([]common.Address, error) {
+	election, _, err := c.electionAt(height)
+	if err != nil {
+		return nil, err
+	}
+	validators, _, err := c.validatorsAt(height)
+	if err != nil {
+		return nil, err
+	}
+	registered, err := validators.GetRegisteredValidators(callOpts(height))
+	if err != nil {
+		return nil, fmt.Errorf("registered validators: %w", err)
+	}
+	eligible, err := election.GetEligibleValidators(callOpts(height))
+	if err != nil {
+		return nil, fmt.Errorf("eligible validators: %w", err)
+	}
+	seen := make(map[common.Address]bool)
+	var all []common.Address
+	for _, v := range append(registered, eligible...) {
+		if !seen[v] {
+			seen[v] = true
+			all = append(all, v)
+		}
+	}
+	return all, nil
+}
+
+// pendingVoterColumns is the layout of the voter.csv files reward
+// reconciliation reads: the voter is in column 2, the amount in coins in
+// column 3 and in wei in column 4.
+var pendingVoterColumns = []string{"index", "validator", "voter", "amount", "wei"}
+
+type pendingVoterRow struct {
+	Index     int            `json:"index"`
+	Validator common.Address `json:"validator"`
+	Voter     common.Address `json:"voter"`
+	Amount    string         `json:"amount"`
+	Wei       string         `json:"wei"`
+}
+
+func pendingVoterRows(voters []PendingVoter) []pendingVoterRow {
+	rows := make([]pendingVoterRow, len(voters))
+	for i, v := range voters {
+		rows[i] = pendingVoterRow{
+			Index:     i + 1,
+			Validator: v.Validator,
+			Voter:     v.Voter,
+			Amount:    decimal.NewFromBigInt(v.Value, -18).String(),
+			Wei:       v.Value.String(),
+		}
+	}
+	return rows
+}
+
+// WritePendingVotersCSV writes voters as CSV, with a header, in the voter.csv
+// layout.
+func WritePendingVotersCSV(w io.Writer, voters []PendingVoter) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(pendingVoterColumns); err != nil {
+		return err
+	}
+	for _, r := range pendingVoterRows(voters) {
+		if err := cw.Write([]string{strconv.Itoa(r.Index), r.Validator.Hex(), r.Voter.Hex(), r.Amount, r.Wei}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WritePendingVotersJSON writes voters as a JSON array of objects keyed by
+// the voter.csv column names.
+func WritePendingVotersJSON(w io.Writer, voters []PendingVoter) error {
+	data, err := json.MarshalIndent(pendingVoterRows(voters), "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+func TestPendingVoters(t *testing.T) {
+	a, b, c := common.HexToAddress("0xa"), common.HexToAddress("0xb"), common.HexToAddress("0xc")
+	x, y := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	oneAndAHalf, _ := new(big.Int).SetString("1500000000000000000", 10)
+	pending := map[common.Address]map[common.Address]*big.Int{
+		a: {x: oneAndAHalf, y: new(big.Int)},
+		b: {x: big.NewInt(2)},
+		c: {},
+	}
+	totals := map[common.Address]*big.Int{a: oneAndAHalf, b: big.NewInt(2), c: new(big.Int)}
+	node := registryNode(t, map[string]common.Address{
+		ElectionID:   GenesisAddresses["ElectionProxy"],
+		ValidatorsID: GenesisAddresses["ValidatorsProxy"],
+	})
+	election, _ := contracts.ElectionMetaData.GetAbi()
+	node.serve(t, GenesisAddresses["ElectionProxy"], election, map[string]contractMethod{
+		"getEligibleValidators": returns([]common.Address{b, c}),
+		"getPendingVotersForValidator": func(args []interface{}) ([]interface{}, error) {
+			var voters []common.Address
+			for _, v := range []common.Address{x, y} {
+				if _, ok := pending[args[0].(common.Address)][v]; ok {
+					voters = append(voters, v)
+				}
+			}
+			return []interface{}{voters}, nil
+		},
+		"getPendingVotesForValidator": func(args []interface{}) ([]interface{}, error) {
+			return []interface{}{totals[args[0].(common.Address)]}, nil
+		},
+		"getPendingVotesForValidatorByAccount": func(args []interface{}) ([]interface{}, error) {
+			return []interface{}{pending[args[0].(common.Address)][args[1].(common.Address)]}, nil
+		},
+	})
+	validators, _ := contracts.ValidatorsMetaData.GetAbi()
+	node.serve(t, GenesisAddresses["ValidatorsProxy"], validators, map[string]contractMethod{
+		"getRegisteredValidators": returns([]common.Address{a, b}),
+	})
+	var blocks []string
+	call := node.call
+	node.call = func(to common.Address, data []byte, block string) ([]byte, error) {
+		blocks = append(blocks, block)
+		return call(to, data, block)
+	}
+	cli := newFakeClient(t, node)
+
+	voters, err := cli.PendingVoters(nil, big.NewInt(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []PendingVoter{{a, x, oneAndAHalf}, {b, x, big.NewInt(2)}}
+	if len(voters) != len(want) {
+		t.Fatalf("have voters %+v", voters)
+	}
+	for i, w := range want {
+		if v := voters[i]; v.Validator != w.Validator || v.Voter != w.Voter || v.Value.Cmp(w.Value) != 0 {
+			t.Errorf("voter %d: have %+v, want %+v", i, v, w)
+		}
+	}
+	for _, block := range blocks {
+		if block != "0x64" {
+			t.Fatalf("have call at block %s, want 0x64", block)
+		}
+	}
+
+	// The export reads back with the voter.csv loaders.
+	var out bytes.Buffer
+	if err := WritePendingVotersCSV(&out, voters); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(&out).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != len(want)+1 {
+		t.Fatalf("have %d rows", len(rows))
+	}
+	for i, row := range rows[1:] {
+		validator, voter, value := handleRow3(row)
+		_, coins := handleRow1(row)
+		if validator != want[i].Validator || voter != want[i].Voter || value.Cmp(want[i].Value) != 0 || coins.Cmp(want[i].Value) != 0 {
+			t.Errorf("row %d: have %v", i+1, row)
+		}
+	}
+	out.Reset()
+	if err := WritePendingVotersJSON(&out, voters); err != nil {
+		t.Fatal(err)
+	}
+	var decoded []map[string]interface{}
+	if err := json.Unmarshal(out.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded) != 2 || decoded[0]["amount"] != "1.5" || decoded[1]["wei"] != "2" {
+		t.Fatalf("have JSON %s", out.Bytes())
+	}
+
+	totals[b] = big.NewInt(3)
+	if _, err := cli.PendingVoters([]common.Address{b}, nil); err == nil {
+		t.Error("expected voters not adding up to the pending votes to be refused")
+	}
+}
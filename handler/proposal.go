@@ -0,0 +1,372 @@
+package handler
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ProposalAction is one transaction of a governance proposal.
+type ProposalAction struct {
+	// Contract and Method name the embedded ABI method encoded in Data.
+	Contract string
+	Method   string
+	Args     []interface{}
+
+	Destination common.Address
+	Value       *big.Int
+	Data        []byte
+}
+
+// Proposal is a list of actions the Governance contract executes in order
+// once the proposal passes.
+type Proposal struct {
+	Actions        []ProposalAction
+	DescriptionURL string
+}
+
+// Pack lays the actions out the way Governance.propose expects them: the
+// calldata of all actions concatenated, with dataLengths telling where each
+// one ends.
+func (p *Proposal) Pack() (values []*big.Int, destinations []common.Address, data []byte, dataLengths []*big.Int) {
+	for _, action := range p.Actions {
+		value := action.Value
+		if value == nil {
+			value = new(big.Int)
+		}
+		values = append(values, value)
+		destinations = append(destinations, action.Destination)
+		data = append(data, action.Data...)
+		dataLengths = append(dataLengths, big.NewInt(int64(len(action.Data))))
+	}
+	return values, destinations, data, dataLengths
+}
+
+// ParseAction builds an action from a spec of the form
+//
+//	<Contract>.<method> [args...] [on <destination>] [value <wei>]
+//
+// e.g. "EpochRewards.setTargetEpochPayment 1000" or
+// "Proxy._setImplementation 0x40d1... on ElectionProxy". Contract names an
+// embedded ABI. The destination defaults to the contract's registry address
+// and may be an address, a GenesisAddresses name or a registry identifier.
+// Arguments are converted to the method's parameter types; arrays are
+// written as [a,b,c], nested as [[a,b],[c]], and addresses may be given by
+// name as well. Double quotes keep white space and commas together, and a
+// quoted "on" or "value" is an argument rather than a keyword.
+func (c *Client) ParseAction(spec string) (ProposalAction, error) {
+	fields, err := splitSpec(spec)
+	if err != nil {
+		return ProposalAction{}, err
+	}
+	if len(fields) == 0 {
+		return ProposalAction{}, fmt.Errorf("empty action")
+	}
+	dot := strings.LastIndex(fields[0], ".")
+	if dot <= 0 {
+		return ProposalAction{}, fmt.Errorf("action %q does not start with <Contract>.<method>", spec)
+	}
+	action := ProposalAction{Contract: unquote(fields[0][:dot]), Method: unquote(fields[0][dot+1:])}
+
+	var (
+		args        []string
+		destination string
+	)
+	for i := 1; i < len(fields); i++ {
+		switch fields[i] {
+		case "on", "value":
+			if i+1 == len(fields) {
+				return ProposalAction{}, fmt.Errorf("action %q: %s needs an operand", spec, fields[i])
+			}
+			if fields[i] == "on" {
+				destination = unquote(fields[i+1])
+			} else if action.Value, err = parseInt(unquote(fields[i+1])); err != nil {
+				return ProposalAction{}, fmt.Errorf("action %q: value: %w", spec, err)
+			}
+			i++
+		default:
+			args = append(args, fields[i])
+		}
+	}
+
+	var parsed *abi.ABI
+	if action.Contract, parsed, err = embeddedABI(action.Contract); err != nil {
+		return ProposalAction{}, err
+	}
+	if destination == "" && action.Contract == ProxyID {
+		return ProposalAction{}, fmt.Errorf("action %q: a Proxy action needs on <proxy>", spec)
+	}
+	if destination == "" {
+		destination = action.Contract
+	}
+	method, ok := parsed.Methods[action.Method]
+	if !ok {
+		return ProposalAction{}, fmt.Errorf("%s has no method %s", action.Contract, action.Method)
+	}
+	if len(args) != len(method.Inputs) {
+		return ProposalAction{}, fmt.Errorf("%s.%s takes %d arguments (%s), have %d",
+			action.Contract, action.Method, len(method.Inputs), method.Sig, len(args))
+	}
+	for i, input := range method.Inputs {
+		arg, err := c.parseArg(input.Type, args[i])
+		if err != nil {
+			return ProposalAction{}, fmt.Errorf("%s.%s argument %s: %w", action.Contract, action.Method, input.Name, err)
+		}
+		action.Args = append(action.Args, arg)
+	}
+	if action.Data, err = parsed.Pack(action.Method, action.Args...); err != nil {
+		return ProposalAction{}, err
+	}
+	if action.Destination, err = c.addressOf(destination); err != nil {
+		return ProposalAction{}, fmt.Errorf("action %q: %w", spec, err)
+	}
+	return action, nil
+}
+
+// ProposeInput returns the Governance address, the required deposit and the
+// propose calldata for p, e.g. to have it signed elsewhere.
+func (c *Client) ProposeInput(p *Proposal) (governance common.Address, deposit *big.Int, input []byte, err error) {
+	if len(p.Actions) == 0 {
+		return common.Address{}, nil, nil, fmt.Errorf("proposal has no actions")
+	}
+	gov, governance, err := c.governanceAt(nil)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if deposit, err = gov.MinDeposit(nil); err != nil {
+		return common.Address{}, nil, nil, fmt.Errorf("min deposit: %w", err)
+	}
+	values, destinations, data, dataLengths := p.Pack()
+	if input, err = pack(gov.Propose(packOpts, values, destinations, data, dataLengths, p.DescriptionURL)); err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return governance, deposit, input, nil
+}
+
+// Propose submits p with the minimum deposit attached.
+func (c *Client) Propose(signer Signer, p *Proposal) (common.Hash, error) {
+	governance, deposit, input, err := c.ProposeInput(p)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, governance, deposit, input, 0)
+}
+
+// embeddedABI returns the canonical name and parsed ABI shipped for
+// contract, matched case-insensitively.
+func embeddedABI(contract string) (string, *abi.ABI, error) {
+	var names []string
+	for _, c := range embeddedContracts {
+		if strings.EqualFold(c.name, contract) {
+			parsed, err := c.meta.GetAbi()
+			return c.name, parsed, err
+		}
+		names = append(names, c.name)
+	}
+	return "", nil, fmt.Errorf("unknown contract %s, want one of %s", contract, strings.Join(names, ", "))
+}
+
+// addressOf resolves a hex address, a GenesisAddresses name such as
+// ElectionProxy, or a registry identifier such as Election.
+func (c *Client) addressOf(name string) (common.Address, error) {
+	if strings.HasPrefix(name, "0x") || strings.HasPrefix(name, "0X") {
+		if !common.IsHexAddress(name) {
+			return common.Address{}, fmt.Errorf("invalid address %q", name)
+		}
+		return common.HexToAddress(name), nil
+	}
+	if a, ok := GenesisAddresses[name]; ok {
+		return a, nil
+	}
+	return c.resolver.Resolve(name, nil)
+}
+
+// parseArg converts s, as split by splitSpec, to a value of type t for abi
+// packing.
+func (c *Client) parseArg(t abi.Type, s string) (interface{}, error) {
+	if t.T != abi.SliceTy && t.T != abi.ArrayTy {
+		s = unquote(s)
+	}
+	switch t.T {
+	case abi.AddressTy:
+		return c.addressOf(s)
+	case abi.BoolTy:
+		switch strings.ToLower(s) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		return nil, fmt.Errorf("invalid bool %q", s)
+	case abi.StringTy:
+		return s, nil
+	case abi.BytesTy:
+		return decodeHex(s)
+	case abi.FixedBytesTy:
+		b, err := decodeHex(s)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != t.Size {
+			return nil, fmt.Errorf("have %d bytes, want %d", len(b), t.Size)
+		}
+		v := reflect.New(t.GetType()).Elem()
+		reflect.Copy(v, reflect.ValueOf(b))
+		return v.Interface(), nil
+	case abi.IntTy, abi.UintTy:
+		n, err := parseInt(s)
+		if err != nil {
+			return nil, err
+		}
+		if t.T == abi.UintTy && n.Sign() < 0 {
+			return nil, fmt.Errorf("negative %s %q", t, s)
+		}
+		min, max := new(big.Int), new(big.Int).Lsh(big.NewInt(1), uint(t.Size))
+		if t.T == abi.IntTy {
+			max.Rsh(max, 1)
+			min.Neg(max)
+		}
+		if n.Cmp(min) < 0 || n.Cmp(max) >= 0 {
+			return nil, fmt.Errorf("%s overflows %s", s, t)
+		}
+		v := reflect.New(t.GetType()).Elem()
+		switch v.Kind() {
+		case reflect.Ptr:
+			return n, nil
+		case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			v.SetInt(n.Int64())
+		default:
+			v.SetUint(n.Uint64())
+		}
+		return v.Interface(), nil
+	case abi.SliceTy, abi.ArrayTy:
+		if quoted := strings.TrimSpace(unquote(s)); strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) && strings.HasPrefix(quoted, "[") {
+			s = quoted
+		}
+		if !strings.HasPrefix(s, "[") || !strings.HasSuffix(s, "]") {
+			return nil, fmt.Errorf("%s must be written as [a,b,...], have %q", t, s)
+		}
+		elems, err := splitElems(s[1 : len(s)-1])
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %w", t, s, err)
+		}
+		if t.T == abi.ArrayTy && len(elems) != t.Size {
+			return nil, fmt.Errorf("%s needs %d elements, have %d", t, t.Size, len(elems))
+		}
+		v := reflect.New(t.GetType()).Elem()
+		if t.T == abi.SliceTy {
+			v = reflect.MakeSlice(t.GetType(), len(elems), len(elems))
+		}
+		for i, elem := range elems {
+			e, err := c.parseArg(*t.Elem, strings.TrimSpace(elem))
+			if err != nil {
+				return nil, err
+			}
+			v.Index(i).Set(reflect.ValueOf(e))
+		}
+		return v.Interface(), nil
+	}
+	return nil, fmt.Errorf("unsupported argument type %s", t)
+}
+
+// parseInt parses a decimal or 0x prefixed hex integer.
+func parseInt(s string) (*big.Int, error) {
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+	return n, nil
+}
+
+func decodeHex(s string) ([]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex %q", s)
+	}
+	return b, nil
+}
+
+// splitSpec splits an action spec on white space, keeping double quoted
+// strings and bracketed arrays together. Quotes are left in the fields so
+// that keywords are only recognised unquoted and array elements can still
+// be told apart; unquote removes them.
+func splitSpec(spec string) ([]string, error) {
+	var (
+		fields []string
+		field  strings.Builder
+		quoted bool
+		depth  int
+	)
+	for _, r := range spec {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case quoted:
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+		case depth <= 0 && (r == ' ' || r == '\t'):
+			if field.Len() > 0 {
+				fields = append(fields, field.String())
+				field.Reset()
+			}
+			continue
+		}
+		field.WriteRune(r)
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quote in %q", spec)
+	}
+	if field.Len() > 0 {
+		fields = append(fields, field.String())
+	}
+	return fields, nil
+}
+
+// splitElems splits the inside of an array on the commas that are neither
+// quoted nor within a nested array.
+func splitElems(inner string) ([]string, error) {
+	if strings.TrimSpace(inner) == "" {
+		return nil, nil
+	}
+	var (
+		elems  []string
+		start  int
+		quoted bool
+		depth  int
+	)
+	for i, r := range inner {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case quoted:
+		case r == '[':
+			depth++
+		case r == ']':
+			if depth--; depth < 0 {
+				return nil, fmt.Errorf("unbalanced ]")
+			}
+		case r == ',' && depth == 0:
+			elems = append(elems, strings.TrimSpace(inner[start:i]))
+			start = i + 1
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced [")
+	}
+	return append(elems, strings.TrimSpace(inner[start:])), nil
+}
+
+// unquote removes the double quotes splitSpec keeps in a field.
+func unquote(s string) string {
+	return strings.ReplaceAll(s, `"`, "")
+}
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+var testGovernance = common.HexToAddress("0xcdB66B1e6A07279df98f804d0aCAC86695F4b99e")
+
+// governanceNode resolves Governance, EpochRewards and LockedGold through
+// the registry and serves the given Governance methods.
+func governanceNode(t *testing.T, methods map[string]contractMethod) *fakeNode {
+	node := registryNode(t, map[string]common.Address{
+		GovernanceID:   testGovernance,
+		EpochRewardsID: GenesisAddresses["EpochRewardsProxy"],
+		LockedGoldID:   GenesisAddresses["LockedGoldProxy"],
+	})
+	parsed, err := contracts.GovernanceMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.serve(t, testGovernance, parsed, methods)
+	return node
+}
+
+func TestParseAction(t *testing.T) {
+	cli := newFakeClient(t, governanceNode(t, nil))
+	impl := common.HexToAddress("0x40d1215e14A94be82902C5f1CC2a5d438641E4Ff")
+
+	tests := []struct {
+		spec        string
+		destination common.Address
+		method      string
+		args        []interface{}
+		value       int64
+	}{
+		{"EpochRewards.setTargetEpochPayment 1000", GenesisAddresses["EpochRewardsProxy"],
+			"setTargetEpochPayment", []interface{}{big.NewInt(1000)}, 0},
+		{"Proxy._setImplementation " + impl.Hex() + " on ElectionProxy", GenesisAddresses["ElectionProxy"],
+			"_setImplementation", []interface{}{impl}, 0},
+		{`election.setElectableValidators 1 0x64 value 5`, GenesisAddresses["ElectionProxy"],
+			"setElectableValidators", []interface{}{big.NewInt(1), big.NewInt(100)}, 5},
+	}
+	for _, tt := range tests {
+		action, err := cli.ParseAction(tt.spec)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.spec, err)
+		}
+		if action.Destination != tt.destination || action.Value == nil && tt.value != 0 ||
+			action.Value != nil && action.Value.Int64() != tt.value {
+			t.Fatalf("%s: have destination %s value %v", tt.spec, action.Destination.Hex(), action.Value)
+		}
+		_, parsed, _ := embeddedABI(action.Contract)
+		want, err := parsed.Pack(tt.method, tt.args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(action.Data, want) {
+			t.Fatalf("%s: have data %x, want %x", tt.spec, action.Data, want)
+		}
+	}
+
+	for _, spec := range []string{
+		"",
+		"setTargetEpochPayment 1000",
+		"Nope.method",
+		"EpochRewards.nope",
+		"EpochRewards.setTargetEpochPayment",
+		"EpochRewards.setTargetEpochPayment -1",
+		"Proxy._setImplementation " + impl.Hex(),
+		"EpochRewards.setTargetEpochPayment 1 on",
+	} {
+		if _, err := cli.ParseAction(spec); err == nil {
+			t.Errorf("%q: expected an error", spec)
+		}
+	}
+
+	// Quoted keywords are arguments.
+	_, lockedGold, _ := embeddedABI(LockedGoldID)
+	for _, keyword := range []string{"on", "value"} {
+		action, err := cli.ParseAction(`LockedGold.addSlasher "` + keyword + `"`)
+		if err != nil {
+			t.Fatalf("quoted %s: %v", keyword, err)
+		}
+		want, _ := lockedGold.Pack("addSlasher", keyword)
+		if !bytes.Equal(action.Data, want) || action.Destination != GenesisAddresses["LockedGoldProxy"] || action.Value != nil {
+			t.Fatalf("quoted %s: have %+v", keyword, action)
+		}
+	}
+}
+
+func TestParseArg(t *testing.T) {
+	cli := newFakeClient(t, governanceNode(t, nil))
+	newType := func(s string) abi.Type {
+		typ, err := abi.NewType(s, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return typ
+	}
+	minInt256 := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	tests := []struct {
+		typ, arg string
+		want     interface{}
+	}{
+		{"int8", "-128", int8(-128)},
+		{"int8", "127", int8(127)},
+		{"uint8", "255", uint8(255)},
+		{"int256", minInt256.String(), minInt256},
+		{"uint256", maxUint256.String(), maxUint256},
+		{"uint256[][]", "[[1,2],[],[3]]", [][]*big.Int{{big.NewInt(1), big.NewInt(2)}, {}, {big.NewInt(3)}}},
+		{"uint8[2][]", "[[1, 2], [3, 4]]", [][2]uint8{{1, 2}, {3, 4}}},
+		{"string[]", `["a,b", "[c]", d]`, []string{"a,b", "[c]", "d"}},
+		{"string", `"on"`, "on"},
+	}
+	for _, tt := range tests {
+		have, err := cli.parseArg(newType(tt.typ), tt.arg)
+		if err != nil {
+			t.Errorf("%s %s: %v", tt.typ, tt.arg, err)
+			continue
+		}
+		if !reflect.DeepEqual(have, tt.want) {
+			t.Errorf("%s %s: have %v, want %v", tt.typ, tt.arg, have, tt.want)
+		}
+	}
+
+	for _, tt := range []struct{ typ, arg string }{
+		{"int8", "-129"},
+		{"int8", "128"},
+		{"uint8", "256"},
+		{"uint8", "-1"},
+		{"int256", new(big.Int).Sub(minInt256, big.NewInt(1)).String()},
+		{"uint256[][]", "[[1,2],[3]"},
+		{"uint256[][]", "[[1,2]],[3]]"},
+		{"string[]", `["a,b]`},
+		{"uint8[2]", "[1,2,3]"},
+	} {
+		if _, err := cli.parseArg(newType(tt.typ), tt.arg); err == nil {
+			t.Errorf("%s %s: expected an error", tt.typ, tt.arg)
+		}
+	}
+
+	fields, err := splitSpec(`Contract.method [1, 2] "a b" "on" on x`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"Contract.method", "[1, 2]", `"a b"`, `"on"`, "on", "x"}; !reflect.DeepEqual(fields, want) {
+		t.Fatalf("have fields %q, want %q", fields, want)
+	}
+}
+
+func TestPropose(t *testing.T) {
+	deposit := big.NewInt(10000)
+	node := governanceNode(t, map[string]contractMethod{"minDeposit": returns(deposit)})
+	node.gasPrice = big.NewInt(1)
+	node.nonce = func(common.Address) uint64 { return 0 }
+	cli := newFakeClient(t, node)
+
+	p := &Proposal{DescriptionURL: "https://example.org/proposal"}
+	for _, spec := range []string{
+		"EpochRewards.setTargetEpochPayment 1000",
+		"Proxy._setImplementation 0x40d1215e14A94be82902C5f1CC2a5d438641E4Ff on ElectionProxy",
+	} {
+		action, err := cli.ParseAction(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.Actions = append(p.Actions, action)
+	}
+	if _, err := cli.Propose(newTestSigner(t), p); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := node.sent[0]
+	if *tx.To() != testGovernance || tx.Value().Cmp(deposit) != 0 {
+		t.Fatalf("have to %s value %v, want governance with deposit %v", tx.To().Hex(), tx.Value(), deposit)
+	}
+	parsed, _ := contracts.GovernanceMetaData.GetAbi()
+	args, err := parsed.Methods["propose"].Inputs.Unpack(tx.Data()[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, lengths := args[2].([]byte), args[3].([]*big.Int)
+	first, second := p.Actions[0].Data, p.Actions[1].Data
+	if !bytes.Equal(data, append(append([]byte{}, first...), second...)) ||
+		lengths[0].Int64() != int64(len(first)) || lengths[1].Int64() != int64(len(second)) {
+		t.Fatalf("have data %x lengths %v", data, lengths)
+	}
+	if destinations := args[1].([]common.Address); destinations[1] != GenesisAddresses["ElectionProxy"] {
+		t.Fatalf("have destinations %v", destinations)
+	}
+	if args[4].(string) != p.DescriptionURL {
+		t.Fatalf("have description %q", args[4])
+	}
+
+	if _, err := cli.Propose(newTestSigner(t), &Proposal{}); err == nil {
+		t.Fatal("expected an empty proposal to be refused")
+	}
+}
@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+// QueuedProposal is an entry of the governance queue or dequeue.
+type QueuedProposal struct {
+	ID *big.Int
+	// Upvotes is nil for a dequeued proposal.
+	Upvotes *big.Int
+	Stage   ProposalStage
+	Expired bool
+}
+
+// GovernanceQueue is the state of the proposal queue and of the proposals
+// dequeued from it.
+type GovernanceQueue struct {
+	Queued   []QueuedProposal
+	Dequeued []QueuedProposal
+
+	LastDequeue      time.Time
+	DequeueFrequency time.Duration
+	QueueExpiry      time.Duration
+	// Block is the latest block when the queue was read, every read being
+	// pinned to it, and Now its timestamp.
+	Block *big.Int
+	Now   time.Time
+}
+
+// NextDequeue is the earliest time dequeueProposalsIfReady moves proposals.
+func (q *GovernanceQueue) NextDequeue() time.Time {
+	return q.LastDequeue.Add(q.DequeueFrequency)
+}
+
+// DequeueDue reports whether dequeueProposalsIfReady would dequeue anything
+// at the latest block.
+func (q *GovernanceQueue) DequeueDue() bool {
+	return len(q.Queued) > 0 && !q.Now.Before(q.NextDequeue())
+}
+
+// Queue reads the queued and dequeued proposals with their stage and expiry.
+// Empty slots of the dequeue, left behind by executed or expired proposals,
+// are skipped. All reads are made at the same block so that neighbours
+// computed from the upvotes are consistent.
+func (c *Client) Queue() (*GovernanceQueue, error) {
+	q := new(GovernanceQueue)
+	var err error
+	if q.Block, q.Now, err = c.head(context.Background()); err != nil {
+		return nil, err
+	}
+	opts := callOpts(q.Block)
+	governance, _, err := c.governanceAt(q.Block)
+	if err != nil {
+		return nil, err
+	}
+	last, err := governance.LastDequeue(opts)
+	if err != nil {
+		return nil, fmt.Errorf("last dequeue: %w", err)
+	}
+	frequency, err := governance.DequeueFrequency(opts)
+	if err != nil {
+		return nil, fmt.Errorf("dequeue frequency: %w", err)
+	}
+	expiry, err := governance.QueueExpiry(opts)
+	if err != nil {
+		return nil, fmt.Errorf("queue expiry: %w", err)
+	}
+	q.LastDequeue = time.Unix(last.Int64(), 0)
+	q.DequeueFrequency = seconds(frequency)
+	q.QueueExpiry = seconds(expiry)
+
+	ids, upvotes, err := governance.GetQueue(opts)
+	if err != nil {
+		return nil, fmt.Errorf("queue: %w", err)
+	}
+	for i, id := range ids {
+		p := QueuedProposal{ID: id, Upvotes: upvotes[i]}
+		if p.Expired, err = governance.IsQueuedProposalExpired(opts, id); err != nil {
+			return nil, fmt.Errorf("proposal %v expiry: %w", id, err)
+		}
+		if p.Stage, err = stageOf(governance, opts, id); err != nil {
+			return nil, err
+		}
+		q.Queued = append(q.Queued, p)
+	}
+
+	dequeued, err := governance.GetDequeue(opts)
+	if err != nil {
+		return nil, fmt.Errorf("dequeue: %w", err)
+	}
+	for _, id := range dequeued {
+		if id.Sign() == 0 {
+			continue
+		}
+		p := QueuedProposal{ID: id}
+		if p.Expired, err = governance.IsDequeuedProposalExpired(opts, id); err != nil {
+			return nil, fmt.Errorf("proposal %v expiry: %w", id, err)
+		}
+		if p.Stage, err = stageOf(governance, opts, id); err != nil {
+			return nil, err
+		}
+		q.Dequeued = append(q.Dequeued, p)
+	}
+	return q, nil
+}
+
+// queued returns the queue entry of proposal id, nil if it is not queued.
+func (q *GovernanceQueue) queued(id *big.Int) *QueuedProposal {
+	for i := range q.Queued {
+		if q.Queued[i].ID.Cmp(id) == 0 {
+			return &q.Queued[i]
+		}
+	}
+	return nil
+}
+
+// neighbours returns the ids just below and above proposal id in the
+// upvote ordered queue once its upvotes are set to upvotes, zero where id
+// would be the tail or the head of the list.
+func (q *GovernanceQueue) neighbours(id, upvotes *big.Int) (lesser, greater *big.Int) {
+	entries := []QueuedProposal{{ID: id, Upvotes: upvotes}}
+	for _, p := range q.Queued {
+		if p.ID.Cmp(id) != 0 {
+			entries = append(entries, p)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Upvotes.Cmp(entries[j].Upvotes) < 0 })
+	lesser, greater = new(big.Int), new(big.Int)
+	for i, p := range entries {
+		if p.ID.Cmp(id) != 0 {
+			continue
+		}
+		if i > 0 {
+			lesser = entries[i-1].ID
+		}
+		if i+1 < len(entries) {
+			greater = entries[i+1].ID
+		}
+	}
+	return lesser, greater
+}
+
+func stageOf(governance *contracts.Governance, opts *bind.CallOpts, id *big.Int) (ProposalStage, error) {
+	stage, err := governance.GetProposalStage(opts, id)
+	if err != nil {
+		return StageNone, fmt.Errorf("proposal %v stage: %w", id, err)
+	}
+	return ProposalStage(stage), nil
+}
+
+// DequeueProposalsIfReady moves the most upvoted proposals from the queue
+// into the approval stage if the dequeue frequency has elapsed.
+func (c *Client) DequeueProposalsIfReady(signer Signer) (common.Hash, error) {
+	governance, address, err := c.governanceAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	input, err := pack(governance.DequeueProposalsIfReady(packOpts))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, address, nil, input, 0)
+}
+
+// headTime returns the timestamp of the latest block, which is what the
+// contracts compare their deadlines against.
+func (c *Client) headTime(ctx context.Context) (time.Time, error) {
+	_, t, err := c.head(ctx)
+	return t, err
+}
+
+// head returns the number and timestamp of the latest block.
+func (c *Client) head(ctx context.Context) (*big.Int, time.Time, error) {
+	var head struct {
+		Number *hexutil.Big   `json:"number"`
+		Time   hexutil.Uint64 `json:"timestamp"`
+	}
+	if err := c.rpc.CallContext(ctx, &head, "eth_getBlockByNumber", "latest", false); err != nil {
+		return nil, time.Time{}, fmt.Errorf("latest block: %w", err)
+	}
+	if head.Number == nil {
+		return nil, time.Time{}, fmt.Errorf("latest block has no number")
+	}
+	return (*big.Int)(head.Number), time.Unix(int64(head.Time), 0), nil
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+func TestQueue(t *testing.T) {
+	stages := map[int64]ProposalStage{3: StageQueued, 4: StageExpiration, 1: StageReferendum}
+	node := governanceNode(t, map[string]contractMethod{
+		"lastDequeue":      returns(big.NewInt(1000)),
+		"dequeueFrequency": returns(big.NewInt(3600)),
+		"queueExpiry":      returns(big.NewInt(86400)),
+		"getQueue":         returns([]*big.Int{big.NewInt(3), big.NewInt(4)}, []*big.Int{big.NewInt(50), big.NewInt(0)}),
+		"getDequeue":       returns([]*big.Int{big.NewInt(0), big.NewInt(1)}),
+		"isQueuedProposalExpired": func(args []interface{}) ([]interface{}, error) {
+			return []interface{}{args[0].(*big.Int).Int64() == 4}, nil
+		},
+		"isDequeuedProposalExpired": returns(false),
+		"getProposalStage": func(args []interface{}) ([]interface{}, error) {
+			return []interface{}{uint8(stages[args[0].(*big.Int).Int64()])}, nil
+		},
+		"dequeueProposalsIfReady": returns(),
+	})
+	node.time = 2000
+	node.gasPrice = big.NewInt(1)
+	node.nonce = func(common.Address) uint64 { return 0 }
+	blocks := make(map[string]bool)
+	call := node.call
+	node.call = func(to common.Address, data []byte, block string) ([]byte, error) {
+		blocks[block] = true
+		return call(to, data, block)
+	}
+	cli := newFakeClient(t, node)
+
+	q, err := cli.Queue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blocks) != 1 || !blocks["0x1"] || q.Block.Int64() != 1 {
+		t.Fatalf("have reads at blocks %v, want all at the head block 1", blocks)
+	}
+	if len(q.Queued) != 2 || q.Queued[0].Upvotes.Int64() != 50 || q.Queued[0].Expired || !q.Queued[1].Expired ||
+		q.Queued[1].Stage != StageExpiration {
+		t.Fatalf("have queue %+v", q.Queued)
+	}
+	if len(q.Dequeued) != 1 || q.Dequeued[0].ID.Int64() != 1 || q.Dequeued[0].Stage != StageReferendum {
+		t.Fatalf("have dequeue %+v", q.Dequeued)
+	}
+	if q.NextDequeue() != time.Unix(4600, 0) || q.DequeueDue() {
+		t.Fatalf("have next dequeue %v, due %v at %v", q.NextDequeue(), q.DequeueDue(), q.Now)
+	}
+	node.time = 4600
+	if q, err = cli.Queue(); err != nil {
+		t.Fatal(err)
+	}
+	if !q.DequeueDue() {
+		t.Fatalf("expected the dequeue to be due at %v", q.Now)
+	}
+
+	if _, err := cli.DequeueProposalsIfReady(newTestSigner(t)); err != nil {
+		t.Fatal(err)
+	}
+	parsed, _ := contracts.GovernanceMetaData.GetAbi()
+	if tx := node.sent[0]; *tx.To() != testGovernance || string(tx.Data()) != string(parsed.Methods["dequeueProposalsIfReady"].ID) {
+		t.Fatalf("have to %s data %x", tx.To().Hex(), tx.Data())
+	}
+}
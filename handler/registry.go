@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+// Registry identifiers of the system contracts.
+const (
+	RegistryID             = "Registry"
+	GoldTokenID            = "GoldToken"
+	StableTokenID          = "StableToken"
+	AccountsID             = "Accounts"
+	LockedGoldID           = "LockedGold"
+	ValidatorsID           = "Validators"
+	ElectionID             = "Election"
+	EpochRewardsID         = "EpochRewards"
+	RandomID               = "Random"
+	BlockchainParametersID = "BlockchainParameters"
+	GovernanceID           = "Governance"
+)
+
+// Resolver looks up contract addresses through the on-chain Registry and
+// caches them per block. Lookups at the latest block are not cached, so a
+// long running client follows Registry updates. When the Registry has no
+// entry, or cannot be reached, the static GenesisAddresses entry is used
+// instead; when both exist and differ the Registry wins and a warning is
+// logged. A fallback after a failed lookup is never cached.
+type Resolver struct {
+	registry *contracts.RegistryCaller
+
+	mu     sync.Mutex
+	cache  map[string]common.Address
+	logger log.Logger
+}
+
+// NewResolver binds the Registry at its genesis address.
+func NewResolver(backend bind.ContractCaller) (*Resolver, error) {
+	registry, err := contracts.NewRegistryCaller(GenesisAddresses[RegistryID+"Proxy"], backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Resolver{registry: registry, cache: make(map[string]common.Address), logger: discardLogger()}, nil
+}
+
+// SetLogger makes the resolver warn on l when it falls back to a genesis
+// address or finds it differing from the Registry.
+func (r *Resolver) SetLogger(l log.Logger) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.logger = l
+}
+
+func (r *Resolver) log() log.Logger {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.logger
+}
+
+// Resolve returns the address registered for identifier at height, nil
+// meaning the latest block.
+func (r *Resolver) Resolve(identifier string, height *big.Int) (common.Address, error) {
+	var key string
+	if height != nil {
+		key = identifier + "@" + height.String()
+		r.mu.Lock()
+		addr, ok := r.cache[key]
+		r.mu.Unlock()
+		if ok {
+			return addr, nil
+		}
+	}
+
+	addr, cache, err := r.resolve(identifier, height)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if cache && key != "" {
+		r.mu.Lock()
+		r.cache[key] = addr
+		r.mu.Unlock()
+	}
+	return addr, nil
+}
+
+// resolve also reports whether the address came from a successful lookup
+// and may be cached.
+func (r *Resolver) resolve(identifier string, height *big.Int) (common.Address, bool, error) {
+	genesis, hasGenesis := GenesisAddresses[identifier+"Proxy"]
+	registered, err := r.Lookup(identifier, height)
+	switch {
+	case err != nil && hasGenesis:
+		r.log().Warn("Registry lookup failed, using genesis address", "contract", identifier, "address", genesis, "err", err)
+		return genesis, false, nil
+	case err != nil:
+		return common.Address{}, false, err
+	case registered == (common.Address{}) && hasGenesis:
+		r.log().Warn("Contract not in registry, using genesis address", "contract", identifier, "address", genesis)
+		return genesis, true, nil
+	case registered == (common.Address{}):
+		return common.Address{}, false, fmt.Errorf("contract %s is not registered", identifier)
+	case hasGenesis && registered != genesis:
+		r.log().Warn("Registry address differs from genesis address", "contract", identifier, "registry", registered, "genesis", genesis)
+	}
+	return registered, true, nil
+}
+
+// Lookup queries the Registry for identifier without any caching or fallback.
+func (r *Resolver) Lookup(identifier string, height *big.Int) (common.Address, error) {
+	addr, err := r.registry.GetAddressFor(callOpts(height), crypto.Keccak256Hash([]byte(identifier)))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("registry lookup %s: %w", identifier, err)
+	}
+	return addr, nil
+}
+
+// Mismatch describes a genesis entry that disagrees with the Registry.
+type Mismatch struct {
+	Identifier string
+	Genesis    common.Address
+	Registry   common.Address
+}
+
+// Check compares every GenesisAddresses entry with the Registry at height
+// and returns the entries that differ. Unregistered contracts are reported
+// with a zero Registry address.
+func (r *Resolver) Check(height *big.Int) ([]Mismatch, error) {
+	var mismatches []Mismatch
+	for _, name := range genesisNames() {
+		identifier := strings.TrimSuffix(name, "Proxy")
+		if identifier == RegistryID {
+			continue
+		}
+		registered, err := r.Lookup(identifier, height)
+		if err != nil {
+			return nil, err
+		}
+		if genesis := GenesisAddresses[name]; registered != genesis {
+			mismatches = append(mismatches, Mismatch{Identifier: identifier, Genesis: genesis, Registry: registered})
+		}
+	}
+	return mismatches, nil
+}
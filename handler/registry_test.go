@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// registryNode serves getAddressFor from entries keyed by identifier.
+func registryNode(t *testing.T, entries map[string]common.Address) *fakeNode {
+	parsed, err := abi.JSON(strings.NewReader(RegistryABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	method := parsed.Methods["getAddressFor"]
+	byHash := make(map[common.Hash]common.Address)
+	for id, a := range entries {
+		byHash[crypto.Keccak256Hash([]byte(id))] = a
+	}
+	return &fakeNode{
+		call: func(to common.Address, data []byte, block string) ([]byte, error) {
+			if to != GenesisAddresses["RegistryProxy"] {
+				t.Fatalf("unexpected call to %s", to.Hex())
+			}
+			args, err := method.Inputs.Unpack(data[4:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			return method.Outputs.Pack(byHash[common.Hash(args[0].([32]byte))])
+		},
+	}
+}
+
+func TestResolverFallbackAndMismatch(t *testing.T) {
+	moved := common.HexToAddress("0x1234")
+	governance := common.HexToAddress("0xcdB66B1e6A07279df98f804d0aCAC86695F4b99e")
+	node := registryNode(t, map[string]common.Address{
+		ElectionID:   GenesisAddresses["ElectionProxy"],
+		ValidatorsID: moved,
+		GovernanceID: governance,
+	})
+	r := newFakeClient(t, node).Resolver()
+
+	tests := []struct {
+		identifier string
+		want       common.Address
+	}{
+		{ElectionID, GenesisAddresses["ElectionProxy"]},
+		{ValidatorsID, moved},
+		{LockedGoldID, GenesisAddresses["LockedGoldProxy"]},
+		{GovernanceID, governance},
+	}
+	for _, tt := range tests {
+		have, err := r.Resolve(tt.identifier, nil)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.identifier, err)
+		}
+		if have != tt.want {
+			t.Errorf("%s: have %s, want %s", tt.identifier, have.Hex(), tt.want.Hex())
+		}
+	}
+	if _, err := r.Resolve("Unknown", nil); err == nil {
+		t.Error("expected an error for an unregistered contract without genesis address")
+	}
+}
+
+func TestResolverCachesPerBlock(t *testing.T) {
+	node := registryNode(t, map[string]common.Address{ElectionID: GenesisAddresses["ElectionProxy"]})
+	r := newFakeClient(t, node).Resolver()
+
+	for i := 0; i < 3; i++ {
+		if _, err := r.Resolve(ElectionID, nil); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := r.Resolve(ElectionID, big.NewInt(100)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if node.calls != 4 {
+		t.Fatalf("have %d registry calls, want 4: one per latest lookup and one at block 100", node.calls)
+	}
+}
+
+func TestResolverDoesNotCacheFallback(t *testing.T) {
+	moved := common.HexToAddress("0x1234")
+	node := registryNode(t, map[string]common.Address{ElectionID: moved})
+	lookup, failing := node.call, true
+	node.call = func(to common.Address, data []byte, block string) ([]byte, error) {
+		if failing {
+			return nil, errors.New("connection reset")
+		}
+		return lookup(to, data, block)
+	}
+	r := newFakeClient(t, node).Resolver()
+
+	height := big.NewInt(100)
+	if have, err := r.Resolve(ElectionID, height); err != nil || have != GenesisAddresses["ElectionProxy"] {
+		t.Fatalf("have %s, %v, want the genesis fallback", have.Hex(), err)
+	}
+	failing = false
+	if have, err := r.Resolve(ElectionID, height); err != nil || have != moved {
+		t.Fatalf("have %s, %v, want the registered address once the Registry answers", have.Hex(), err)
+	}
+}
+
+func TestResolverCheck(t *testing.T) {
+	moved := common.HexToAddress("0x1234")
+	entries := make(map[string]common.Address)
+	for name, a := range GenesisAddresses {
+		entries[strings.TrimSuffix(name, "Proxy")] = a
+	}
+	entries[ValidatorsID] = moved
+	delete(entries, RandomID)
+	r := newFakeClient(t, registryNode(t, entries)).Resolver()
+
+	mismatches, err := r.Check(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Mismatch{
+		{Identifier: RandomID, Genesis: GenesisAddresses["RandomProxy"]},
+		{Identifier: ValidatorsID, Genesis: GenesisAddresses["ValidatorsProxy"], Registry: moved},
+	}
+	if len(mismatches) != len(want) {
+		t.Fatalf("have %v, want %v", mismatches, want)
+	}
+	for i := range want {
+		if mismatches[i] != want[i] {
+			t.Errorf("mismatch %d: have %v, want %v", i, mismatches[i], want[i])
+		}
+	}
+}
+
+func TestResolverWarnsOnInjectedLogger(t *testing.T) {
+	node := registryNode(t, map[string]common.Address{})
+	cli := newFakeClient(t, node)
+	var warnings []string
+	logger := log.New()
+	logger.SetHandler(log.FuncHandler(func(r *log.Record) error {
+		if r.Lvl == log.LvlWarn {
+			warnings = append(warnings, r.Msg)
+		}
+		return nil
+	}))
+
+	if _, err := cli.Resolver().Resolve(ElectionID, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 0 {
+		t.Fatalf("have warnings %q before a logger is set", warnings)
+	}
+	cli.SetLogger(logger)
+	if _, err := cli.Resolver().Resolve(ElectionID, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("have warnings %q, want the genesis fallback", warnings)
+	}
+}
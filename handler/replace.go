@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// PriceBump is the fee increase, in percent, a node's pool requires before a
+// pending transaction is replaced by another with the same nonce. It matches
+// go-ethereum's default txpool.pricebump.
+const PriceBump = 10
+
+// SpeedUp resends the pending transaction txHash of signer with the same
+// nonce, recipient, value, data and gas limit, but with fees raised by at
+// least PriceBump percent, or to the fee policy's price if that is higher.
+func (c *Client) SpeedUp(signer Signer, txHash common.Hash) (common.Hash, error) {
+	old, err := c.pendingFrom(signer.Address(), txHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if old.To() == nil {
+		return common.Hash{}, fmt.Errorf("transaction %s creates a contract, not supported", txHash.Hex())
+	}
+	return c.replace(signer, old, *old.To(), old.Value(), old.Data(), old.Gas())
+}
+
+// Cancel replaces the pending transaction txHash of signer with a zero-value
+// transfer from the account to itself, priced like SpeedUp.
+func (c *Client) Cancel(signer Signer, txHash common.Hash) (common.Hash, error) {
+	old, err := c.pendingFrom(signer.Address(), txHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.replace(signer, old, signer.Address(), new(big.Int), nil, params.TxGas)
+}
+
+// pendingFrom returns txHash if it is still in the pool and was sent by from.
+func (c *Client) pendingFrom(from common.Address, txHash common.Hash) (*types.Transaction, error) {
+	tx, isPending, err := c.conn.TransactionByHash(context.Background(), txHash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, fmt.Errorf("transaction %s is unknown to the node", txHash.Hex())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("transaction %s: %w", txHash.Hex(), err)
+	}
+	if !isPending {
+		return nil, fmt.Errorf("transaction %s is already mined", txHash.Hex())
+	}
+	chainID, err := c.ChainID()
+	if err != nil {
+		return nil, err
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+	if err != nil {
+		return nil, err
+	}
+	if sender != from {
+		return nil, fmt.Errorf("transaction %s was sent by %s, not %s", txHash.Hex(), sender.Hex(), from.Hex())
+	}
+	return tx, nil
+}
+
+// replace signs and sends a transaction with the nonce of old whose fees
+// clear the pool's replacement rule.
+func (c *Client) replace(signer Signer, old *types.Transaction, to common.Address, value *big.Int, input []byte, gas uint64) (common.Hash, error) {
+	chainID, err := c.ChainID()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	fees, err := c.fees(ethereum.CallMsg{From: signer.Address(), To: &to, Value: value, Data: input}, gas)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	// A legacy transaction's gas price counts as both its tip and fee cap.
+	minTip, minFeeCap := bumped(old.GasTipCap()), bumped(old.GasFeeCap())
+	var feeCap *big.Int
+	if fees.dynamic() {
+		fees.gasTipCap = maxBig(fees.gasTipCap, minTip)
+		fees.gasFeeCap = maxBig(fees.gasFeeCap, minFeeCap, fees.gasTipCap)
+		feeCap = fees.gasFeeCap
+	} else {
+		fees.gasPrice = maxBig(fees.gasPrice, minFeeCap)
+		feeCap = fees.gasPrice
+	}
+	if maxFee := c.FeePolicy().MaxFee; maxFee != nil && feeCap.Cmp(maxFee) > 0 {
+		return common.Hash{}, fmt.Errorf("replacing %s needs a fee cap of %v, above max fee %v", old.Hash().Hex(), feeCap, maxFee)
+	}
+
+	signedTx, err := signer.SignTx(fees.newTx(chainID, old.Nonce(), to, value, input), chainID)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("sign tx: %w", err)
+	}
+	if err := c.conn.SendTransaction(context.Background(), signedTx); err != nil {
+		return common.Hash{}, fmt.Errorf("send tx: %w", err)
+	}
+	return signedTx.Hash(), nil
+}
+
+// bumped raises price by PriceBump percent, rounding up.
+func bumped(price *big.Int) *big.Int {
+	bump := new(big.Int).Mul(price, big.NewInt(100+PriceBump))
+	bump.Add(bump, big.NewInt(99))
+	return bump.Div(bump, big.NewInt(100))
+}
+
+func maxBig(x *big.Int, ys ...*big.Int) *big.Int {
+	for _, y := range ys {
+		if y.Cmp(x) > 0 {
+			x = y
+		}
+	}
+	return x
+}
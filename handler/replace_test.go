@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestSpeedUpAndCancel(t *testing.T) {
+	signer := newTestSigner(t)
+	from := signer.Address()
+	node := &fakeNode{
+		gasPrice: big.NewInt(100),
+		nonce:    func(common.Address) uint64 { return 4 },
+		estimate: func(callArgs) (uint64, error) { return 50000, nil },
+	}
+	cli := newFakeClient(t, node)
+	to := common.HexToAddress("0xd013")
+	if _, err := cli.SendTransaction(signer, to, big.NewInt(9)); err != nil {
+		t.Fatal(err)
+	}
+	old := node.sent[0]
+	node.pend(old)
+
+	if _, err := cli.SpeedUp(signer, old.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	sped := node.sent[1]
+	if sped.Nonce() != 4 || sped.To() == nil || *sped.To() != to || sped.Value().Int64() != 9 || sped.Gas() != old.Gas() {
+		t.Fatalf("speed-up changed the transaction: %+v", sped)
+	}
+	if sped.GasPrice().Int64() != 110 {
+		t.Fatalf("have gas price %v, want 110", sped.GasPrice())
+	}
+
+	if _, err := cli.Cancel(signer, old.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	cancel := node.sent[2]
+	if cancel.Nonce() != 4 || *cancel.To() != from || cancel.Value().Sign() != 0 || cancel.Gas() != 21000 {
+		t.Fatalf("have %+v, want a zero-value self-transfer with nonce 4", cancel)
+	}
+
+	if _, err := cli.SpeedUp(newTestSigner(t), old.Hash()); err == nil {
+		t.Fatal("expected a transaction of another sender to be refused")
+	}
+	node.mine(old, 2, types.ReceiptStatusSuccessful)
+	if _, err := cli.SpeedUp(signer, old.Hash()); err == nil {
+		t.Fatal("expected a mined transaction to be refused")
+	}
+}
+
+func TestSpeedUpDynamic(t *testing.T) {
+	signer := newTestSigner(t)
+	node := &fakeNode{
+		gasTipCap: big.NewInt(10),
+		baseFee:   big.NewInt(100),
+		nonce:     func(common.Address) uint64 { return 0 },
+	}
+	cli := newFakeClient(t, node)
+	if _, err := cli.SendTransaction(signer, common.HexToAddress("0xd013"), big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	old := node.sent[0]
+	node.pend(old)
+
+	if _, err := cli.SpeedUp(signer, old.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	sped := node.sent[1]
+	if sped.GasTipCap().Int64() != 11 || sped.GasFeeCap().Int64() != 231 {
+		t.Fatalf("have tip %v cap %v, want 11 and 231", sped.GasTipCap(), sped.GasFeeCap())
+	}
+
+	p := DefaultFeePolicy()
+	p.MaxFee = big.NewInt(220)
+	cli.SetFeePolicy(p)
+	if _, err := cli.SpeedUp(signer, old.Hash()); err == nil {
+		t.Fatal("expected a replacement above max fee to be refused")
+	}
+}
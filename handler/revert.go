@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// RevertError is returned when a call, a gas estimate or a replayed
+// transaction reverted.
+type RevertError struct {
+	// Reason is the decoded revert string, panic or custom error; empty
+	// when the contract reverted without data.
+	Reason string
+	// Data is the raw revert data.
+	Data []byte
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return "execution reverted"
+	}
+	return "execution reverted: " + e.Reason
+}
+
+// TxFailedError reports a mined transaction with a failed status. It
+// unwraps to ErrTxFailed.
+type TxFailedError struct {
+	Receipt *types.Receipt
+	// Cause is the error hit when replaying the transaction, usually a
+	// *RevertError; nil when the replay succeeded.
+	Cause error
+}
+
+func (e *TxFailedError) Error() string {
+	msg := fmt.Sprintf("%v in block %d", ErrTxFailed, e.Receipt.BlockNumber.Uint64())
+	if e.Cause != nil {
+		msg += ": " + e.Cause.Error()
+	}
+	return msg
+}
+
+func (e *TxFailedError) Unwrap() error {
+	return ErrTxFailed
+}
+
+var panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+// panicReasons names the Solidity panic codes.
+var panicReasons = map[uint64]string{
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized function",
+}
+
+// decodeRevert turns revert data into a readable reason: an Error(string)
+// message, a Panic(uint256) code, or a custom error declared in one of the
+// embedded ABIs. Unknown selectors are reported as such.
+func decodeRevert(data []byte) string {
+	if len(data) < 4 {
+		return ""
+	}
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	if bytes.Equal(data[:4], panicSelector) && len(data) == 36 {
+		code := new(big.Int).SetBytes(data[4:]).Uint64()
+		if reason, ok := panicReasons[code]; ok {
+			return fmt.Sprintf("panic 0x%x (%s)", code, reason)
+		}
+		return fmt.Sprintf("panic 0x%x", code)
+	}
+	for _, c := range embeddedContracts {
+		parsed, err := c.meta.GetAbi()
+		if err != nil {
+			continue
+		}
+		for _, e := range parsed.Errors {
+			if !bytes.Equal(e.ID[:4], data[:4]) {
+				continue
+			}
+			args, err := e.Inputs.Unpack(data[4:])
+			if err != nil {
+				continue
+			}
+			return fmt.Sprintf("%s.%s%v", c.name, e.Name, args)
+		}
+	}
+	return fmt.Sprintf("unknown custom error %s", hexutil.Encode(data[:4]))
+}
+
+// asRevert converts a node error carrying revert data, or a plain
+// "execution reverted" message, into a *RevertError. Other errors are
+// returned unchanged.
+func asRevert(err error) error {
+	if err == nil {
+		return nil
+	}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if s, ok := dataErr.ErrorData().(string); ok {
+			if data, decodeErr := hexutil.Decode(s); decodeErr == nil {
+				return &RevertError{Reason: decodeRevert(data), Data: data}
+			}
+		}
+	}
+	if msg := err.Error(); strings.HasPrefix(msg, "execution reverted") {
+		reason := strings.TrimPrefix(strings.TrimPrefix(msg, "execution reverted"), ": ")
+		return &RevertError{Reason: reason}
+	}
+	return err
+}
+
+// revertBackend is the contract backend handed to the bindings. It turns
+// reverted calls and gas estimates into *RevertError.
+type revertBackend struct {
+	*ethclient.Client
+}
+
+func (b revertBackend) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	output, err := b.Client.CallContract(ctx, msg, blockNumber)
+	return output, asRevert(err)
+}
+
+func (b revertBackend) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	gas, err := b.Client.EstimateGas(ctx, msg)
+	return gas, asRevert(err)
+}
+
+// replayFailed re-executes the failed transaction of receipt with eth_call
+// on the state its block started from and returns the error it hits,
+// normally a *RevertError, or nil if the replay succeeds.
+func (c *Client) replayFailed(receipt *types.Receipt) error {
+	ctx := context.Background()
+	tx, _, err := c.conn.TransactionByHash(ctx, receipt.TxHash)
+	if err != nil {
+		return fmt.Errorf("replay: %w", err)
+	}
+	chainID, err := c.ChainID()
+	if err != nil {
+		return fmt.Errorf("replay: %w", err)
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+	if err != nil {
+		return fmt.Errorf("replay: %w", err)
+	}
+	msg := ethereum.CallMsg{
+		From:  from,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	parent := new(big.Int).Sub(receipt.BlockNumber, common.Big1)
+	_, err = c.conn.CallContract(ctx, msg, parent)
+	return asRevert(err)
+}
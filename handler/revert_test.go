@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// revertingNode is the error a node returns for a reverted call: code 3
+// with the revert data attached.
+type revertingNode struct {
+	data []byte
+}
+
+func (e revertingNode) Error() string          { return "execution reverted" }
+func (e revertingNode) ErrorCode() int         { return 3 }
+func (e revertingNode) ErrorData() interface{} { return hexutil.Encode(e.data) }
+
+func revertData(t *testing.T, signature, typ string, value interface{}) []byte {
+	argType, err := abi.NewType(typ, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	packed, err := abi.Arguments{{Type: argType}}.Pack(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(crypto.Keccak256([]byte(signature))[:4], packed...)
+}
+
+func TestDecodeRevert(t *testing.T) {
+	tests := []struct {
+		data []byte
+		want string
+	}{
+		{nil, ""},
+		{revertData(t, "Error(string)", "string", "not owner"), "not owner"},
+		{revertData(t, "Panic(uint256)", "uint256", big.NewInt(0x11)), "panic 0x11 (arithmetic overflow or underflow)"},
+		{revertData(t, "Panic(uint256)", "uint256", big.NewInt(0x99)), "panic 0x99"},
+		{revertData(t, "Unauthorized(address)", "address", common.HexToAddress("0x1")), "unknown custom error 0x8e4a23d6"},
+	}
+	for _, tt := range tests {
+		if have := decodeRevert(tt.data); have != tt.want {
+			t.Errorf("decodeRevert(%x) = %q, want %q", tt.data, have, tt.want)
+		}
+	}
+}
+
+func TestSendAbortsOnRevertedEstimate(t *testing.T) {
+	signer := newTestSigner(t)
+	node := &fakeNode{
+		gasPrice: big.NewInt(1),
+		nonce:    func(common.Address) uint64 { return 0 },
+		estimate: func(callArgs) (uint64, error) {
+			return 0, revertingNode{revertData(t, "Error(string)", "string", "not owner")}
+		},
+	}
+	cli := newFakeClient(t, node)
+	to := common.HexToAddress("0xd013")
+
+	_, err := cli.SendTransaction(signer, to, big.NewInt(1))
+	var revert *RevertError
+	if !errors.As(err, &revert) || revert.Reason != "not owner" {
+		t.Fatalf("have %v, want a revert with reason %q", err, "not owner")
+	}
+	if !strings.Contains(err.Error(), "execution reverted: not owner") {
+		t.Fatalf("reason missing from %q", err)
+	}
+	if len(node.sent) != 0 {
+		t.Fatalf("sent %d transactions after a failed simulation", len(node.sent))
+	}
+
+	cli.SetForce(true)
+	if _, err := cli.SendTransaction(signer, to, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	if len(node.sent) != 1 || node.sent[0].Gas() != DefaultGasLimit {
+		t.Fatalf("forced send: have %d transactions, want one with gas %d", len(node.sent), DefaultGasLimit)
+	}
+
+	// A fixed gas limit still has the call simulated, with that limit.
+	var simulatedGas uint64
+	node.estimate = func(args callArgs) (uint64, error) {
+		if args.Gas != nil {
+			simulatedGas = uint64(*args.Gas)
+		}
+		return 0, revertingNode{revertData(t, "Error(string)", "string", "not owner")}
+	}
+	cli.SetForce(false)
+	if _, err := cli.sendContractTransaction(signer, to, nil, nil, 90000); !errors.As(err, &revert) {
+		t.Fatalf("have %v, want a fixed gas limit send refused on revert", err)
+	}
+	if simulatedGas != 90000 || len(node.sent) != 1 {
+		t.Fatalf("simulated with gas %d and sent %d transactions", simulatedGas, len(node.sent))
+	}
+	cli.SetForce(true)
+	if _, err := cli.sendContractTransaction(signer, to, nil, nil, 90000); err != nil {
+		t.Fatal(err)
+	}
+	if len(node.sent) != 2 || node.sent[1].Gas() != 90000 {
+		t.Fatalf("forced send with a fixed gas limit: have %d transactions", len(node.sent))
+	}
+}
@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Signer signs transactions on behalf of a single account. Every method
+// that sends a transaction takes one instead of a raw private key.
+type Signer interface {
+	// Address is the account transactions are sent from.
+	Address() common.Address
+	// SignTx returns tx signed for chainID.
+	SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// keySigner signs with a private key held in memory.
+type keySigner struct {
+	key  *ecdsa.PrivateKey
+	addr common.Address
+}
+
+// NewKeySigner returns a Signer for key.
+func NewKeySigner(key *ecdsa.PrivateKey) Signer {
+	return &keySigner{key: key, addr: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+// HexKeySigner parses a hex encoded private key, with or without 0x prefix.
+func HexKeySigner(hexKey string) (Signer, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(hexKey), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %v", err)
+	}
+	return NewKeySigner(key), nil
+}
+
+// KeyFileSigner reads a hex encoded private key from path.
+func KeyFileSigner(path string) (Signer, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return HexKeySigner(string(data))
+}
+
+// KeystoreSigner decrypts the go-ethereum keystore JSON file at path.
+func KeystoreSigner(path, passphrase string) (Signer, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.DecryptKey(data, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt keystore %s: %w", path, err)
+	}
+	return NewKeySigner(key.PrivateKey), nil
+}
+
+func (s *keySigner) Address() common.Address {
+	return s.addr
+}
+
+func (s *keySigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.key)
+}
+
+// RemoteSigner asks an external signer such as clef to sign through its
+// account_signTransaction JSON-RPC method. The key never leaves the signer.
+type RemoteSigner struct {
+	client  *rpc.Client
+	account common.Address
+}
+
+// DialRemoteSigner connects to the signer at endpoint, e.g. clef's IPC path
+// or HTTP address, for account.
+func DialRemoteSigner(endpoint string, account common.Address) (*RemoteSigner, error) {
+	client, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("dial signer %s: %w", endpoint, err)
+	}
+	return NewRemoteSigner(client, account), nil
+}
+
+// NewRemoteSigner signs for account through client.
+func NewRemoteSigner(client *rpc.Client, account common.Address) *RemoteSigner {
+	return &RemoteSigner{client: client, account: account}
+}
+
+func (s *RemoteSigner) Address() common.Address {
+	return s.account
+}
+
+// SignTx sends the transaction fields to the signer and checks that the
+// transaction it returns is signed by the account and has the same signing
+// hash as tx, so no field was changed.
+func (s *RemoteSigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	data := hexutil.Bytes(tx.Data())
+	args := apitypes.SendTxArgs{
+		From:    common.NewMixedcaseAddress(s.account),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    &data,
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if tx.To() != nil {
+		to := common.NewMixedcaseAddress(*tx.To())
+		args.To = &to
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %d", tx.Type())
+	}
+
+	var res struct {
+		Raw hexutil.Bytes      `json:"raw"`
+		Tx  *types.Transaction `json:"tx"`
+	}
+	if err := s.client.CallContext(context.Background(), &res, "account_signTransaction", &args); err != nil {
+		return nil, fmt.Errorf("remote signer: %w", err)
+	}
+	if res.Tx == nil {
+		return nil, fmt.Errorf("remote signer returned no transaction")
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	sender, err := types.Sender(signer, res.Tx)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer: %w", err)
+	}
+	if sender != s.account {
+		return nil, fmt.Errorf("remote signer returned a transaction of %s, want %s", sender.Hex(), s.account.Hex())
+	}
+	if res.Tx.Type() != tx.Type() || signer.Hash(res.Tx) != signer.Hash(tx) {
+		return nil, fmt.Errorf("remote signer returned a different transaction than the one asked for")
+	}
+	return res.Tx, nil
+}
+
+// Close disconnects from the signer.
+func (s *RemoteSigner) Close() {
+	s.client.Close()
+}
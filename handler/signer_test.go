@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"crypto/ecdsa"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// signerStandIn serves the account_signTransaction method of clef with a
+// key held in memory. tamper, if set, changes the fields before signing.
+type signerStandIn struct {
+	key    *ecdsa.PrivateKey
+	tamper func(*apitypes.SendTxArgs)
+}
+
+type signTxResult struct {
+	Raw hexutil.Bytes      `json:"raw"`
+	Tx  *types.Transaction `json:"tx"`
+}
+
+func (s *signerStandIn) SignTransaction(args apitypes.SendTxArgs) (*signTxResult, error) {
+	if s.tamper != nil {
+		s.tamper(&args)
+	}
+	chainID := (*big.Int)(args.ChainID)
+	tx, err := types.SignTx(args.ToTransaction(), types.LatestSignerForChainID(chainID), s.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &signTxResult{Raw: raw, Tx: tx}, nil
+}
+
+func newRemoteSigner(t *testing.T, key *ecdsa.PrivateKey, account common.Address) *RemoteSigner {
+	return serveSigner(t, &signerStandIn{key: key}, account)
+}
+
+func serveSigner(t *testing.T, standIn *signerStandIn, account common.Address) *RemoteSigner {
+	server := rpc.NewServer()
+	if err := server.RegisterName("account", standIn); err != nil {
+		t.Fatal(err)
+	}
+	signer := NewRemoteSigner(rpc.DialInProc(server), account)
+	t.Cleanup(func() {
+		signer.Close()
+		server.Stop()
+	})
+	return signer
+}
+
+func TestKeySigners(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	want := crypto.PubkeyToAddress(key.PublicKey)
+	dir := t.TempDir()
+
+	hexKey := hexutil.Encode(crypto.FromECDSA(key))
+	keyFile := filepath.Join(dir, "key")
+	if err := ioutil.WriteFile(keyFile, []byte(hexKey+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	ks := keystore.NewKeyStore(filepath.Join(dir, "keystore"), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.ImportECDSA(key, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keystoreFile := account.URL.Path
+
+	loaders := map[string]func() (Signer, error){
+		"hex":      func() (Signer, error) { return HexKeySigner(hexKey) },
+		"keyfile":  func() (Signer, error) { return KeyFileSigner(keyFile) },
+		"keystore": func() (Signer, error) { return KeystoreSigner(keystoreFile, "secret") },
+	}
+	for name, load := range loaders {
+		signer, err := load()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if signer.Address() != want {
+			t.Fatalf("%s: have address %s, want %s", name, signer.Address().Hex(), want.Hex())
+		}
+	}
+	if _, err := KeystoreSigner(keystoreFile, "wrong"); err == nil {
+		t.Fatal("expected a wrong passphrase to be rejected")
+	}
+	if _, err := HexKeySigner("0x1234"); err == nil {
+		t.Fatal("expected a short key to be rejected")
+	}
+}
+
+func TestRemoteSigner(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	node := &fakeNode{
+		gasTipCap: big.NewInt(1),
+		baseFee:   big.NewInt(10),
+		nonce:     func(common.Address) uint64 { return 3 },
+	}
+	cli := newFakeClient(t, node)
+
+	signer := newRemoteSigner(t, key, from)
+	if _, err := cli.SendTransaction(signer, common.HexToAddress("0x02"), big.NewInt(5)); err != nil {
+		t.Fatal(err)
+	}
+	tx := node.sent[0]
+	sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(fakeChainID)), tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sender != from || tx.Nonce() != 3 || tx.Type() != types.DynamicFeeTxType {
+		t.Fatalf("have %s nonce %d type %d, want %s nonce 3 dynamic", sender.Hex(), tx.Nonce(), tx.Type(), from.Hex())
+	}
+
+	// A signer answering with another key must not be trusted.
+	other, _ := crypto.GenerateKey()
+	if _, err := cli.SendTransaction(newRemoteSigner(t, other, from), common.HexToAddress("0x02"), big.NewInt(5)); err == nil {
+		t.Fatal("expected a transaction signed by another account to be rejected")
+	}
+
+	// Nor one that changes what it was asked to sign.
+	tampered := map[string]func(*apitypes.SendTxArgs){
+		"to": func(args *apitypes.SendTxArgs) {
+			to := common.NewMixedcaseAddress(common.HexToAddress("0x666"))
+			args.To = &to
+		},
+		"value": func(args *apitypes.SendTxArgs) { args.Value = hexutil.Big(*big.NewInt(500)) },
+		"data": func(args *apitypes.SendTxArgs) {
+			data := hexutil.Bytes{0xde, 0xad}
+			args.Data = &data
+		},
+		"gas":     func(args *apitypes.SendTxArgs) { args.Gas *= 2 },
+		"fee cap": func(args *apitypes.SendTxArgs) { args.MaxFeePerGas = (*hexutil.Big)(big.NewInt(1e12)) },
+	}
+	sent := len(node.sent)
+	for field, tamper := range tampered {
+		signer := serveSigner(t, &signerStandIn{key: key, tamper: tamper}, from)
+		if _, err := cli.SendTransaction(signer, common.HexToAddress("0x02"), big.NewInt(5)); err == nil {
+			t.Errorf("expected a transaction with a changed %s to be rejected", field)
+		}
+	}
+	if len(node.sent) != sent {
+		t.Fatalf("sent %d tampered transactions", len(node.sent)-sent)
+	}
+}
@@ -2,208 +2,311 @@ package handler
 
 import (
 	"context"
-	"crypto/ecdsa"
+	"errors"
+	"fmt"
 	"math/big"
-	"os"
-	"strings"
-	"time"
+	"sync"
 
 	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/mapprotocol/marker_tool01/contracts"
 )
 
 const DefaultGasLimit = 4500000
 
 var zeroAddr = common.Address{}
 
-func dial(endpoint string) *ethclient.Client {
-	cli, err := ethclient.Dial(endpoint)
-	if err != nil {
-		log.Crit("dail failed", err.Error())
-	}
-	return cli
+// ErrTxFailed is returned when a transaction was mined with a failed status.
+var ErrTxFailed = errors.New("transaction failed")
+
+// Client talks to a single node and exposes the system contract operations.
+// Getters return the decoded values, setters return the hash of the sent
+// transaction which can be passed to WaitTx. Contract addresses are
+// resolved through the Registry.
+type Client struct {
+	rpc      *rpc.Client
+	conn     *ethclient.Client
+	backend  revertBackend
+	resolver *Resolver
+	nonces   *NonceManager
+
+	mu        sync.Mutex
+	bindings  map[common.Address]interface{}
+	feePolicy FeePolicy
+	force     bool
+	chainID   *big.Int
+	logger    log.Logger
 }
 
-func parseABI(abiStr string) *abi.ABI {
-	parsed, err := abi.JSON(strings.NewReader(abiStr))
+// Dial connects to the node at endpoint.
+func Dial(endpoint string) (*Client, error) {
+	rpcClient, err := rpc.Dial(endpoint)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("dial %s: %w", endpoint, err)
 	}
-	return &parsed
+	return NewClient(rpcClient)
 }
 
-func packInput(abi *abi.ABI, abiMethod string, params ...interface{}) []byte {
-	input, err := abi.Pack(abiMethod, params...)
+// NewClient wraps an existing connection.
+func NewClient(rpcClient *rpc.Client) (*Client, error) {
+	conn := ethclient.NewClient(rpcClient)
+	backend := revertBackend{conn}
+	resolver, err := NewResolver(backend)
 	if err != nil {
-		log.Error(abiMethod, " error", err)
+		return nil, err
 	}
-	return input
+	return &Client{
+		rpc:       rpcClient,
+		conn:      conn,
+		backend:   backend,
+		resolver:  resolver,
+		nonces:    NewNonceManager(conn),
+		bindings:  make(map[common.Address]interface{}),
+		feePolicy: DefaultFeePolicy(),
+		logger:    discardLogger(),
+	}, nil
+}
 
+// FeePolicy returns the fee policy applied to sent transactions.
+func (c *Client) FeePolicy() FeePolicy {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.feePolicy
 }
 
-func getResult(conn *ethclient.Client, txHash common.Hash) {
-	logger := log.New("func", "getResult")
-	logger.Info("Please waiting ", " txHash ", txHash.String())
-	for {
-		time.Sleep(time.Millisecond * 200)
-		_, isPending, err := conn.TransactionByHash(context.Background(), txHash)
-		if err != nil {
-			logger.Info("TransactionByHash", "error", err)
-		}
-		if !isPending {
-			break
-		}
-	}
+// SetFeePolicy replaces the fee policy applied to sent transactions.
+func (c *Client) SetFeePolicy(p FeePolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.feePolicy = p
+}
+
+// SetForce makes the client send transactions whose gas estimate fails,
+// e.g. because the simulation reverts, instead of refusing them.
+func (c *Client) SetForce(force bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.force = force
+}
+
+func (c *Client) forced() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.force
+}
 
-	queryTx(conn, txHash, false)
+// SetLogger makes the client and its resolver report what they work around,
+// such as a capped gas price or a genesis address fallback, to l. Nothing
+// is logged until it is called.
+func (c *Client) SetLogger(l log.Logger) {
+	c.mu.Lock()
+	c.logger = l
+	c.mu.Unlock()
+	c.resolver.SetLogger(l)
 }
 
-func queryTx(conn *ethclient.Client, txHash common.Hash, pending bool) {
-	logger := log.New("func", "queryTx")
-	if pending {
-		_, isPending, err := conn.TransactionByHash(context.Background(), txHash)
+func (c *Client) log() log.Logger {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.logger
+}
+
+// discardLogger is the logger of a client or resolver until SetLogger.
+func discardLogger() log.Logger {
+	l := log.New()
+	l.SetHandler(log.DiscardHandler())
+	return l
+}
+
+// ChainID returns the chain id of the node, fetched once.
+func (c *Client) ChainID() (*big.Int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.chainID == nil {
+		chainID, err := c.conn.ChainID(context.Background())
 		if err != nil {
-			logger.Error("TransactionByHash", "error", err)
-		}
-		if isPending {
-			println("In tx_pool no validator  process this, please query later")
-			os.Exit(0)
+			return nil, fmt.Errorf("chain id: %w", err)
 		}
+		c.chainID = chainID
 	}
+	return c.chainID, nil
+}
 
-	receipt, err := conn.TransactionReceipt(context.Background(), txHash)
-	if err != nil {
-		for {
-			time.Sleep(time.Millisecond * 200)
-			receipt, err = conn.TransactionReceipt(context.Background(), txHash)
-			if err == nil {
-				break
-			}
-		}
-		logger.Error("TransactionReceipt", "error", err)
-	}
+// Resolver returns the registry resolver of the client.
+func (c *Client) Resolver() *Resolver {
+	return c.resolver
+}
 
-	if receipt.Status == types.ReceiptStatusSuccessful {
-		logger.Info("Transaction Success", "block Number", receipt.BlockNumber.Uint64())
-	} else if receipt.Status == types.ReceiptStatusFailed {
-		logger.Error("Transaction Failed ", "Block Number", receipt.BlockNumber.Uint64())
-	}
+// Nonces returns the nonce manager used for every transaction the client
+// sends.
+func (c *Client) Nonces() *NonceManager {
+	return c.nonces
 }
 
-func CallContract(client *ethclient.Client, to common.Address, input []byte) []byte {
-	msg := ethereum.CallMsg{
-		From: zeroAddr,
-		To:   &to,
-		Data: input,
-	}
-	output, err := client.CallContract(context.Background(), msg, nil)
+// bound resolves identifier at height and returns the binding created by
+// newFn for the resolved address, reusing it on later calls.
+func (c *Client) bound(identifier string, height *big.Int, newFn func(common.Address) (interface{}, error)) (interface{}, common.Address, error) {
+	address, err := c.resolver.Resolve(identifier, height)
 	if err != nil {
-		log.Crit("method CallContract error", "err", err)
+		return nil, common.Address{}, err
 	}
-	return output
-}
-func CallContract3(client *ethclient.Client, to common.Address, input []byte, height *big.Int) []byte {
-	msg := ethereum.CallMsg{
-		From: zeroAddr,
-		To:   &to,
-		Data: input,
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if b, ok := c.bindings[address]; ok {
+		return b, address, nil
 	}
-	output, err := client.CallContract(context.Background(), msg, height)
+	b, err := newFn(address)
 	if err != nil {
-		log.Crit("method CallContract error", "err", err)
+		return nil, common.Address{}, err
 	}
-	return output
+	c.bindings[address] = b
+	return b, address, nil
 }
-func CallContract2(client *ethclient.Client, sender, to common.Address, input []byte) []byte {
-	msg := ethereum.CallMsg{
-		From: sender,
-		To:   &to,
-		Data: input,
-	}
-	output, err := client.CallContract(context.Background(), msg, nil)
+
+func (c *Client) epochRewardsAt(height *big.Int) (*contracts.EpochRewards, common.Address, error) {
+	b, address, err := c.bound(EpochRewardsID, height, func(a common.Address) (interface{}, error) {
+		return contracts.NewEpochRewards(a, c.backend)
+	})
 	if err != nil {
-		log.Crit("method CallContract error", "err", err)
+		return nil, address, err
 	}
-	return output
+	return b.(*contracts.EpochRewards), address, nil
 }
 
-func sendContractTransaction(client *ethclient.Client, from, toAddress common.Address, value *big.Int, privateKey *ecdsa.PrivateKey, input []byte, gasLimitSetting uint64) common.Hash {
-	// Ensure a valid value field and resolve the account nonce
-	logger := log.New("func", "sendContractTransaction")
-	nonce, err := client.PendingNonceAt(context.Background(), from)
+func (c *Client) electionAt(height *big.Int) (*contracts.Election, common.Address, error) {
+	b, address, err := c.bound(ElectionID, height, func(a common.Address) (interface{}, error) {
+		return contracts.NewElection(a, c.backend)
+	})
 	if err != nil {
-		logger.Error("PendingNonceAt", "error", err)
+		return nil, address, err
 	}
-	gasPrice, err := client.SuggestGasPrice(context.Background())
-	//gasPrice = big.NewInt(1000 000 000 000)
+	return b.(*contracts.Election), address, nil
+}
+
+func (c *Client) validatorsAt(height *big.Int) (*contracts.Validators, common.Address, error) {
+	b, address, err := c.bound(ValidatorsID, height, func(a common.Address) (interface{}, error) {
+		return contracts.NewValidators(a, c.backend)
+	})
 	if err != nil {
-		log.Error("SuggestGasPrice", "error", err)
+		return nil, address, err
 	}
-	gasLimit := uint64(DefaultGasLimit) // in units
-
-	//If the contract surely has code (or code is not needed), estimate the transaction
+	return b.(*contracts.Validators), address, nil
+}
 
-	msg := ethereum.CallMsg{From: from, To: &toAddress, GasPrice: gasPrice, Value: value, Data: input}
-	gasLimit, err = client.EstimateGas(context.Background(), msg)
+func (c *Client) lockedGoldAt(height *big.Int) (*contracts.LockedGold, common.Address, error) {
+	b, address, err := c.bound(LockedGoldID, height, func(a common.Address) (interface{}, error) {
+		return contracts.NewLockedGold(a, c.backend)
+	})
 	if err != nil {
-		logger.Error("Contract exec failed", "error", err)
-	}
-	if gasLimit < 1 {
-		//gasLimit = 866328
-		gasLimit = 2100000
+		return nil, address, err
 	}
-	gasLimit = uint64(DefaultGasLimit)
+	return b.(*contracts.LockedGold), address, nil
+}
 
-	if gasLimitSetting != 0 {
-		gasLimit = gasLimitSetting // in units
+func (c *Client) blockchainParametersAt(height *big.Int) (*contracts.BlockchainParameters, common.Address, error) {
+	b, address, err := c.bound(BlockchainParametersID, height, func(a common.Address) (interface{}, error) {
+		return contracts.NewBlockchainParameters(a, c.backend)
+	})
+	if err != nil {
+		return nil, address, err
 	}
+	return b.(*contracts.BlockchainParameters), address, nil
+}
 
-	// Create the transaction, sign it and schedule it for execution
-	tx := types.NewTransaction(nonce, toAddress, value, gasLimit, gasPrice, input)
-
-	chainID, _ := client.ChainID(context.Background())
-	logger.Info("tx info", "nonce ", nonce, " gasLimit ", gasLimit, " gasPrice ", gasPrice, " chainID ", chainID)
-	signer := types.LatestSignerForChainID(chainID)
-	signedTx, err := types.SignTx(tx, signer, privateKey)
+func (c *Client) governanceAt(height *big.Int) (*contracts.Governance, common.Address, error) {
+	b, address, err := c.bound(GovernanceID, height, func(a common.Address) (interface{}, error) {
+		return contracts.NewGovernance(a, c.backend)
+	})
 	if err != nil {
-		log.Crit("SignTx", "error", err)
+		return nil, address, err
 	}
+	return b.(*contracts.Governance), address, nil
+}
 
-	err = client.SendTransaction(context.Background(), signedTx)
+// Close closes the underlying connection.
+func (c *Client) Close() {
+	c.conn.Close()
+}
+
+// packOpts makes a binding transactor return the unsigned transaction
+// instead of sending it, so only its calldata is used by pack.
+var packOpts = &bind.TransactOpts{
+	Signer: func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		return tx, nil
+	},
+	Nonce:    common.Big0,
+	GasPrice: common.Big0,
+	GasLimit: 1,
+	NoSend:   true,
+}
+
+// pack extracts the calldata from a binding transactor called with packOpts.
+func pack(tx *types.Transaction, err error) ([]byte, error) {
 	if err != nil {
-		log.Crit("SendTransaction", "error", err)
+		return nil, err
 	}
-	return signedTx.Hash()
+	return tx.Data(), nil
+}
+
+// callOpts queries at height, nil meaning the latest block.
+func callOpts(height *big.Int) *bind.CallOpts {
+	return &bind.CallOpts{BlockNumber: height}
 }
-func sendTransaction0(client *ethclient.Client, from, to common.Address, value *big.Int, privateKey *ecdsa.PrivateKey) common.Hash {
-	// Ensure a valid value field and resolve the account nonce
-	logger := log.New("func", "sendTransaction0")
-	nonce, err := client.PendingNonceAt(context.Background(), from)
+
+// sendContractTransaction sends input to toAddress with gas and fees chosen
+// by the client's FeePolicy; a non-zero gasLimitSetting fixes the gas limit.
+func (c *Client) sendContractTransaction(signer Signer, toAddress common.Address, value *big.Int, input []byte, gasLimitSetting uint64) (common.Hash, error) {
+	logger := c.log().New("func", "sendContractTransaction")
+	chainID, err := c.ChainID()
 	if err != nil {
-		logger.Error("PendingNonceAt", "error", err)
-	}
-	//gasPrice, err := client.SuggestGasPrice(context.Background())
-	//if err != nil {
-	//	log.Error("SuggestGasPrice", "error", err)
-	//}
-	gasPrice := big.NewInt(110 * 1e9)
-	// Create the transaction, sign it and schedule it for execution
-	tx := types.NewTransaction(nonce, to, value, 31000, gasPrice, nil)
-
-	chainID, _ := client.ChainID(context.Background())
-	signer := types.LatestSignerForChainID(chainID)
-	signedTx, err := types.SignTx(tx, signer, privateKey)
+		return common.Hash{}, err
+	}
+	fees, err := c.fees(ethereum.CallMsg{From: signer.Address(), To: &toAddress, Value: value, Data: input}, gasLimitSetting)
 	if err != nil {
-		log.Crit("SignTx", "error", err)
+		return common.Hash{}, err
 	}
+	return c.signAndSend(signer, func(nonce uint64) *types.Transaction {
+		logger.Debug("tx info", "nonce", nonce, "gasLimit", fees.gasLimit, "gasPrice", fees.gasPrice,
+			"gasTipCap", fees.gasTipCap, "gasFeeCap", fees.gasFeeCap, "chainID", chainID)
+		return fees.newTx(chainID, nonce, toAddress, value, input)
+	})
+}
 
-	err = client.SendTransaction(context.Background(), signedTx)
+// signAndSend signs the transaction built by newTx with the next nonce of
+// from and sends it without waiting for it to be mined. If the node already
+// has the transaction it counts as sent. If the node reports the nonce as
+// already used the account is resynced and the send retried once.
+func (c *Client) signAndSend(signer Signer, newTx func(nonce uint64) *types.Transaction) (common.Hash, error) {
+	chainID, err := c.ChainID()
 	if err != nil {
-		log.Crit("SendTransaction", "error", err)
+		return common.Hash{}, err
+	}
+	from := signer.Address()
+	for attempt := 0; ; attempt++ {
+		nonce, err := c.nonces.Next(from)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("pending nonce: %w", err)
+		}
+		signedTx, err := signer.SignTx(newTx(nonce), chainID)
+		if err != nil {
+			c.nonces.Release(from, nonce)
+			return common.Hash{}, fmt.Errorf("sign tx: %w", err)
+		}
+		err = c.conn.SendTransaction(context.Background(), signedTx)
+		if err == nil || isKnownTxError(err) {
+			return signedTx.Hash(), nil
+		}
+		if isNonceError(err) && attempt == 0 {
+			c.log().Debug("Stale nonce, resyncing", "from", from, "nonce", nonce, "err", err)
+			c.nonces.Resync(from)
+			continue
+		}
+		c.nonces.Release(from, nonce)
+		return common.Hash{}, fmt.Errorf("send tx: %w", err)
 	}
-	return signedTx.Hash()
 }
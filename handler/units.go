@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// durationUnits are the units ParseDuration accepts, largest first, in
+// seconds.
+var durationUnits = []struct {
+	unit    string
+	seconds int64
+}{
+	{"w", 7 * 24 * 60 * 60},
+	{"d", 24 * 60 * 60},
+	{"h", 60 * 60},
+	{"m", 60},
+	{"s", 1},
+}
+
+// ParseDuration parses a duration such as 30m, 24h, 4w or 1d12h into
+// seconds. A plain number is taken as seconds. Units are w, d, h, m and s;
+// numbers may have a fraction as long as the total is whole seconds.
+func ParseDuration(s string) (*big.Int, error) {
+	if n, ok := new(big.Int).SetString(s, 10); ok {
+		if n.Sign() < 0 {
+			return nil, fmt.Errorf("negative duration %q", s)
+		}
+		return n, nil
+	}
+	rest := strings.TrimSpace(s)
+	if rest == "" {
+		return nil, fmt.Errorf("empty duration")
+	}
+	total := new(big.Rat)
+	for rest != "" {
+		i := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid duration %q, want e.g. 30m, 24h or 4w", s)
+		}
+		n, ok := new(big.Rat).SetString(rest[:i])
+		if !ok {
+			return nil, fmt.Errorf("invalid duration %q", s)
+		}
+		rest = rest[i:]
+		var seconds int64
+		for _, u := range durationUnits {
+			if strings.HasPrefix(rest, u.unit) {
+				seconds = u.seconds
+				rest = rest[len(u.unit):]
+				break
+			}
+		}
+		if seconds == 0 {
+			return nil, fmt.Errorf("invalid duration %q, units are w, d, h, m and s", s)
+		}
+		total.Add(total, n.Mul(n, new(big.Rat).SetInt64(seconds)))
+	}
+	if !total.IsInt() {
+		return nil, fmt.Errorf("duration %q is not a whole number of seconds", s)
+	}
+	return new(big.Int).Set(total.Num()), nil
+}
+
+// FormatDuration prints seconds in the largest units that fit, e.g. 4w or
+// 1d12h.
+func FormatDuration(seconds *big.Int) string {
+	if seconds.Sign() == 0 {
+		return "0s"
+	}
+	var (
+		b    strings.Builder
+		rest = new(big.Int).Set(seconds)
+	)
+	if rest.Sign() < 0 {
+		b.WriteByte('-')
+		rest.Neg(rest)
+	}
+	for _, u := range durationUnits {
+		n, m := new(big.Int).DivMod(rest, big.NewInt(u.seconds), new(big.Int))
+		if n.Sign() > 0 {
+			fmt.Fprintf(&b, "%v%s", n, u.unit)
+		}
+		rest = m
+	}
+	return b.String()
+}
+
+// fixidityDecimals is the precision of FixidityLib fractions.
+const fixidityDecimals = 24
+
+var fractionPattern = regexp.MustCompile(`^(\d*)(?:\.(\d*))?$`)
+
+// ParseFixidity parses a decimal fraction such as 0.005 into the 24 decimal
+// fixed point format of FixidityLib.
+func ParseFixidity(s string) (*big.Int, error) {
+	m := fractionPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil || m[1]+m[2] == "" {
+		return nil, fmt.Errorf("invalid fraction %q, want e.g. 0.005", s)
+	}
+	whole, frac := m[1], m[2]
+	if len(frac) > fixidityDecimals {
+		return nil, fmt.Errorf("fraction %q has more than %d decimals", s, fixidityDecimals)
+	}
+	n, _ := new(big.Int).SetString(whole+frac+strings.Repeat("0", fixidityDecimals-len(frac)), 10)
+	return n, nil
+}
+
+// FormatFixidity prints a FixidityLib fraction as a decimal, e.g. 0.005.
+func FormatFixidity(v *big.Int) string {
+	sign := ""
+	abs := new(big.Int).Set(v)
+	if abs.Sign() < 0 {
+		sign = "-"
+		abs.Neg(abs)
+	}
+	whole, frac := new(big.Int).DivMod(abs, fixed1, new(big.Int))
+	if frac.Sign() == 0 {
+		return sign + whole.String()
+	}
+	digits := fmt.Sprintf("%0*s", fixidityDecimals, frac.String())
+	return sign + whole.String() + "." + strings.TrimRight(digits, "0")
+}
+
+// ParsePercent parses a percentage such as 5, 0.5% or 12.5% into a
+// FixidityLib fraction.
+func ParsePercent(s string) (*big.Int, error) {
+	f, err := ParseFixidity(strings.TrimSuffix(strings.TrimSpace(s), "%"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid percentage %q, want e.g. 5%%", s)
+	}
+	q, r := new(big.Int).QuoRem(f, big.NewInt(100), new(big.Int))
+	if r.Sign() != 0 {
+		return nil, fmt.Errorf("percentage %q has more than %d decimals", s, fixidityDecimals-2)
+	}
+	return q, nil
+}
+
+// FormatPercent prints a FixidityLib fraction as a percentage, e.g. 5%.
+func FormatPercent(v *big.Int) string {
+	return FormatFixidity(new(big.Int).Mul(v, big.NewInt(100))) + "%"
+}
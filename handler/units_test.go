@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		seconds int64
+		out     string
+	}{
+		{"30m", 30 * 60, "30m"},
+		{"24h", 24 * 3600, "1d"},
+		{"4w", 4 * 7 * 86400, "4w"},
+		{"1d12h", 36 * 3600, "1d12h"},
+		{"1.5h", 90 * 60, "1h30m"},
+		{"90", 90, "1m30s"},
+		{"0", 0, "0s"},
+	}
+	for _, tt := range tests {
+		seconds, err := ParseDuration(tt.in)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.in, err)
+		}
+		if seconds.Int64() != tt.seconds {
+			t.Errorf("%s: have %v seconds, want %d", tt.in, seconds, tt.seconds)
+		}
+		if out := FormatDuration(seconds); out != tt.out {
+			t.Errorf("%s: formatted as %s, want %s", tt.in, out, tt.out)
+		}
+	}
+	for _, in := range []string{"", "-5", "5x", "h", "0.5s", "1h30"} {
+		if _, err := ParseDuration(in); err == nil {
+			t.Errorf("%q: expected an error", in)
+		}
+	}
+}
+
+func TestFixidity(t *testing.T) {
+	tests := []struct {
+		in, fixed, out string
+	}{
+		{"0.005", "5000000000000000000000", "0.005"},
+		{"0.2", "200000000000000000000000", "0.2"},
+		{"1", "1000000000000000000000000", "1"},
+		{".5", "500000000000000000000000", "0.5"},
+		{"1.25", "1250000000000000000000000", "1.25"},
+		{"0.000000000000000000000001", "1", "0.000000000000000000000001"},
+		{"0", "0", "0"},
+	}
+	for _, tt := range tests {
+		v, err := ParseFixidity(tt.in)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.in, err)
+		}
+		if v.String() != tt.fixed {
+			t.Errorf("%s: have %v, want %s", tt.in, v, tt.fixed)
+		}
+		if out := FormatFixidity(v); out != tt.out {
+			t.Errorf("%s: formatted as %s, want %s", tt.in, out, tt.out)
+		}
+	}
+	for _, in := range []string{"", ".", "-0.5", "1e-3", "0.0000000000000000000000001", "abc"} {
+		if _, err := ParseFixidity(in); err == nil {
+			t.Errorf("%q: expected an error", in)
+		}
+	}
+	if out := FormatFixidity(big.NewInt(-5)); out != "-0.000000000000000000000005" {
+		t.Errorf("have %s", out)
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		in, fraction, out string
+	}{
+		{"5", "0.05", "5%"},
+		{"0.5%", "0.005", "0.5%"},
+		{"12.5%", "0.125", "12.5%"},
+		{"100%", "1", "100%"},
+	}
+	for _, tt := range tests {
+		v, err := ParsePercent(tt.in)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.in, err)
+		}
+		if have := FormatFixidity(v); have != tt.fraction {
+			t.Errorf("%s: have fraction %s, want %s", tt.in, have, tt.fraction)
+		}
+		if out := FormatPercent(v); out != tt.out {
+			t.Errorf("%s: formatted as %s, want %s", tt.in, out, tt.out)
+		}
+	}
+	for _, in := range []string{"", "%", "-5%", "0.0000000000000000000000001%"} {
+		if _, err := ParsePercent(in); err == nil {
+			t.Errorf("%q: expected an error", in)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+// VoteValue mirrors the Proposals.VoteValue enum of the Governance contract.
+type VoteValue uint8
+
+const (
+	VoteNone VoteValue = iota
+	VoteAbstain
+	VoteNo
+	VoteYes
+)
+
+var voteNames = []string{"None", "Abstain", "No", "Yes"}
+
+func (v VoteValue) String() string {
+	if int(v) < len(voteNames) {
+		return voteNames[v]
+	}
+	return fmt.Sprintf("VoteValue(%d)", uint8(v))
+}
+
+// ParseVoteValue parses yes, no or abstain.
+func ParseVoteValue(s string) (VoteValue, error) {
+	switch strings.ToLower(s) {
+	case "yes":
+		return VoteYes, nil
+	case "no":
+		return VoteNo, nil
+	case "abstain":
+		return VoteAbstain, nil
+	}
+	return VoteNone, fmt.Errorf("invalid vote %q, want yes, no or abstain", s)
+}
+
+// UpvoteRecord is the proposal an account upvotes and with what weight.
+type UpvoteRecord struct {
+	ProposalID *big.Int
+	Weight     *big.Int
+}
+
+// VoteRecord is an account's referendum vote on a dequeued proposal.
+type VoteRecord struct {
+	ProposalID *big.Int
+	Index      *big.Int
+	Value      VoteValue
+	Weight     *big.Int
+}
+
+// VoteTotals are the referendum votes cast on a proposal.
+type VoteTotals struct {
+	Yes, No, Abstain *big.Int
+}
+
+// Upvote upvotes queued proposal id with all of the signer's locked gold.
+// The proposal's neighbours in the upvote ordered queue are computed from
+// getQueue as it will be once the upvote is counted.
+func (c *Client) Upvote(signer Signer, id *big.Int) (common.Hash, error) {
+	governance, address, err := c.governanceAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	queue, err := c.upvoteQueue()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	proposal := queue.queued(id)
+	if proposal == nil {
+		return common.Hash{}, fmt.Errorf("proposal %v is not queued", id)
+	}
+	if proposal.Expired {
+		return common.Hash{}, fmt.Errorf("proposal %v expired in the queue", id)
+	}
+	record, err := c.UpvoteRecord(signer.Address())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if record.ProposalID.Cmp(id) == 0 {
+		return common.Hash{}, fmt.Errorf("%s already upvotes proposal %v", signer.Address().Hex(), id)
+	}
+	if upvoted := queue.queued(record.ProposalID); upvoted != nil && !upvoted.Expired {
+		return common.Hash{}, fmt.Errorf("%s upvotes proposal %v, revoke that upvote first", signer.Address().Hex(), record.ProposalID)
+	}
+	weight, err := c.lockedGold(signer.Address())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if weight.Sign() == 0 {
+		return common.Hash{}, fmt.Errorf("%s has no locked gold to upvote with", signer.Address().Hex())
+	}
+
+	lesser, greater := queue.neighbours(id, new(big.Int).Add(proposal.Upvotes, weight))
+	input, err := pack(governance.Upvote(packOpts, id, lesser, greater))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, address, nil, input, 0)
+}
+
+// RevokeUpvote withdraws the signer's upvote. If the upvoted proposal is
+// still queued its neighbours after the revocation are computed; otherwise
+// the contract only clears the record.
+func (c *Client) RevokeUpvote(signer Signer) (common.Hash, error) {
+	governance, address, err := c.governanceAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	record, err := c.UpvoteRecord(signer.Address())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if record.ProposalID.Sign() == 0 {
+		return common.Hash{}, fmt.Errorf("%s has no upvote to revoke", signer.Address().Hex())
+	}
+	queue, err := c.upvoteQueue()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	lesser, greater := new(big.Int), new(big.Int)
+	if proposal := queue.queued(record.ProposalID); proposal != nil {
+		lesser, greater = queue.neighbours(record.ProposalID, new(big.Int).Sub(proposal.Upvotes, record.Weight))
+	}
+	input, err := pack(governance.RevokeUpvote(packOpts, lesser, greater))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, address, nil, input, 0)
+}
+
+// Vote casts the signer's referendum vote on proposal id, looking up the
+// proposal's index in the dequeue.
+func (c *Client) Vote(signer Signer, id *big.Int, value VoteValue) (common.Hash, error) {
+	if value == VoteNone || value > VoteYes {
+		return common.Hash{}, fmt.Errorf("invalid vote %v", value)
+	}
+	governance, address, err := c.governanceAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	index, err := dequeueIndex(governance, id)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if stage, err := stageOf(governance, nil, id); err != nil {
+		return common.Hash{}, err
+	} else if stage != StageReferendum {
+		return common.Hash{}, fmt.Errorf("proposal %v is in stage %v, votes are only taken in %v", id, stage, StageReferendum)
+	}
+	input, err := pack(governance.Vote(packOpts, id, index, uint8(value)))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, address, nil, input, 0)
+}
+
+// RevokeVotes withdraws the signer's votes on all proposals in referendum.
+func (c *Client) RevokeVotes(signer Signer) (common.Hash, error) {
+	governance, address, err := c.governanceAt(nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	input, err := pack(governance.RevokeVotes(packOpts))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return c.sendContractTransaction(signer, address, nil, input, 0)
+}
+
+// UpvoteRecord returns the proposal account upvotes, zero if none.
+func (c *Client) UpvoteRecord(account common.Address) (*UpvoteRecord, error) {
+	governance, _, err := c.governanceAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	id, weight, err := governance.GetUpvoteRecord(nil, account)
+	if err != nil {
+		return nil, fmt.Errorf("upvote record of %s: %w", account.Hex(), err)
+	}
+	return &UpvoteRecord{ProposalID: id, Weight: weight}, nil
+}
+
+// VoteRecord returns account's vote on dequeued proposal id. The value is
+// VoteNone if the account has not voted on it.
+func (c *Client) VoteRecord(account common.Address, id *big.Int) (*VoteRecord, error) {
+	governance, _, err := c.governanceAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	index, err := dequeueIndex(governance, id)
+	if err != nil {
+		return nil, err
+	}
+	recorded, value, weight, err := governance.GetVoteRecord(nil, account, index)
+	if err != nil {
+		return nil, fmt.Errorf("vote record of %s: %w", account.Hex(), err)
+	}
+	record := &VoteRecord{ProposalID: id, Index: index, Weight: weight}
+	if recorded.Cmp(id) == 0 {
+		record.Value = VoteValue(value.Uint64())
+	} else {
+		record.Weight = new(big.Int)
+	}
+	return record, nil
+}
+
+// VoteTotals returns the yes, no and abstain votes on proposal id.
+func (c *Client) VoteTotals(id *big.Int) (*VoteTotals, error) {
+	governance, _, err := c.governanceAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	yes, no, abstain, err := governance.GetVoteTotals(nil, id)
+	if err != nil {
+		return nil, fmt.Errorf("proposal %v vote totals: %w", id, err)
+	}
+	return &VoteTotals{Yes: yes, No: no, Abstain: abstain}, nil
+}
+
+func (c *Client) lockedGold(account common.Address) (*big.Int, error) {
+	lockedGold, _, err := c.lockedGoldAt(nil)
+	if err != nil {
+		return nil, err
+	}
+	total, err := lockedGold.GetAccountTotalLockedGold(nil, account)
+	if err != nil {
+		return nil, fmt.Errorf("locked gold of %s: %w", account.Hex(), err)
+	}
+	return total, nil
+}
+
+// dequeueIndex returns the position of proposal id in the dequeue, which
+// vote and getVoteRecord take alongside the id.
+func dequeueIndex(governance *contracts.Governance, id *big.Int) (*big.Int, error) {
+	dequeued, err := governance.GetDequeue(nil)
+	if err != nil {
+		return nil, fmt.Errorf("dequeue: %w", err)
+	}
+	for i, d := range dequeued {
+		if d.Cmp(id) == 0 {
+			return big.NewInt(int64(i)), nil
+		}
+	}
+	return nil, fmt.Errorf("proposal %v is not dequeued", id)
+}
+
+// upvoteQueue reads the queue for computing upvote neighbours.
+func (c *Client) upvoteQueue() (*GovernanceQueue, error) {
+	queue, err := c.Queue()
+	if err != nil {
+		return nil, err
+	}
+	if queue.DequeueDue() {
+		// upvote and revokeUpvote dequeue first, which would invalidate
+		// neighbours computed from the current queue.
+		return nil, fmt.Errorf("a dequeue is due, run dequeueProposalsIfReady first")
+	}
+	return queue, nil
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mapprotocol/marker_tool01/contracts"
+)
+
+func TestQueueNeighbours(t *testing.T) {
+	q := &GovernanceQueue{Queued: []QueuedProposal{
+		{ID: big.NewInt(1), Upvotes: big.NewInt(100)},
+		{ID: big.NewInt(2), Upvotes: big.NewInt(50)},
+		{ID: big.NewInt(3), Upvotes: big.NewInt(10)},
+	}}
+	tests := []struct {
+		id, upvotes     int64
+		lesser, greater int64
+	}{
+		{3, 70, 2, 1},
+		{3, 200, 1, 0},
+		{1, 0, 0, 3},
+		{2, 50, 3, 1},
+		{4, 20, 3, 2},
+	}
+	for _, tt := range tests {
+		lesser, greater := q.neighbours(big.NewInt(tt.id), big.NewInt(tt.upvotes))
+		if lesser.Int64() != tt.lesser || greater.Int64() != tt.greater {
+			t.Errorf("proposal %d at %d: have %v < %v, want %d < %d", tt.id, tt.upvotes, lesser, greater, tt.lesser, tt.greater)
+		}
+	}
+}
+
+func TestUpvoteAndVote(t *testing.T) {
+	upvoted := struct{ id, weight int64 }{}
+	stage := StageReferendum
+	node := governanceNode(t, map[string]contractMethod{
+		"lastDequeue":               returns(big.NewInt(1000)),
+		"dequeueFrequency":          returns(big.NewInt(3600)),
+		"queueExpiry":               returns(big.NewInt(86400)),
+		"getQueue":                  returns([]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)}, []*big.Int{big.NewInt(100), big.NewInt(50), big.NewInt(10)}),
+		"getDequeue":                returns([]*big.Int{big.NewInt(0), big.NewInt(7)}),
+		"isQueuedProposalExpired":   returns(false),
+		"isDequeuedProposalExpired": returns(false),
+		"getProposalStage": func(args []interface{}) ([]interface{}, error) {
+			if args[0].(*big.Int).Int64() == 7 {
+				return []interface{}{uint8(stage)}, nil
+			}
+			return []interface{}{uint8(StageQueued)}, nil
+		},
+		"getUpvoteRecord": func([]interface{}) ([]interface{}, error) {
+			return []interface{}{big.NewInt(upvoted.id), big.NewInt(upvoted.weight)}, nil
+		},
+	})
+	lockedGold, _ := contracts.LockedGoldMetaData.GetAbi()
+	node.serve(t, GenesisAddresses["LockedGoldProxy"], lockedGold, map[string]contractMethod{
+		"getAccountTotalLockedGold": returns(big.NewInt(60)),
+	})
+	node.time = 2000
+	node.gasPrice = big.NewInt(1)
+	node.nonce = func(common.Address) uint64 { return 0 }
+	cli := newFakeClient(t, node)
+	signer := newTestSigner(t)
+	governance, _ := contracts.GovernanceMetaData.GetAbi()
+	sentArgs := func(method string) []interface{} {
+		t.Helper()
+		data := node.sent[len(node.sent)-1].Data()
+		if string(data[:4]) != string(governance.Methods[method].ID) {
+			t.Fatalf("have selector %x, want %s", data[:4], method)
+		}
+		args, err := governance.Methods[method].Inputs.Unpack(data[4:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return args
+	}
+
+	if _, err := cli.Upvote(signer, big.NewInt(3)); err != nil {
+		t.Fatal(err)
+	}
+	if args := sentArgs("upvote"); args[1].(*big.Int).Int64() != 2 || args[2].(*big.Int).Int64() != 1 {
+		t.Fatalf("have upvote %v", args)
+	}
+	upvoted.id, upvoted.weight = 2, 50
+	if _, err := cli.Upvote(signer, big.NewInt(3)); err == nil {
+		t.Fatal("expected a second queued upvote to be refused")
+	}
+	if _, err := cli.Upvote(signer, big.NewInt(9)); err == nil {
+		t.Fatal("expected an upvote of an unqueued proposal to be refused")
+	}
+	if _, err := cli.RevokeUpvote(signer); err != nil {
+		t.Fatal(err)
+	}
+	if args := sentArgs("revokeUpvote"); args[0].(*big.Int).Int64() != 0 || args[1].(*big.Int).Int64() != 3 {
+		t.Fatalf("have revokeUpvote %v", args)
+	}
+
+	if _, err := cli.Vote(signer, big.NewInt(7), VoteNo); err != nil {
+		t.Fatal(err)
+	}
+	if args := sentArgs("vote"); args[1].(*big.Int).Int64() != 1 || args[2].(uint8) != uint8(VoteNo) {
+		t.Fatalf("have vote %v", args)
+	}
+	stage = StageApproval
+	if _, err := cli.Vote(signer, big.NewInt(7), VoteYes); err == nil {
+		t.Fatal("expected a vote outside the referendum to be refused")
+	}
+	if _, err := cli.Vote(signer, big.NewInt(3), VoteYes); err == nil {
+		t.Fatal("expected a vote on a queued proposal to be refused")
+	}
+
+	node.time = 4600
+	if _, err := cli.Upvote(signer, big.NewInt(3)); err == nil {
+		t.Fatal("expected an upvote to be refused while a dequeue is due")
+	}
+}
@@ -0,0 +1,284 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// DefaultPollInterval is how often Wait asks the node about a transaction.
+	DefaultPollInterval = 200 * time.Millisecond
+	// DefaultWaitTimeout bounds WaitTx.
+	DefaultWaitTimeout = 5 * time.Minute
+	// DefaultUnknownGrace is how long Wait lets a transaction stay unknown
+	// to the node, which behind a load balancer may not have seen it yet,
+	// before reporting it dropped.
+	DefaultUnknownGrace = 30 * time.Second
+)
+
+// ErrTxDropped is returned when a transaction left the pool, or was never
+// known to the node, without being mined and its nonce is still unused.
+var ErrTxDropped = errors.New("transaction dropped")
+
+// ReplacedError reports a transaction that will never be mined because
+// another transaction with the same sender and nonce was.
+type ReplacedError struct {
+	Hash  common.Hash
+	From  common.Address
+	Nonce uint64
+}
+
+func (e *ReplacedError) Error() string {
+	return fmt.Sprintf("transaction %s replaced: nonce %d of %s was used by another transaction", e.Hash.Hex(), e.Nonce, e.From.Hex())
+}
+
+// WaitOpts tune how Wait follows a transaction.
+type WaitOpts struct {
+	// Confirmations is the number of blocks required on top of the block
+	// including the transaction; zero returns as soon as it is mined.
+	Confirmations uint64
+	// PollInterval defaults to DefaultPollInterval.
+	PollInterval time.Duration
+	// UnknownGrace defaults to DefaultUnknownGrace.
+	UnknownGrace time.Duration
+}
+
+// TxResult is the outcome of a mined transaction.
+type TxResult struct {
+	Hash              common.Hash
+	Status            uint64
+	BlockNumber       uint64
+	BlockHash         common.Hash
+	GasUsed           uint64
+	EffectiveGasPrice *big.Int
+	Logs              []*types.Log
+	// Confirmations is the number of blocks mined on top of BlockNumber
+	// when the result was taken.
+	Confirmations uint64
+	Receipt       *types.Receipt
+}
+
+// Wait follows txHash until it is mined with opts.Confirmations blocks on
+// top, ctx is done, or it can no longer be mined. A transaction that left
+// the pool unmined, or stayed unknown to the node for opts.UnknownGrace, is
+// reported as ErrTxDropped, one whose nonce was taken
+// by another transaction as *ReplacedError. A reorg that removes the
+// including block sends Wait back to waiting. A mined but reverted
+// transaction returns its result together with a *TxFailedError.
+func (c *Client) Wait(ctx context.Context, txHash common.Hash, opts WaitOpts) (*TxResult, error) {
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	grace := opts.UnknownGrace
+	if grace <= 0 {
+		grace = DefaultUnknownGrace
+	}
+	start := time.Now()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	done := func() error {
+		err := ctx.Err()
+		if err == nil {
+			err = context.DeadlineExceeded
+		}
+		return fmt.Errorf("wait for %s: %w", txHash.Hex(), err)
+	}
+	var tx *types.Transaction
+	for {
+		result, err := c.result(ctx, txHash, tx)
+		if err == nil && result == nil {
+			tx, err = c.unmined(ctx, txHash, tx)
+			if err == nil && tx == nil && time.Since(start) >= grace {
+				err = fmt.Errorf("%w: %s is unknown to the node after %v", ErrTxDropped, txHash.Hex(), grace)
+			}
+		}
+		switch {
+		case err != nil && expired(ctx):
+			return nil, done()
+		case err != nil:
+			return nil, err
+		case result != nil && result.Confirmations >= opts.Confirmations:
+			if result.Status == types.ReceiptStatusFailed {
+				return result, &TxFailedError{Receipt: result.Receipt, Cause: c.replayFailed(result.Receipt)}
+			}
+			return result, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, done()
+		case <-ticker.C:
+		}
+	}
+}
+
+// expired reports whether ctx is done or past its deadline. A call cut
+// short by the deadline can fail before ctx itself reports it.
+func expired(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return true
+	}
+	deadline, ok := ctx.Deadline()
+	return ok && !time.Now().Before(deadline)
+}
+
+// unmined checks on a transaction without a receipt and returns it when it
+// can still be mined. known is the transaction as last seen, if ever; while
+// the node has never known it unmined returns nil without an error.
+func (c *Client) unmined(ctx context.Context, txHash common.Hash, known *types.Transaction) (*types.Transaction, error) {
+	tx, _, err := c.conn.TransactionByHash(ctx, txHash)
+	switch {
+	case err == nil:
+		return tx, nil
+	case !errors.Is(err, ethereum.NotFound):
+		return nil, fmt.Errorf("transaction %s: %w", txHash.Hex(), err)
+	case known == nil:
+		return nil, nil
+	}
+
+	chainID, err := c.ChainID()
+	if err != nil {
+		return nil, err
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(chainID), known)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := c.conn.NonceAt(ctx, from, nil)
+	if err != nil {
+		return nil, fmt.Errorf("nonce of %s: %w", from.Hex(), err)
+	}
+	if nonce <= known.Nonce() {
+		return nil, fmt.Errorf("%w: %s left the pool unmined", ErrTxDropped, txHash.Hex())
+	}
+	// The nonce is used; make sure it was not this transaction being mined
+	// since the receipt was asked for.
+	if result, err := c.result(ctx, txHash, known); err != nil || result != nil {
+		return known, err
+	}
+	return nil, &ReplacedError{Hash: txHash, From: from, Nonce: known.Nonce()}
+}
+
+// result returns the outcome of txHash, nil if it has no receipt. tx is the
+// transaction if already known, used when the node does not report the
+// effective gas price.
+func (c *Client) result(ctx context.Context, txHash common.Hash, tx *types.Transaction) (*TxResult, error) {
+	var raw json.RawMessage
+	if err := c.rpc.CallContext(ctx, &raw, "eth_getTransactionReceipt", txHash); err != nil {
+		return nil, fmt.Errorf("receipt of %s: %w", txHash.Hex(), err)
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	receipt := new(types.Receipt)
+	if err := json.Unmarshal(raw, receipt); err != nil {
+		return nil, fmt.Errorf("receipt of %s: %w", txHash.Hex(), err)
+	}
+	var extra struct {
+		EffectiveGasPrice *hexutil.Big `json:"effectiveGasPrice"`
+	}
+	if err := json.Unmarshal(raw, &extra); err != nil {
+		return nil, fmt.Errorf("receipt of %s: %w", txHash.Hex(), err)
+	}
+	head, err := c.conn.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("block number: %w", err)
+	}
+
+	result := &TxResult{
+		Hash:              txHash,
+		Status:            receipt.Status,
+		BlockNumber:       receipt.BlockNumber.Uint64(),
+		BlockHash:         receipt.BlockHash,
+		GasUsed:           receipt.GasUsed,
+		EffectiveGasPrice: (*big.Int)(extra.EffectiveGasPrice),
+		Logs:              receipt.Logs,
+		Receipt:           receipt,
+	}
+	if head > result.BlockNumber {
+		result.Confirmations = head - result.BlockNumber
+	}
+	if result.EffectiveGasPrice == nil {
+		if result.EffectiveGasPrice, err = c.effectiveGasPrice(ctx, receipt, tx); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// effectiveGasPrice works out the price paid by a mined transaction for
+// nodes whose receipts lack the field.
+func (c *Client) effectiveGasPrice(ctx context.Context, receipt *types.Receipt, tx *types.Transaction) (*big.Int, error) {
+	if tx == nil {
+		var err error
+		if tx, _, err = c.conn.TransactionByHash(ctx, receipt.TxHash); err != nil {
+			return nil, fmt.Errorf("transaction %s: %w", receipt.TxHash.Hex(), err)
+		}
+	}
+	if tx.Type() != types.DynamicFeeTxType {
+		return tx.GasPrice(), nil
+	}
+	baseFee, err := c.baseFee(ctx, receipt.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if baseFee == nil {
+		return tx.GasFeeCap(), nil
+	}
+	price := new(big.Int).Add(tx.GasTipCap(), baseFee)
+	if price.Cmp(tx.GasFeeCap()) > 0 {
+		price.Set(tx.GasFeeCap())
+	}
+	return price, nil
+}
+
+// WaitTx waits up to DefaultWaitTimeout for txHash to be mined and returns
+// its receipt. A mined but reverted transaction is reported as
+// *TxFailedError.
+func (c *Client) WaitTx(txHash common.Hash) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultWaitTimeout)
+	defer cancel()
+	result, err := c.Wait(ctx, txHash, WaitOpts{})
+	if result == nil {
+		return nil, err
+	}
+	return result.Receipt, err
+}
+
+// QueryTx looks up the receipt of txHash once. With pending set it first
+// checks the pool and returns pending=true without a receipt if the
+// transaction has not been mined yet. A missing receipt is reported as
+// ethereum.NotFound, a failed transaction is replayed to find its revert
+// reason and reported as *TxFailedError.
+func (c *Client) QueryTx(txHash common.Hash, pending bool) (receipt *types.Receipt, isPending bool, err error) {
+	ctx := context.Background()
+	if pending {
+		_, isPending, err := c.conn.TransactionByHash(ctx, txHash)
+		if err != nil {
+			return nil, false, err
+		}
+		if isPending {
+			return nil, true, nil
+		}
+	}
+
+	receipt, err = c.conn.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, false, err
+	}
+	if receipt.Status == types.ReceiptStatusFailed {
+		return receipt, false, &TxFailedError{Receipt: receipt, Cause: c.replayFailed(receipt)}
+	}
+	return receipt, false, nil
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func sentTransfer(t *testing.T, node *fakeNode, cli *Client) *types.Transaction {
+	signer := newTestSigner(t)
+	if _, err := cli.SendTransaction(signer, common.HexToAddress("0xd013"), big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	return node.sent[len(node.sent)-1]
+}
+
+func TestWaitConfirmations(t *testing.T) {
+	node := &fakeNode{gasPrice: big.NewInt(7), nonce: func(common.Address) uint64 { return 0 }}
+	cli := newFakeClient(t, node)
+	tx := sentTransfer(t, node, cli)
+	node.mine(tx, 5, types.ReceiptStatusSuccessful)
+	node.head = 5
+
+	opts := WaitOpts{Confirmations: 2, PollInterval: time.Millisecond}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := cli.Wait(ctx, tx.Hash(), opts); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("have %v, want a deadline error while unconfirmed", err)
+	}
+
+	node.mu.Lock()
+	node.head = 7
+	node.mu.Unlock()
+	result, err := cli.Wait(context.Background(), tx.Hash(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.BlockNumber != 5 || result.Confirmations != 2 || result.GasUsed != 21000 {
+		t.Fatalf("have %+v, want block 5 with 2 confirmations", result)
+	}
+	if result.EffectiveGasPrice == nil || result.EffectiveGasPrice.Int64() != 7 {
+		t.Fatalf("have effective gas price %v, want 7", result.EffectiveGasPrice)
+	}
+}
+
+func TestWaitFailedReplaysRevert(t *testing.T) {
+	node := &fakeNode{
+		gasPrice: big.NewInt(1),
+		nonce:    func(common.Address) uint64 { return 0 },
+		call: func(common.Address, []byte, string) ([]byte, error) {
+			return nil, revertingNode{revertData(t, "Error(string)", "string", "not owner")}
+		},
+	}
+	cli := newFakeClient(t, node)
+	tx := sentTransfer(t, node, cli)
+	node.mine(tx, 3, types.ReceiptStatusFailed)
+	node.head = 3
+
+	result, err := cli.Wait(context.Background(), tx.Hash(), WaitOpts{})
+	var failed *TxFailedError
+	if !errors.As(err, &failed) || !errors.Is(err, ErrTxFailed) {
+		t.Fatalf("have %v, want a failed transaction", err)
+	}
+	var revert *RevertError
+	if !errors.As(failed.Cause, &revert) || revert.Reason != "not owner" {
+		t.Fatalf("have cause %v, want the replayed revert reason", failed.Cause)
+	}
+	if result == nil || result.Status != types.ReceiptStatusFailed {
+		t.Fatalf("have result %+v, want the failed receipt", result)
+	}
+}
+
+func TestWaitDroppedAndReplaced(t *testing.T) {
+	var nonce uint64
+	node := &fakeNode{gasPrice: big.NewInt(1), nonce: func(common.Address) uint64 { return nonce }}
+	cli := newFakeClient(t, node)
+	tx := sentTransfer(t, node, cli)
+
+	opts := WaitOpts{PollInterval: time.Millisecond, UnknownGrace: 5 * time.Millisecond}
+	if _, err := cli.Wait(context.Background(), tx.Hash(), opts); !errors.Is(err, ErrTxDropped) {
+		t.Fatalf("have %v, want a transaction unknown past the grace period to be dropped", err)
+	}
+
+	// The node saw tx before, it has since left the pool.
+	if _, err := cli.unmined(context.Background(), tx.Hash(), tx); !errors.Is(err, ErrTxDropped) {
+		t.Fatalf("have %v, want dropped while the nonce is unused", err)
+	}
+	nonce = 1
+	_, err := cli.unmined(context.Background(), tx.Hash(), tx)
+	var replaced *ReplacedError
+	if !errors.As(err, &replaced) || replaced.Nonce != 0 {
+		t.Fatalf("have %v, want replaced once the nonce is used", err)
+	}
+}
+
+func TestWaitUnknownThenSeen(t *testing.T) {
+	node := &fakeNode{gasPrice: big.NewInt(1), nonce: func(common.Address) uint64 { return 0 }}
+	cli := newFakeClient(t, node)
+	tx := sentTransfer(t, node, cli)
+
+	// The endpoint answering the first polls has not seen tx yet.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		node.pend(tx)
+		time.Sleep(10 * time.Millisecond)
+		node.mine(tx, 2, types.ReceiptStatusSuccessful)
+		node.mu.Lock()
+		node.head = 2
+		node.mu.Unlock()
+	}()
+	result, err := cli.Wait(context.Background(), tx.Hash(), WaitOpts{PollInterval: time.Millisecond, UnknownGrace: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.BlockNumber != 2 {
+		t.Fatalf("have %+v, want block 2", result)
+	}
+}
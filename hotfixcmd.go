@@ -0,0 +1,206 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/mapprotocol/marker_tool01/handler"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var saltFlag = cli.StringFlag{
+	Name:  "salt",
+	Usage: "32 byte hex salt of the hotfix",
+}
+
+var hotfixCommand = cli.Command{
+	Name:  "hotfix",
+	Usage: "hotfix lifecycle: hash, whitelist, approve, prepare and execute",
+	Subcommands: []cli.Command{
+		{
+			Name:   "hash",
+			Usage:  "compute the hash of a hotfix from its actions and salt, a random salt if none is given",
+			Flags:  append([]cli.Flag{actionFlag, actionsFileFlag, saltFlag}, callFlags...),
+			Action: hotfixHash,
+		},
+		{
+			Name:      "status",
+			Usage:     "show the approval, whitelist tally and missing validators of a hotfix",
+			ArgsUsage: "<hash>",
+			Flags:     callFlags,
+			Action:    hotfixStatus,
+		},
+		{
+			Name:      "whitelist",
+			Usage:     "whitelist a hotfix from a validator account or signer",
+			ArgsUsage: "<hash>",
+			Flags:     txFlags,
+			Action:    whitelistHotfix,
+		},
+		{
+			Name:      "approve",
+			Usage:     "approve a hotfix as the approver",
+			ArgsUsage: "<hash>",
+			Flags:     txFlags,
+			Action:    approveHotfix,
+		},
+		{
+			Name:      "prepare",
+			Usage:     "prepare a whitelisted hotfix for execution in the current epoch",
+			ArgsUsage: "<hash>",
+			Flags:     txFlags,
+			Action:    prepareHotfix,
+		},
+		{
+			Name:   "execute",
+			Usage:  "execute an approved hotfix prepared in the current epoch",
+			Flags:  append([]cli.Flag{actionFlag, actionsFileFlag, saltFlag}, txFlags...),
+			Action: executeHotfix,
+		},
+	},
+}
+
+func hotfixHash(ctx *cli.Context) error {
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	h, err := hotfixFromContext(ctx, client, true)
+	if err != nil {
+		return err
+	}
+	hash, err := h.Hash()
+	if err != nil {
+		return err
+	}
+	log.Info("hotfix", "hash", hash, "salt", common.Hash(h.Salt), "actions", len(h.Actions))
+	return nil
+}
+
+func hotfixStatus(ctx *cli.Context) error {
+	hash, err := argHash(ctx, 0, "hash")
+	if err != nil {
+		return err
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	return logHotfixStatus(client, hash)
+}
+
+func logHotfixStatus(client *handler.Client, hash common.Hash) error {
+	s, err := client.HotfixStatus(hash)
+	if err != nil {
+		return err
+	}
+	missing := s.Missing()
+	log.Info("hotfix status", "hash", hash, "approved", s.Approved, "executed", s.Executed,
+		"preparedEpoch", s.PreparedEpoch, "epoch", s.Epoch, "tally", s.Tally, "quorum", s.Quorum,
+		"passing", s.Passing, "validators", len(s.Validators), "missing", len(missing))
+	for _, signer := range missing {
+		log.Info("not whitelisted", "signer", signer)
+	}
+	return nil
+}
+
+func whitelistHotfix(ctx *cli.Context) error {
+	return sendHotfixTx(ctx, "whitelistHotfix", (*handler.Client).WhitelistHotfix)
+}
+
+func approveHotfix(ctx *cli.Context) error {
+	return sendHotfixTx(ctx, "approveHotfix", (*handler.Client).ApproveHotfix)
+}
+
+func prepareHotfix(ctx *cli.Context) error {
+	return sendHotfixTx(ctx, "prepareHotfix", (*handler.Client).PrepareHotfix)
+}
+
+// sendHotfixTx sends the transaction built by send for the hotfix given as
+// argument, waits for it and logs the hotfix status.
+func sendHotfixTx(ctx *cli.Context, name string, send func(*handler.Client, handler.Signer, common.Hash) (common.Hash, error)) error {
+	hash, err := argHash(ctx, 0, "hash")
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := send(client, signer, hash)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info(name, "hash", hash)
+	return logHotfixStatus(client, hash)
+}
+
+func executeHotfix(ctx *cli.Context) error {
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	h, err := hotfixFromContext(ctx, client, false)
+	if err != nil {
+		return err
+	}
+	hash, err := h.Hash()
+	if err != nil {
+		return err
+	}
+	txHash, err := client.ExecuteHotfix(signer, h)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info("executeHotfix", "hash", hash)
+	return nil
+}
+
+// hotfixFromContext builds a hotfix from the action flags and --salt. A
+// random salt is drawn if --salt is missing and randomSalt is set.
+func hotfixFromContext(ctx *cli.Context, client *handler.Client, randomSalt bool) (*handler.Hotfix, error) {
+	actions, err := actionsFromContext(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	h := &handler.Hotfix{Actions: actions}
+	switch salt := ctx.String(saltFlag.Name); {
+	case salt != "":
+		b, err := hex.DecodeString(strings.TrimPrefix(salt, "0x"))
+		if err != nil || len(b) != common.HashLength {
+			return nil, fmt.Errorf("invalid --%s %q, want 32 hex bytes", saltFlag.Name, salt)
+		}
+		copy(h.Salt[:], b)
+	case randomSalt:
+		if _, err := rand.Read(h.Salt[:]); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("missing --%s", saltFlag.Name)
+	}
+	return h, nil
+}
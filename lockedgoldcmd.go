@@ -0,0 +1,125 @@
+package main
+
+import (
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/mapprotocol/marker_tool01/handler"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var lockedGoldCommand = cli.Command{
+	Name:  "lockedgold",
+	Usage: "LockedGold contract operations",
+	Subcommands: []cli.Command{
+		{
+			Name:   "get-unlocking-period",
+			Usage:  "show the unlocking period in seconds",
+			Flags:  callFlags,
+			Action: getUnlockingPeriod,
+		},
+		{
+			Name:      "set-unlocking-period",
+			Usage:     "set the unlocking period in seconds",
+			ArgsUsage: "<seconds>",
+			Flags:     txFlags,
+			Action:    setUnlockingPeriod,
+		},
+		{
+			Name:      "total",
+			Usage:     "show the total locked gold of an account",
+			ArgsUsage: "<account>",
+			Flags:     append([]cli.Flag{blockFlag}, callFlags...),
+			Action:    getAccountTotalLockedGold,
+		},
+		{
+			Name:      "nonvoting",
+			Usage:     "show the nonvoting locked gold of an account",
+			ArgsUsage: "<account>",
+			Flags:     append([]cli.Flag{blockFlag}, callFlags...),
+			Action:    getAccountNonvotingLockedGold,
+		},
+	},
+}
+
+func getUnlockingPeriod(ctx *cli.Context) error {
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	period, err := client.GetUnlockingPeriod()
+	if err != nil {
+		return err
+	}
+	log.Info("getUnlockingPeriod", "period", period)
+	return nil
+}
+
+func setUnlockingPeriod(ctx *cli.Context) error {
+	period, err := argBig(ctx, 0, "seconds")
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := client.SetUnlockingPeriod(signer, period)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info("setUnlockingPeriod", "from", signer.Address(), "period", period)
+	return nil
+}
+
+func getAccountTotalLockedGold(ctx *cli.Context) error {
+	account, err := argAddress(ctx, 0, "account")
+	if err != nil {
+		return err
+	}
+	height, err := blockFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	res, err := client.GetAccountTotalLockedGold(account, height)
+	if err != nil {
+		return err
+	}
+	log.Info("getAccountTotalLockedGold", "addr", account, "height", height, "val", handler.ToCoin(res))
+	return nil
+}
+
+func getAccountNonvotingLockedGold(ctx *cli.Context) error {
+	account, err := argAddress(ctx, 0, "account")
+	if err != nil {
+		return err
+	}
+	height, err := blockFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	res, err := client.GetAccountNonvotingLockedGold(account, height)
+	if err != nil {
+		return err
+	}
+	log.Info("getAccountNonvotingLockedGold", "addr", account, "height", height, "val", handler.ToCoin(res))
+	return nil
+}
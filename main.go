@@ -1,7 +1,42 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/log"
+	"gopkg.in/urfave/cli.v1"
+)
 
 func main() {
-	fmt.Println("marker tool")
+	app := cli.NewApp()
+	app.Name = "marker"
+	app.Usage = "marker tool for the MAP relay chain system contracts"
+	app.Before = func(ctx *cli.Context) error {
+		startLogger()
+		return nil
+	}
+	app.Commands = []cli.Command{
+		epochRewardsCommand,
+		electionCommand,
+		validatorsCommand,
+		lockedGoldCommand,
+		proxyCommand,
+		blockchainCommand,
+		accountCommand,
+		registryCommand,
+		txCommand,
+		governanceCommand,
+	}
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func startLogger() {
+	var lvl = log.LvlInfo
+	logger := log.NewGlogHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(true)))
+	logger.Verbosity(lvl)
+	log.Root().SetHandler(logger)
 }
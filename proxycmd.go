@@ -0,0 +1,75 @@
+package main
+
+import (
+	"github.com/ethereum/go-ethereum/log"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var proxyCommand = cli.Command{
+	Name:  "proxy",
+	Usage: "Proxy contract operations",
+	Subcommands: []cli.Command{
+		{
+			Name:      "get-impl",
+			Usage:     "show the implementation behind a proxy",
+			ArgsUsage: "<proxy>",
+			Flags:     callFlags,
+			Action:    getImplAddress,
+		},
+		{
+			Name:      "set-impl",
+			Usage:     "point a proxy to a new implementation",
+			ArgsUsage: "<proxy> <implementation>",
+			Flags:     txFlags,
+			Action:    setImplAddress,
+		},
+	},
+}
+
+func getImplAddress(ctx *cli.Context) error {
+	proxy, err := argAddress(ctx, 0, "proxy")
+	if err != nil {
+		return err
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	impl, err := client.GetImplAddress(proxy)
+	if err != nil {
+		return err
+	}
+	log.Info("getImplAddress", "proxy", proxy, "impl", impl)
+	return nil
+}
+
+func setImplAddress(ctx *cli.Context) error {
+	proxy, err := argAddress(ctx, 0, "proxy")
+	if err != nil {
+		return err
+	}
+	impl, err := argAddress(ctx, 1, "implementation")
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := client.SetImplAddress(signer, proxy, impl)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info("setImplAddress", "from", signer.Address(), "proxy", proxy, "impl", impl)
+	return nil
+}
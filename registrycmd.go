@@ -0,0 +1,71 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/log"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var registryCommand = cli.Command{
+	Name:  "registry",
+	Usage: "Registry contract operations",
+	Subcommands: []cli.Command{
+		{
+			Name:      "lookup",
+			Usage:     "show the address registered for a contract identifier",
+			ArgsUsage: "<identifier>",
+			Flags:     append([]cli.Flag{blockFlag}, callFlags...),
+			Action:    registryLookup,
+		},
+		{
+			Name:   "check",
+			Usage:  "compare the static genesis addresses with the registry",
+			Flags:  append([]cli.Flag{blockFlag}, callFlags...),
+			Action: registryCheck,
+		},
+	},
+}
+
+func registryLookup(ctx *cli.Context) error {
+	if ctx.NArg() < 1 {
+		return errors.New("missing identifier argument")
+	}
+	identifier := ctx.Args().First()
+	height, err := blockFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	addr, err := client.Resolver().Resolve(identifier, height)
+	if err != nil {
+		return err
+	}
+	log.Info("registry lookup", "identifier", identifier, "address", addr)
+	return nil
+}
+
+func registryCheck(ctx *cli.Context) error {
+	height, err := blockFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	mismatches, err := client.Resolver().Check(height)
+	if err != nil {
+		return err
+	}
+	for _, m := range mismatches {
+		log.Warn("genesis address mismatch", "contract", m.Identifier, "genesis", m.Genesis, "registry", m.Registry)
+	}
+	log.Info("registry check", "mismatches", len(mismatches))
+	return nil
+}
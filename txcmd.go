@@ -0,0 +1,61 @@
+package main
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/mapprotocol/marker_tool01/handler"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var txCommand = cli.Command{
+	Name:  "tx",
+	Usage: "manage sent transactions",
+	Subcommands: []cli.Command{
+		{
+			Name:      "speedup",
+			Usage:     "resend a pending transaction with the same nonce and higher fees",
+			ArgsUsage: "<txHash>",
+			Flags:     txFlags,
+			Action:    speedUpTx,
+		},
+		{
+			Name:      "cancel",
+			Usage:     "replace a pending transaction with a zero-value transfer to the sender",
+			ArgsUsage: "<txHash>",
+			Flags:     txFlags,
+			Action:    cancelTx,
+		},
+	},
+}
+
+func speedUpTx(ctx *cli.Context) error {
+	return replaceTx(ctx, "speedup", (*handler.Client).SpeedUp)
+}
+
+func cancelTx(ctx *cli.Context) error {
+	return replaceTx(ctx, "cancel", (*handler.Client).Cancel)
+}
+
+// replaceTx sends the replacement built by replace and waits for it.
+func replaceTx(ctx *cli.Context, name string, replace func(*handler.Client, handler.Signer, common.Hash) (common.Hash, error)) error {
+	oldHash, err := argHash(ctx, 0, "txHash")
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := replace(client, signer, oldHash)
+	if err != nil {
+		return err
+	}
+	log.Info(name, "replaced", oldHash, "txHash", txHash)
+	return waitTx(ctx, client, txHash)
+}
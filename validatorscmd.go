@@ -0,0 +1,91 @@
+package main
+
+import (
+	"github.com/ethereum/go-ethereum/log"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var validatorsCommand = cli.Command{
+	Name:  "validators",
+	Usage: "Validators contract operations",
+	Subcommands: []cli.Command{
+		{
+			Name:   "get-commission-delay",
+			Usage:  "show the commission update delay in blocks",
+			Flags:  callFlags,
+			Action: getCommissionUpdateDelay,
+		},
+		{
+			Name:      "set-commission-delay",
+			Usage:     "set the commission update delay in blocks",
+			ArgsUsage: "<blocks>",
+			Flags:     txFlags,
+			Action:    setCommissionUpdateDelay,
+		},
+		{
+			Name:      "pending-deregister",
+			Usage:     "show whether a validator is pending deregistration",
+			ArgsUsage: "<validator>",
+			Flags:     callFlags,
+			Action:    isPendingDeRegisterValidator,
+		},
+	},
+}
+
+func getCommissionUpdateDelay(ctx *cli.Context) error {
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	value, err := client.GetCommissionUpdateDelay()
+	if err != nil {
+		return err
+	}
+	log.Info("getCommissionUpdateDelay", "delayBlock", value)
+	return nil
+}
+
+func setCommissionUpdateDelay(ctx *cli.Context) error {
+	delay, err := argBig(ctx, 0, "blocks")
+	if err != nil {
+		return err
+	}
+	signer, closeSigner, err := signerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSigner()
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	txHash, err := client.SetCommissionUpdateDelay(signer, delay)
+	if err != nil {
+		return err
+	}
+	if err := waitTx(ctx, client, txHash); err != nil {
+		return err
+	}
+	log.Info("setCommissionUpdateDelay", "address", signer.Address(), "delayBlock", delay)
+	return nil
+}
+
+func isPendingDeRegisterValidator(ctx *cli.Context) error {
+	validator, err := argAddress(ctx, 0, "validator")
+	if err != nil {
+		return err
+	}
+	client, err := clientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	result, err := client.IsPendingDeRegisterValidator(validator)
+	if err != nil {
+		return err
+	}
+	log.Info("isPendingDeRegisterValidator", "validator", validator, "result", result)
+	return nil
+}